@@ -1,22 +1,37 @@
 package app
 
 import (
-	"log"
+	"context"
+	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
+	"sync/atomic"
 	"syscall"
 
 	"booking-service/internal/app"
+	"booking-service/internal/auth"
+	"booking-service/internal/certs"
 	"booking-service/internal/controllers"
 	"booking-service/internal/generated"
+	"booking-service/internal/invoice"
+	"booking-service/internal/notification"
+	"booking-service/internal/pii"
+	"booking-service/internal/pricing"
+	"booking-service/internal/ratelimit"
 	"booking-service/internal/storage"
+	"booking-service/internal/tracing"
 
+	consulapi "github.com/hashicorp/consul/api"
 	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
 )
 
 type (
 	App struct {
-		config *Config
+		configPath string
+		config     *Config
 
 		Handlers struct {
 			booking *app.Handler
@@ -30,49 +45,219 @@ type (
 		Storage    *storage.Storage
 
 		Clients struct {
-			payment generated.PaymentServiceClient
+			payment       generated.PaymentServiceClient
+			consul        *consulapi.Client
+			notifications *notification.Publisher
 		}
+
+		certs struct {
+			http    *certs.Reloader
+			grpc    *certs.Reloader
+			payment *certs.Reloader
+		}
+		stopCerts context.CancelFunc
+
+		authenticator *auth.Authenticator
+		bookingTokens *auth.BookingTokens
+		stopAuth      context.CancelFunc
+
+		quoteTokens *pricing.QuoteTokens
+		invoices    *invoice.Renderer
+		pii         *pii.Cipher
+
+		rateLimiter   *ratelimit.Limiter
+		stopRateLimit context.CancelFunc
+
+		stopReconciliation context.CancelFunc
+		stopDeposits       context.CancelFunc
+		stopGuestRetention context.CancelFunc
+		stopNotifications  context.CancelFunc
+
+		grpcServer       *grpc.Server
+		grpcHealthServer *grpc.Server
+		httpServer       *http.Server
+		healthServer     *health.Server
+		consulServiceID  string
+
+		logger         *slog.Logger
+		logLevel       *slog.LevelVar
+		requestTimeout atomic.Int64
+
+		shutdownTracing tracing.ShutdownFunc
 	}
 )
 
-func New() *App {
-	return &App{}
+// New создает приложение; configPath — путь к файлу или каталогу конфига,
+// пустая строка означает CONFIG_PATH или ./internal/config
+func New(configPath string) *App {
+	return &App{
+		configPath: configPath,
+	}
 }
 
 func (a *App) Run() {
 	err := a.InitConfig()
 	if err != nil {
-		log.Printf("failed to initialize config: %s\n", err)
+		slog.Error("failed to initialize config", "error", err)
+		return
+	}
+
+	err = a.initLogger()
+	if err != nil {
+		slog.Error("failed to initialize logger", "error", err)
+		return
+	}
+	a.watchConfig()
+
+	err = a.initTracing()
+	if err != nil {
+		a.logger.Error("failed to initialize tracing", "error", err)
+		return
+	}
+
+	err = a.initCerts()
+	if err != nil {
+		a.logger.Error("failed to initialize certificates", "error", err)
+		return
+	}
+
+	err = a.initAuth()
+	if err != nil {
+		a.logger.Error("failed to initialize authentication", "error", err)
+		return
+	}
+
+	err = a.initPricing()
+	if err != nil {
+		a.logger.Error("failed to initialize pricing", "error", err)
+		return
+	}
+
+	err = a.initInvoices()
+	if err != nil {
+		a.logger.Error("failed to initialize invoices", "error", err)
+		return
+	}
+
+	err = a.initGuests()
+	if err != nil {
+		a.logger.Error("failed to initialize guests", "error", err)
 		return
 	}
 
 	err = a.initDB()
 	if err != nil {
-		log.Printf("failed to initialize database: %s\n", err)
+		a.logger.Error("failed to initialize database", "error", err)
 		return
 	}
 	defer a.Stop()
 
+	if a.config.Consul.Enabled {
+		err = a.initConsul()
+		if err != nil {
+			a.logger.Error("failed to initialize consul", "error", err)
+			return
+		}
+	}
+
 	a.initClients()
 	a.initControllers()
 	a.initHandlers()
+	a.initRateLimit()
+	a.initReconciliation()
+	a.initDeposits()
+	a.initGuestRetention()
+	a.initNotifications()
 
-	go a.initGRPC()
-	go a.initHTTP()
-	//err = a.initConsul()
-	//if err != nil {
-	//	log.Printf("failed to initialize consul: %s\n", err)
-	//	return
-	//}
+	a.initGRPC()
+	a.initHTTP()
+	if a.config.Consul.Enabled {
+		err = a.registerConsul()
+		if err != nil {
+			a.logger.Error("failed to register in consul", "error", err)
+			return
+		}
+	}
 	a.initSwagger()
-	log.Println("application started")
+	a.logger.Info("application started")
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
 	<-sigChan
-	log.Println("Received shutdown signal, initiating graceful shutdown...")
+	a.logger.Info("received shutdown signal, initiating graceful shutdown")
 }
 
+// Stop сначала снимает инстанс с балансировки (Consul, health), затем
+// дожидается завершения текущих запросов и только после этого закрывает БД
 func (a *App) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), a.config.Timeouts.Shutdown)
+	defer cancel()
+
+	if a.Clients.consul != nil {
+		a.deregisterConsul()
+	}
+	if a.healthServer != nil {
+		a.healthServer.Shutdown()
+	}
+	if a.httpServer != nil {
+		if err := a.httpServer.Shutdown(ctx); err != nil {
+			a.logger.Error("failed to shutdown HTTP server", "error", err)
+		}
+	}
+	if a.grpcServer != nil {
+		stopped := make(chan struct{})
+		go func() {
+			a.grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			a.grpcServer.Stop()
+		}
+	}
+	if a.grpcHealthServer != nil {
+		a.grpcHealthServer.Stop()
+	}
+
 	a.PostgreSQL.Close()
+
+	if a.stopCerts != nil {
+		a.stopCerts()
+	}
+	if a.stopAuth != nil {
+		a.stopAuth()
+	}
+	if a.stopRateLimit != nil {
+		a.stopRateLimit()
+	}
+	if a.stopReconciliation != nil {
+		a.stopReconciliation()
+	}
+	if a.stopDeposits != nil {
+		a.stopDeposits()
+	}
+	if a.stopGuestRetention != nil {
+		a.stopGuestRetention()
+	}
+	if a.stopNotifications != nil {
+		a.stopNotifications()
+	}
+	if a.Clients.notifications != nil {
+		if err := a.Clients.notifications.Close(); err != nil {
+			a.logger.Error("failed to close notifications publisher", "error", err)
+		}
+	}
+
+	if a.shutdownTracing != nil {
+		if err := a.shutdownTracing(ctx); err != nil {
+			a.logger.Error("failed to shutdown tracing", "error", err)
+		}
+	}
+}
+
+// fatal логирует ошибку и завершает процесс; используется в горутинах серверов
+func (a *App) fatal(msg string, err error) {
+	a.logger.Error(msg, "error", err)
+	os.Exit(1)
 }
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/auth"
+)
+
+// initAuth настраивает проверку JWT (JWKS из файла или по URL) и статических
+// API-ключей. Публичные HTTP-эндпоинты (/health, swagger) обслуживаются мимо
+// gRPC и остаются открытыми; grpc.health.v1 открыт в самом Authenticator.
+func (a *App) initAuth() error {
+	// токены гостей выдаются и проверяются и при выключенной аутентификации,
+	// иначе ListMyBookings недоступен
+	if a.config.Auth.BookingTokenSecret != "" {
+		tokens, err := auth.NewBookingTokens(a.config.Auth.BookingTokenSecret, a.config.Auth.BookingTokenTTL)
+		if err != nil {
+			return err
+		}
+		a.bookingTokens = tokens
+	}
+
+	if !a.config.Auth.Enabled {
+		a.logger.Warn("authentication is disabled, all gRPC methods are public")
+		return nil
+	}
+
+	var validator *auth.JWTValidator
+	if a.config.Auth.JWKSSource != "" {
+		ctx, cancel := context.WithCancel(context.Background())
+		a.stopAuth = cancel
+
+		keys, err := auth.NewKeySet(ctx, a.config.Auth.JWKSSource, a.config.Auth.JWKSRefreshInterval, a.logger)
+		if err != nil {
+			return err
+		}
+		go keys.Run(ctx)
+		validator = auth.NewJWTValidator(keys, a.config.Auth.Issuer, a.config.Auth.Audience, a.config.Auth.Leeway)
+	}
+
+	var apiKeys *auth.APIKeys
+	if len(a.config.Auth.APIKeys) > 0 {
+		keys := make([]auth.APIKey, 0, len(a.config.Auth.APIKeys))
+		for _, k := range a.config.Auth.APIKeys {
+			keys = append(keys, auth.APIKey{Name: k.Name, Key: k.Key})
+		}
+		var err error
+		if apiKeys, err = auth.NewAPIKeys(keys); err != nil {
+			return err
+		}
+	}
+
+	a.authenticator = auth.NewAuthenticator(validator, apiKeys)
+	return nil
+}
@@ -0,0 +1,85 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/certs"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// initCerts загружает сертификаты для включенных TLS-эндпоинтов и запускает
+// их перечитывание с диска, чтобы ротация cert-manager не требовала рестарта
+func (a *App) initCerts() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopCerts = cancel
+
+	load := func(cfg *TLSConfig) (*certs.Reloader, error) {
+		if !cfg.Enabled {
+			return nil, nil
+		}
+		r, err := certs.NewReloader(certs.Files{
+			CertFile: cfg.CertFile,
+			KeyFile:  cfg.KeyFile,
+			CAFile:   cfg.CAFile,
+		}, a.config.Certs.ReloadInterval, a.logger)
+		if err != nil {
+			return nil, err
+		}
+		go r.Run(ctx)
+		return r, nil
+	}
+
+	var err error
+	if a.certs.http, err = load(a.config.app.TLS); err != nil {
+		return err
+	}
+	if a.certs.grpc, err = load(a.config.app.Grpc.TLS); err != nil {
+		return err
+	}
+	if a.certs.payment, err = load(a.config.PaymentClient.TLS); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (a *App) grpcServerCredentials() credentials.TransportCredentials {
+	if a.certs.grpc == nil {
+		return insecure.NewCredentials()
+	}
+	return credentials.NewTLS(a.certs.grpc.ServerConfig(a.config.app.Grpc.TLS.ClientAuth))
+}
+
+// grpcHealthCredentials креды отдельного health-порта: тот же сертификат сервера,
+// но без клиентского — так его может проверить агент Consul
+func (a *App) grpcHealthCredentials() credentials.TransportCredentials {
+	if a.certs.grpc == nil {
+		return insecure.NewCredentials()
+	}
+	return credentials.NewTLS(a.certs.grpc.ServerConfig(false))
+}
+
+// gatewayCredentials креды для loopback-подключения шлюза к gRPC-порту. При mTLS
+// шлюз предъявляет тот же сертификат, что и gRPC-сервер (нужен clientAuth в EKU).
+func (a *App) gatewayCredentials() credentials.TransportCredentials {
+	if a.certs.grpc == nil {
+		return insecure.NewCredentials()
+	}
+	serverName := a.config.app.Grpc.TLS.ServerName
+	if serverName == "" {
+		serverName = "localhost"
+	}
+	return credentials.NewTLS(a.certs.grpc.ClientConfig(serverName))
+}
+
+func (a *App) paymentCredentials() credentials.TransportCredentials {
+	if a.certs.payment == nil {
+		return insecure.NewCredentials()
+	}
+	serverName := a.config.PaymentClient.TLS.ServerName
+	if serverName == "" {
+		serverName = a.config.PaymentClient.Host
+	}
+	return credentials.NewTLS(a.certs.payment.ClientConfig(serverName))
+}
@@ -1,25 +1,55 @@
 package app
 
 import (
-	"log"
-
+	"booking-service/internal/discovery"
 	"booking-service/internal/generated"
+	"booking-service/internal/payment"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 func (a *App) initClients() {
 	a.initPaymentClient()
+	a.initNotificationPublisher()
 }
 
 func (a *App) initPaymentClient() {
-	conn, err := grpc.NewClient("dns:///"+a.config.PaymentClient.Host+":"+a.config.PaymentClient.Grpc.Port,
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	target := "dns:///" + a.config.PaymentClient.Host + ":" + a.config.PaymentClient.Grpc.Port
+	// повторы выполняет payment.Resilient; повторы и hedging gRPC выключены, чтобы
+	// service config не мог включить повтор неидемпотентного списания
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(a.paymentCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithDisableRetry(),
+	}
+	if a.Clients.consul != nil && a.config.Consul.PaymentServiceName != "" {
+		target = discovery.Target(a.config.Consul.PaymentServiceName)
+		opts = append(opts,
+			grpc.WithResolvers(discovery.NewResolverBuilder(a.Clients.consul, a.logger)),
+			grpc.WithDefaultServiceConfig(`{"loadBalancingConfig": [{"round_robin": {}}]}`),
+		)
+	}
+
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		a.fatal("failed to create payment client", err)
+	}
+	cfg := a.config.PaymentResilience
+	client, err := payment.NewResilient(generated.NewPaymentServiceClient(conn), payment.ResilienceConfig{
+		ProcessPaymentTimeout:  cfg.ProcessPaymentTimeout,
+		CancelPaymentTimeout:   cfg.CancelPaymentTimeout,
+		GetPaymentsInfoTimeout: cfg.GetPaymentsInfoTimeout,
+		MaxAttempts:            cfg.MaxAttempts,
+		InitialBackoff:         cfg.InitialBackoff,
+		MaxBackoff:             cfg.MaxBackoff,
+		FailureThreshold:       uint32(cfg.FailureThreshold),
+		OpenTimeout:            cfg.OpenTimeout,
+		HalfOpenRequests:       uint32(cfg.HalfOpenRequests),
+	}, a.logger)
 	if err != nil {
-		log.Fatalf("did not connect: %v", err)
+		a.fatal("failed to create payment client", err)
 	}
-	a.Clients.payment = generated.NewPaymentServiceClient(conn)
-	log.Printf("Payment client initialized on %v\n",
-		a.config.PaymentClient.Host+":"+a.config.PaymentClient.Grpc.Port)
+	a.Clients.payment = client
+	a.logger.Info("payment client initialized", "target", target)
 }
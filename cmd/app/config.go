@@ -1,19 +1,61 @@
 package app
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/logger"
+	"booking-service/internal/pii"
+	"booking-service/internal/tracing"
 
 	"github.com/spf13/viper"
 )
 
+const (
+	// configPathEnv путь к файлу или каталогу конфига, флаг -config имеет приоритет
+	configPathEnv     = "CONFIG_PATH"
+	defaultConfigPath = "./internal/config"
+	// envPrefix префикс переменных окружения, переопределяющих ключи конфига:
+	// db.password -> BOOKING_DB_PASSWORD
+	envPrefix = "BOOKING"
+	// secretFileSuffix суффикс ключа с путем к файлу секрета: db.password_file, BOOKING_DB_PASSWORD_FILE
+	secretFileSuffix = "_file"
+)
+
 type ApplicationConfig struct {
 	Host string
 	Port string
 	Grpc *GRPCConfig
+	TLS  *TLSConfig
 }
 
 type GRPCConfig struct {
 	Port string
+	TLS  *TLSConfig
+	// HealthPort отдельный порт grpc.health.v1 без клиентских сертификатов для
+	// проверок Consul при mTLS; пусто — health только на основном порту
+	HealthPort string
+}
+
+type TLSConfig struct {
+	Enabled  bool
+	CertFile string
+	KeyFile  string
+	// CAFile для сервера — CA клиентских сертификатов, для клиента — CA сервера
+	CAFile string
+	// ClientAuth включает mTLS: сервер требует клиентский сертификат
+	ClientAuth bool
+	// ServerName имя, по которому клиент проверяет сертификат сервера
+	ServerName string
 }
 
 type DbConfig struct {
@@ -24,34 +66,299 @@ type DbConfig struct {
 	Password string
 }
 
+type TracingConfig struct {
+	ServiceName string
+	Exporter    string
+	Endpoint    string
+	Insecure    bool
+	SampleRatio float64
+}
+
+type LoggerConfig struct {
+	Level string
+}
+
+type TimeoutsConfig struct {
+	Request  time.Duration
+	Shutdown time.Duration
+}
+
+type AuthConfig struct {
+	Enabled bool
+	// JWKSSource путь к файлу JWKS или http(s) URL
+	JWKSSource          string
+	JWKSRefreshInterval time.Duration
+	Issuer              string
+	Audience            string
+	Leeway              time.Duration
+	APIKeys             []APIKeyConfig
+	// BookingTokenSecret ключ HMAC для токенов доступа гостей; пустой — токены не выдаются
+	BookingTokenSecret string
+	// BookingTokenTTL срок действия токена после даты выезда
+	BookingTokenTTL time.Duration
+}
+
+type APIKeyConfig struct {
+	Name    string `mapstructure:"name" json:"name"`
+	Key     string `mapstructure:"key" json:"key"`
+	KeyFile string `mapstructure:"key_file" json:"key_file"`
+}
+
+// RateLimitConfig лимиты запросов: rate — запросов в секунду, burst — емкость корзины.
+// PerIP проверяется до аутентификации, остальные — по аутентифицированному клиенту
+type RateLimitConfig struct {
+	Enabled bool
+	// Store memory — лимит на реплику, postgres — общий для всех реплик
+	Store           string
+	Default         RateLimit
+	PerIP           RateLimit
+	Budgets         map[string]RateLimitBudget
+	TrustedProxies  int
+	CleanupInterval time.Duration
+	// TrustedProxyCIDRs сети, из которых принимается X-Forwarded-For
+	TrustedProxyCIDRs []netip.Prefix
+}
+
+type RateLimit struct {
+	Rate  float64 `mapstructure:"rate" json:"rate"`
+	Burst int     `mapstructure:"burst" json:"burst"`
+}
+
+type RateLimitBudget struct {
+	RateLimit `mapstructure:",squash"`
+	Methods   []string `mapstructure:"methods" json:"methods"`
+}
+
+const (
+	rateLimitStoreMemory   = "memory"
+	rateLimitStorePostgres = "postgres"
+)
+
+type PricingConfig struct {
+	// QuoteTokenSecret ключ HMAC для токенов предложений QuoteStay
+	QuoteTokenSecret string
+	// QuoteTokenTTL сколько действует предложение
+	QuoteTokenTTL time.Duration
+	// BaseCurrency валюта отеля, если она не задана при создании
+	BaseCurrency string
+}
+
+type InvoicesConfig struct {
+	// FontFile TTF-шрифт для PDF; без него счета печатаются встроенным
+	// шрифтом без кириллицы
+	FontFile string
+}
+
+// PaymentResilienceConfig таймауты, повторы и размыкатель цепи клиента платежного сервиса
+type PaymentResilienceConfig struct {
+	ProcessPaymentTimeout  time.Duration
+	CancelPaymentTimeout   time.Duration
+	GetPaymentsInfoTimeout time.Duration
+	// MaxAttempts попыток идемпотентного вызова, включая первую; списание не повторяется
+	MaxAttempts      int
+	InitialBackoff   time.Duration
+	MaxBackoff       time.Duration
+	FailureThreshold int
+	OpenTimeout      time.Duration
+	HalfOpenRequests int
+}
+
+// ReconciliationConfig фоновая сверка оплат с платежным сервисом
+type ReconciliationConfig struct {
+	Enabled  bool
+	Interval time.Duration
+	// Lookback сверяются бронирования, измененные за этот период
+	Lookback time.Duration
+	// RecheckAfter бронирование сверяется повторно не раньше этого срока
+	RecheckAfter time.Duration
+	// PaymentDueBefore за сколько до заезда бронирование должно быть оплачено
+	PaymentDueBefore time.Duration
+	BatchSize        int
+}
+
+// GuestsConfig персональные данные гостей
+type GuestsConfig struct {
+	// DocumentKey ключ AES-256 в base64 для шифрования номеров документов
+	DocumentKey string
+	// MatchKeys поля, по которым новый гость сопоставляется с существующим, в порядке приоритета
+	MatchKeys []string
+	// MatchNameSimilarity минимальное сходство имен от 0 до 1 при совпадении поля
+	MatchNameSimilarity float64
+}
+
+// DepositsConfig фоновое списание остатков после депозита
+type DepositsConfig struct {
+	Enabled   bool
+	Interval  time.Duration
+	BatchSize int
+	// MaxAttempts отклоненных списаний, после которых бронирование отменяется
+	MaxAttempts   int
+	RetryInterval time.Duration
+}
+
+// GuestRetentionConfig обезличивание гостей, неактивных дольше InactiveAfter
+type GuestRetentionConfig struct {
+	Enabled       bool
+	Interval      time.Duration
+	InactiveAfter time.Duration
+	BatchSize     int
+}
+
+// NotificationsConfig отправка уведомлений из outbox в RabbitMQ
+type NotificationsConfig struct {
+	Enabled   bool
+	AMQPURL   string
+	Exchange  string
+	Interval  time.Duration
+	BatchSize int
+}
+
+type CertsConfig struct {
+	ReloadInterval time.Duration
+}
+
 type Config struct {
 	app                *ApplicationConfig
 	Db                 *DbConfig
 	NotificationClient *ApplicationConfig
 	PaymentClient      *ApplicationConfig
+	PaymentResilience  *PaymentResilienceConfig
 	Consul             *Consul
+	Tracing            *TracingConfig
+	Logger             *LoggerConfig
+	Timeouts           *TimeoutsConfig
+	Certs              *CertsConfig
+	Auth               *AuthConfig
+	RateLimit          *RateLimitConfig
+	Pricing            *PricingConfig
+	Invoices           *InvoicesConfig
+	Reconciliation     *ReconciliationConfig
+	Deposits           *DepositsConfig
+	Guests             *GuestsConfig
+	GuestRetention     *GuestRetentionConfig
+	Notifications      *NotificationsConfig
 }
 
 type Consul struct {
 	host string
 	port string
+
+	Enabled                 bool
+	ServiceName             string
+	ServiceAddress          string
+	CheckInterval           time.Duration
+	CheckTimeout            time.Duration
+	DeregisterCriticalAfter time.Duration
+	// PaymentServiceName имя платежного сервиса в Consul; если задано,
+	// адрес payment_client берется из Consul, а не из host/grpc.port
+	PaymentServiceName string
 }
 
 func (a *App) InitConfig() error {
+	path := a.configPath
+	if path == "" {
+		path = os.Getenv(configPathEnv)
+	}
+	if path == "" {
+		path = defaultConfigPath
+	}
+
 	viper.SetConfigType("yaml")
-	viper.SetConfigName("config")
-	viper.AddConfigPath("./internal/config")
-	viper.WatchConfig()
+	if info, err := os.Stat(path); err == nil && !info.IsDir() {
+		viper.SetConfigFile(path)
+	} else {
+		viper.SetConfigName("config")
+		viper.AddConfigPath(path)
+	}
+
+	viper.SetEnvPrefix(envPrefix)
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	viper.AutomaticEnv()
+	setConfigDefaults()
+
 	err := viper.ReadInConfig()
 	if err != nil {
-		return fmt.Errorf("[InitConfig]: fatal error while reading config file: %s", err)
+		return fmt.Errorf("[InitConfig]: fatal error while reading config file %s: %s", path, err)
 	}
 
+	cfg, err := readConfig()
+	if err != nil {
+		return fmt.Errorf("[InitConfig]: %w", err)
+	}
+	if err = cfg.Validate(); err != nil {
+		return fmt.Errorf("[InitConfig]: invalid config %s:\n%w", viper.ConfigFileUsed(), err)
+	}
+
+	a.config = cfg
+	return nil
+}
+
+func setConfigDefaults() {
+	viper.SetDefault("tracing.service_name", "booking_service")
+	viper.SetDefault("tracing.exporter", tracing.ExporterNone)
+	viper.SetDefault("tracing.sample_ratio", 1.0)
+	viper.SetDefault("logger.level", "info")
+	viper.SetDefault("certs.reload_interval", 30*time.Second)
+	viper.SetDefault("auth.enabled", true)
+	viper.SetDefault("auth.jwt.jwks_refresh_interval", 5*time.Minute)
+	viper.SetDefault("auth.jwt.leeway", 30*time.Second)
+	viper.SetDefault("auth.booking_token.ttl", 30*24*time.Hour)
+	viper.SetDefault("pricing.quote_token.ttl", 30*time.Minute)
+	viper.SetDefault("pricing.base_currency", "RUB")
+	viper.SetDefault("rate_limit.enabled", false)
+	viper.SetDefault("rate_limit.store", rateLimitStoreMemory)
+	viper.SetDefault("rate_limit.cleanup_interval", time.Minute)
+	viper.SetDefault("rate_limit.per_ip.rate", 100)
+	viper.SetDefault("rate_limit.per_ip.burst", 200)
+	viper.SetDefault("clients.payment_client.resilience.timeouts.process_payment", 10*time.Second)
+	viper.SetDefault("clients.payment_client.resilience.timeouts.cancel_payment", 5*time.Second)
+	viper.SetDefault("clients.payment_client.resilience.timeouts.get_payments_info", 3*time.Second)
+	viper.SetDefault("clients.payment_client.resilience.retry.max_attempts", 3)
+	viper.SetDefault("clients.payment_client.resilience.retry.initial_backoff", 100*time.Millisecond)
+	viper.SetDefault("clients.payment_client.resilience.retry.max_backoff", 2*time.Second)
+	viper.SetDefault("clients.payment_client.resilience.circuit_breaker.failure_threshold", 5)
+	viper.SetDefault("clients.payment_client.resilience.circuit_breaker.open_timeout", 30*time.Second)
+	viper.SetDefault("clients.payment_client.resilience.circuit_breaker.half_open_requests", 1)
+	viper.SetDefault("reconciliation.enabled", false)
+	viper.SetDefault("reconciliation.interval", 5*time.Minute)
+	viper.SetDefault("reconciliation.lookback", 72*time.Hour)
+	viper.SetDefault("reconciliation.recheck_after", time.Hour)
+	viper.SetDefault("reconciliation.payment_due_before", 24*time.Hour)
+	viper.SetDefault("reconciliation.batch_size", 100)
+	viper.SetDefault("guests.matching.keys", []string{"document", "email", "phone"})
+	viper.SetDefault("guests.matching.name_similarity", 0.8)
+	viper.SetDefault("guest_retention.enabled", false)
+	viper.SetDefault("guest_retention.interval", 24*time.Hour)
+	viper.SetDefault("guest_retention.inactive_after", 3*365*24*time.Hour)
+	viper.SetDefault("guest_retention.batch_size", 100)
+	viper.SetDefault("deposits.enabled", false)
+	viper.SetDefault("deposits.interval", time.Minute)
+	viper.SetDefault("deposits.batch_size", 100)
+	viper.SetDefault("deposits.max_attempts", 3)
+	viper.SetDefault("deposits.retry_interval", 6*time.Hour)
+	viper.SetDefault("notifications.enabled", false)
+	viper.SetDefault("notifications.exchange", "booking.notifications")
+	viper.SetDefault("notifications.interval", 10*time.Second)
+	viper.SetDefault("notifications.batch_size", 100)
+	viper.SetDefault("consul.enabled", false)
+	viper.SetDefault("consul.service_name", "booking_service")
+	viper.SetDefault("consul.check_interval", 10*time.Second)
+	viper.SetDefault("consul.check_timeout", 5*time.Second)
+	viper.SetDefault("consul.deregister_critical_after", time.Minute)
+	viper.SetDefault("timeouts.request", 30*time.Second)
+	viper.SetDefault("timeouts.shutdown", 15*time.Second)
+}
+
+// readConfig собирает Config из текущего состояния viper (файл + переменные окружения)
+func readConfig() (*Config, error) {
 	host := viper.GetString("application.host")
 	port := viper.GetString("application.port")
 	dbHost := viper.GetString("db.host")
 	dbPort := viper.GetString("db.port")
-	dbPassword := viper.GetString("db.password")
+	dbPassword, err := secretString("db.password")
+	if err != nil {
+		return nil, err
+	}
 	dbName := viper.GetString("db.name")
 	dbUser := viper.GetString("db.user")
 	grpcPort := viper.GetString("application.grpc.port")
@@ -66,14 +373,60 @@ func (a *App) InitConfig() error {
 
 	consulHost := viper.GetString("consul.host")
 	consulPort := viper.GetString("consul.port")
+	consulEnabled := viper.GetBool("consul.enabled")
+	consulServiceName := viper.GetString("consul.service_name")
+	consulServiceAddress := viper.GetString("consul.service_address")
+	consulCheckInterval := viper.GetDuration("consul.check_interval")
+	consulCheckTimeout := viper.GetDuration("consul.check_timeout")
+	consulDeregisterCriticalAfter := viper.GetDuration("consul.deregister_critical_after")
+	consulPaymentServiceName := viper.GetString("consul.payment_service_name")
+
+	tracingServiceName := viper.GetString("tracing.service_name")
+	tracingExporter := viper.GetString("tracing.exporter")
+	tracingEndpoint := viper.GetString("tracing.endpoint")
+	tracingInsecure := viper.GetBool("tracing.insecure")
+	tracingSampleRatio := viper.GetFloat64("tracing.sample_ratio")
+
+	loggerLevel := viper.GetString("logger.level")
+
+	authConfig, err := readAuthConfig()
+	if err != nil {
+		return nil, err
+	}
 
-	a.config = &Config{
+	rateLimitConfig, err := readRateLimitConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	documentKey, err := secretString("guests.document_key")
+	if err != nil {
+		return nil, err
+	}
+
+	amqpURL, err := secretString("notifications.amqp_url")
+	if err != nil {
+		return nil, err
+	}
+
+	quoteTokenSecret, err := secretString("pricing.quote_token.secret")
+	if err != nil {
+		return nil, err
+	}
+
+	requestTimeout := viper.GetDuration("timeouts.request")
+	shutdownTimeout := viper.GetDuration("timeouts.shutdown")
+
+	return &Config{
 		app: &ApplicationConfig{
 			Host: host,
 			Port: port,
 			Grpc: &GRPCConfig{
-				Port: grpcPort,
+				Port:       grpcPort,
+				TLS:        readTLSConfig("application.grpc.tls"),
+				HealthPort: viper.GetString("application.grpc.health_port"),
 			},
+			TLS: readTLSConfig("application.tls"),
 		},
 		Db: &DbConfig{
 			Name:     dbName,
@@ -95,12 +448,453 @@ func (a *App) InitConfig() error {
 			Grpc: &GRPCConfig{
 				Port: paymentGRPCPort,
 			},
+			TLS: readTLSConfig("clients.payment_client.tls"),
 		},
 		Consul: &Consul{
-			host: consulHost,
-			port: consulPort,
+			host:                    consulHost,
+			port:                    consulPort,
+			Enabled:                 consulEnabled,
+			ServiceName:             consulServiceName,
+			ServiceAddress:          consulServiceAddress,
+			CheckInterval:           consulCheckInterval,
+			CheckTimeout:            consulCheckTimeout,
+			DeregisterCriticalAfter: consulDeregisterCriticalAfter,
+			PaymentServiceName:      consulPaymentServiceName,
+		},
+		Tracing: &TracingConfig{
+			ServiceName: tracingServiceName,
+			Exporter:    tracingExporter,
+			Endpoint:    tracingEndpoint,
+			Insecure:    tracingInsecure,
+			SampleRatio: tracingSampleRatio,
+		},
+		Logger: &LoggerConfig{
+			Level: loggerLevel,
+		},
+		Timeouts: &TimeoutsConfig{
+			Request:  requestTimeout,
+			Shutdown: shutdownTimeout,
+		},
+		Certs: &CertsConfig{
+			ReloadInterval: viper.GetDuration("certs.reload_interval"),
+		},
+		Auth:      authConfig,
+		RateLimit: rateLimitConfig,
+		Pricing: &PricingConfig{
+			QuoteTokenSecret: quoteTokenSecret,
+			QuoteTokenTTL:    viper.GetDuration("pricing.quote_token.ttl"),
+			BaseCurrency:     viper.GetString("pricing.base_currency"),
+		},
+		Invoices: &InvoicesConfig{
+			FontFile: viper.GetString("invoices.font_file"),
+		},
+		PaymentResilience: readPaymentResilienceConfig(),
+		Reconciliation: &ReconciliationConfig{
+			Enabled:          viper.GetBool("reconciliation.enabled"),
+			Interval:         viper.GetDuration("reconciliation.interval"),
+			Lookback:         viper.GetDuration("reconciliation.lookback"),
+			RecheckAfter:     viper.GetDuration("reconciliation.recheck_after"),
+			PaymentDueBefore: viper.GetDuration("reconciliation.payment_due_before"),
+			BatchSize:        viper.GetInt("reconciliation.batch_size"),
+		},
+		Deposits: &DepositsConfig{
+			Enabled:       viper.GetBool("deposits.enabled"),
+			Interval:      viper.GetDuration("deposits.interval"),
+			BatchSize:     viper.GetInt("deposits.batch_size"),
+			MaxAttempts:   viper.GetInt("deposits.max_attempts"),
+			RetryInterval: viper.GetDuration("deposits.retry_interval"),
 		},
+		Guests: &GuestsConfig{
+			DocumentKey:         documentKey,
+			MatchKeys:           viper.GetStringSlice("guests.matching.keys"),
+			MatchNameSimilarity: viper.GetFloat64("guests.matching.name_similarity"),
+		},
+		GuestRetention: &GuestRetentionConfig{
+			Enabled:       viper.GetBool("guest_retention.enabled"),
+			Interval:      viper.GetDuration("guest_retention.interval"),
+			InactiveAfter: viper.GetDuration("guest_retention.inactive_after"),
+			BatchSize:     viper.GetInt("guest_retention.batch_size"),
+		},
+		Notifications: &NotificationsConfig{
+			Enabled:   viper.GetBool("notifications.enabled"),
+			AMQPURL:   amqpURL,
+			Exchange:  viper.GetString("notifications.exchange"),
+			Interval:  viper.GetDuration("notifications.interval"),
+			BatchSize: viper.GetInt("notifications.batch_size"),
+		},
+	}, nil
+}
+
+func readPaymentResilienceConfig() *PaymentResilienceConfig {
+	const prefix = "clients.payment_client.resilience."
+	return &PaymentResilienceConfig{
+		ProcessPaymentTimeout:  viper.GetDuration(prefix + "timeouts.process_payment"),
+		CancelPaymentTimeout:   viper.GetDuration(prefix + "timeouts.cancel_payment"),
+		GetPaymentsInfoTimeout: viper.GetDuration(prefix + "timeouts.get_payments_info"),
+		MaxAttempts:            viper.GetInt(prefix + "retry.max_attempts"),
+		InitialBackoff:         viper.GetDuration(prefix + "retry.initial_backoff"),
+		MaxBackoff:             viper.GetDuration(prefix + "retry.max_backoff"),
+		FailureThreshold:       viper.GetInt(prefix + "circuit_breaker.failure_threshold"),
+		OpenTimeout:            viper.GetDuration(prefix + "circuit_breaker.open_timeout"),
+		HalfOpenRequests:       viper.GetInt(prefix + "circuit_breaker.half_open_requests"),
 	}
+}
 
-	return nil
+func readRateLimitConfig() (*RateLimitConfig, error) {
+	budgets, err := readRateLimitBudgets()
+	if err != nil {
+		return nil, err
+	}
+	var trustedCIDRs []netip.Prefix
+	for _, cidr := range viper.GetStringSlice("rate_limit.trusted_proxy_cidrs") {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("rate_limit.trusted_proxy_cidrs: %w", err)
+		}
+		trustedCIDRs = append(trustedCIDRs, prefix.Masked())
+	}
+
+	return &RateLimitConfig{
+		Enabled:           viper.GetBool("rate_limit.enabled"),
+		Store:             viper.GetString("rate_limit.store"),
+		Default:           readRateLimit("rate_limit.default."),
+		PerIP:             readRateLimit("rate_limit.per_ip."),
+		Budgets:           budgets,
+		TrustedProxies:    viper.GetInt("rate_limit.trusted_proxies"),
+		TrustedProxyCIDRs: trustedCIDRs,
+		CleanupInterval:   viper.GetDuration("rate_limit.cleanup_interval"),
+	}, nil
+}
+
+// readRateLimit читает поля по отдельности: UnmarshalKey не смотрит переменные
+// окружения вложенных ключей вроде BOOKING_RATE_LIMIT_DEFAULT_RATE
+func readRateLimit(prefix string) RateLimit {
+	return RateLimit{
+		Rate:  viper.GetFloat64(prefix + "rate"),
+		Burst: viper.GetInt(prefix + "burst"),
+	}
+}
+
+// readRateLimitBudgets читает бюджеты из BOOKING_RATE_LIMIT_BUDGETS целиком или
+// из конфига; поля бюджетов из конфига переопределяются по отдельности:
+// BOOKING_RATE_LIMIT_BUDGETS_EXPENSIVE_RATE, методы — через пробел
+func readRateLimitBudgets() (map[string]RateLimitBudget, error) {
+	var budgets map[string]RateLimitBudget
+	if ok, err := envJSON("rate_limit.budgets", &budgets); ok || err != nil {
+		return budgets, err
+	}
+
+	budgets = make(map[string]RateLimitBudget)
+	for name := range viper.GetStringMap("rate_limit.budgets") {
+		prefix := "rate_limit.budgets." + name + "."
+		budgets[name] = RateLimitBudget{
+			RateLimit: readRateLimit(prefix),
+			Methods:   viper.GetStringSlice(prefix + "methods"),
+		}
+	}
+	return budgets, nil
+}
+
+func readAuthConfig() (*AuthConfig, error) {
+	enabled := viper.GetBool("auth.enabled")
+
+	var apiKeys []APIKeyConfig
+	if ok, err := envJSON("auth.api_keys", &apiKeys); err != nil {
+		return nil, err
+	} else if !ok {
+		if err = viper.UnmarshalKey("auth.api_keys", &apiKeys); err != nil {
+			return nil, fmt.Errorf("auth.api_keys: %w", err)
+		}
+	}
+	for i := range apiKeys {
+		// файлы ключей не нужны, если аутентификация выключена
+		if !enabled || apiKeys[i].KeyFile == "" {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Clean(apiKeys[i].KeyFile))
+		if err != nil {
+			return nil, fmt.Errorf("auth.api_keys[%d].key_file: %w", i, err)
+		}
+		apiKeys[i].Key = strings.TrimRight(string(b), "\r\n")
+	}
+
+	bookingTokenSecret, err := secretString("auth.booking_token.secret")
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthConfig{
+		Enabled:             enabled,
+		JWKSSource:          viper.GetString("auth.jwt.jwks"),
+		JWKSRefreshInterval: viper.GetDuration("auth.jwt.jwks_refresh_interval"),
+		Issuer:              viper.GetString("auth.jwt.issuer"),
+		Audience:            viper.GetString("auth.jwt.audience"),
+		Leeway:              viper.GetDuration("auth.jwt.leeway"),
+		APIKeys:             apiKeys,
+		BookingTokenSecret:  bookingTokenSecret,
+		BookingTokenTTL:     viper.GetDuration("auth.booking_token.ttl"),
+	}, nil
+}
+
+func readTLSConfig(prefix string) *TLSConfig {
+	return &TLSConfig{
+		Enabled:    viper.GetBool(prefix + ".enabled"),
+		CertFile:   viper.GetString(prefix + ".cert_file"),
+		KeyFile:    viper.GetString(prefix + ".key_file"),
+		CAFile:     viper.GetString(prefix + ".ca_file"),
+		ClientAuth: viper.GetBool(prefix + ".client_auth"),
+		ServerName: viper.GetString(prefix + ".server_name"),
+	}
+}
+
+// envJSON читает список или словарь из переменной окружения ключа key. У
+// элементов таких ключей нет своих переменных, поэтому значение задается
+// целиком в JSON: BOOKING_AUTH_API_KEYS='[{"name":"payments","key_file":"/run/key"}]'.
+// Возвращает false, если переменная не задана
+func envJSON(key string, out any) (bool, error) {
+	raw, ok := viper.Get(key).(string)
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal([]byte(raw), out); err != nil {
+		return true, fmt.Errorf("%s: %w", key, err)
+	}
+	return true, nil
+}
+
+// secretString читает секрет по ключу key. Если задан key+"_file" (в конфиге
+// или через BOOKING_<KEY>_FILE), значение берется из этого файла — так удобно
+// подключать секреты Kubernetes/Docker, не храня пароли в config.yaml.
+func secretString(key string) (string, error) {
+	if path := viper.GetString(key + secretFileSuffix); path != "" {
+		b, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return "", fmt.Errorf("%s%s: %w", key, secretFileSuffix, err)
+		}
+		return strings.TrimRight(string(b), "\r\n"), nil
+	}
+	return viper.GetString(key), nil
+}
+
+// Validate проверяет конфиг целиком и возвращает все найденные ошибки разом
+func (c *Config) Validate() error {
+	var errs []error
+	required := func(key, value string) {
+		if strings.TrimSpace(value) == "" {
+			errs = append(errs, fmt.Errorf("%s: must not be empty", key))
+		}
+	}
+	port := func(key, value string) {
+		if value == "" {
+			errs = append(errs, fmt.Errorf("%s: must not be empty", key))
+			return
+		}
+		if p, err := strconv.Atoi(value); err != nil || p <= 0 || p > 65535 {
+			errs = append(errs, fmt.Errorf("%s: %q is not a valid port", key, value))
+		}
+	}
+	positive := func(key string, d time.Duration) {
+		if d <= 0 {
+			errs = append(errs, fmt.Errorf("%s: must be positive, got %s", key, d))
+		}
+	}
+
+	port("application.port", c.app.Port)
+	port("application.grpc.port", c.app.Grpc.Port)
+	if c.app.Grpc.HealthPort != "" {
+		port("application.grpc.health_port", c.app.Grpc.HealthPort)
+	}
+
+	required("db.host", c.Db.Host)
+	required("db.name", c.Db.Name)
+	required("db.user", c.Db.User)
+	required("db.password", c.Db.Password)
+	port("db.port", c.Db.Port)
+
+	if c.Consul.Enabled {
+		required("consul.host", c.Consul.host)
+		port("consul.port", c.Consul.port)
+		required("consul.service_name", c.Consul.ServiceName)
+		positive("consul.check_interval", c.Consul.CheckInterval)
+		positive("consul.check_timeout", c.Consul.CheckTimeout)
+		positive("consul.deregister_critical_after", c.Consul.DeregisterCriticalAfter)
+		// у агента Consul нет клиентского сертификата, проверка основного порта при mTLS не пройдет
+		if c.app.Grpc.TLS.Enabled && c.app.Grpc.TLS.ClientAuth && c.app.Grpc.HealthPort == "" {
+			errs = append(errs, errors.New(
+				"application.grpc.health_port: must be set when consul is enabled and application.grpc.tls.client_auth is on"))
+		}
+	}
+	if !c.Consul.Enabled || c.Consul.PaymentServiceName == "" {
+		required("clients.payment_client.host", c.PaymentClient.Host)
+		port("clients.payment_client.grpc.port", c.PaymentClient.Grpc.Port)
+	}
+
+	switch c.Tracing.Exporter {
+	case tracing.ExporterNone, tracing.ExporterStdout:
+	case tracing.ExporterOTLP:
+		required("tracing.endpoint", c.Tracing.Endpoint)
+	default:
+		errs = append(errs, fmt.Errorf("tracing.exporter: unknown exporter %q (expected none, stdout or otlp)", c.Tracing.Exporter))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("tracing.sample_ratio: must be within [0, 1], got %v", c.Tracing.SampleRatio))
+	}
+
+	if _, err := logger.ParseLevel(c.Logger.Level); err != nil {
+		errs = append(errs, fmt.Errorf("logger.level: %w", err))
+	}
+
+	validateTLS := func(prefix string, t *TLSConfig, server bool) {
+		if !t.Enabled {
+			return
+		}
+		if server {
+			required(prefix+".cert_file", t.CertFile)
+			required(prefix+".key_file", t.KeyFile)
+		} else if (t.CertFile == "") != (t.KeyFile == "") {
+			errs = append(errs, fmt.Errorf("%s: cert_file and key_file must be set together", prefix))
+		}
+		if t.ClientAuth {
+			required(prefix+".ca_file", t.CAFile)
+		}
+	}
+	validateTLS("application.tls", c.app.TLS, true)
+	validateTLS("application.grpc.tls", c.app.Grpc.TLS, true)
+	validateTLS("clients.payment_client.tls", c.PaymentClient.TLS, false)
+	if c.app.TLS.Enabled || c.app.Grpc.TLS.Enabled || c.PaymentClient.TLS.Enabled {
+		positive("certs.reload_interval", c.Certs.ReloadInterval)
+	}
+
+	if c.Auth.Enabled {
+		if c.Auth.JWKSSource == "" && len(c.Auth.APIKeys) == 0 {
+			errs = append(errs, errors.New("auth: enabled but neither auth.jwt.jwks nor auth.api_keys is configured"))
+		}
+		for i, k := range c.Auth.APIKeys {
+			required(fmt.Sprintf("auth.api_keys[%d].name", i), k.Name)
+			required(fmt.Sprintf("auth.api_keys[%d].key", i), k.Key)
+		}
+		// без секрета гости не смогут работать со своими бронированиями
+		required("auth.booking_token.secret", c.Auth.BookingTokenSecret)
+	}
+	if c.Auth.BookingTokenSecret != "" {
+		if len(c.Auth.BookingTokenSecret) < 32 {
+			errs = append(errs, errors.New("auth.booking_token.secret: must be at least 32 bytes"))
+		}
+		positive("auth.booking_token.ttl", c.Auth.BookingTokenTTL)
+	}
+
+	if c.RateLimit.Enabled {
+		switch c.RateLimit.Store {
+		case rateLimitStoreMemory, rateLimitStorePostgres:
+		default:
+			errs = append(errs, fmt.Errorf("rate_limit.store: unknown store %q", c.RateLimit.Store))
+		}
+		validateLimit := func(name string, l RateLimit) {
+			if l.Rate <= 0 || l.Burst < 1 {
+				errs = append(errs, fmt.Errorf("%s: rate must be positive and burst at least 1", name))
+			}
+		}
+		validateLimit("rate_limit.default", c.RateLimit.Default)
+		validateLimit("rate_limit.per_ip", c.RateLimit.PerIP)
+		for name, b := range c.RateLimit.Budgets {
+			validateLimit("rate_limit.budgets."+name, b.RateLimit)
+			if len(b.Methods) == 0 {
+				errs = append(errs, fmt.Errorf("rate_limit.budgets.%s.methods: required", name))
+			}
+		}
+		if c.RateLimit.TrustedProxies < 0 {
+			errs = append(errs, errors.New("rate_limit.trusted_proxies: must not be negative"))
+		}
+		positive("rate_limit.cleanup_interval", c.RateLimit.CleanupInterval)
+	}
+
+	required("pricing.quote_token.secret", c.Pricing.QuoteTokenSecret)
+	if c.Pricing.QuoteTokenSecret != "" && len(c.Pricing.QuoteTokenSecret) < 32 {
+		errs = append(errs, errors.New("pricing.quote_token.secret: must be at least 32 bytes"))
+	}
+	positive("pricing.quote_token.ttl", c.Pricing.QuoteTokenTTL)
+	if !entities.IsValidCurrency(c.Pricing.BaseCurrency) {
+		errs = append(errs, fmt.Errorf("pricing.base_currency: invalid ISO 4217 code %q", c.Pricing.BaseCurrency))
+	}
+
+	resilience := c.PaymentResilience
+	positive("clients.payment_client.resilience.timeouts.process_payment", resilience.ProcessPaymentTimeout)
+	positive("clients.payment_client.resilience.timeouts.cancel_payment", resilience.CancelPaymentTimeout)
+	positive("clients.payment_client.resilience.timeouts.get_payments_info", resilience.GetPaymentsInfoTimeout)
+	if resilience.MaxAttempts < 1 {
+		errs = append(errs, errors.New("clients.payment_client.resilience.retry.max_attempts: must be at least 1"))
+	}
+	positive("clients.payment_client.resilience.retry.initial_backoff", resilience.InitialBackoff)
+	if resilience.MaxBackoff < resilience.InitialBackoff {
+		errs = append(errs, errors.New("clients.payment_client.resilience.retry.max_backoff: must not be below initial_backoff"))
+	}
+	if resilience.FailureThreshold < 1 {
+		errs = append(errs, errors.New("clients.payment_client.resilience.circuit_breaker.failure_threshold: must be at least 1"))
+	}
+	positive("clients.payment_client.resilience.circuit_breaker.open_timeout", resilience.OpenTimeout)
+	if resilience.HalfOpenRequests < 1 {
+		errs = append(errs, errors.New("clients.payment_client.resilience.circuit_breaker.half_open_requests: must be at least 1"))
+	}
+
+	if c.Reconciliation.Enabled {
+		positive("reconciliation.interval", c.Reconciliation.Interval)
+		positive("reconciliation.lookback", c.Reconciliation.Lookback)
+		positive("reconciliation.recheck_after", c.Reconciliation.RecheckAfter)
+		if c.Reconciliation.PaymentDueBefore < 0 {
+			errs = append(errs, errors.New("reconciliation.payment_due_before: must not be negative"))
+		}
+		if c.Reconciliation.BatchSize < 1 {
+			errs = append(errs, errors.New("reconciliation.batch_size: must be at least 1"))
+		}
+	}
+
+	required("guests.document_key", c.Guests.DocumentKey)
+	if c.Guests.DocumentKey != "" {
+		if key, err := base64.StdEncoding.DecodeString(c.Guests.DocumentKey); err != nil || len(key) != pii.KeySize {
+			errs = append(errs, fmt.Errorf("guests.document_key: must be a base64-encoded %d-byte key", pii.KeySize))
+		}
+	}
+	for _, key := range c.Guests.MatchKeys {
+		switch entities.GuestMatchKey(key) {
+		case entities.GuestMatchEmail, entities.GuestMatchPhone, entities.GuestMatchDocument:
+		default:
+			errs = append(errs, fmt.Errorf("guests.matching.keys: unknown key %q, expected email, phone or document", key))
+		}
+	}
+	if c.Guests.MatchNameSimilarity < 0 || c.Guests.MatchNameSimilarity > 1 {
+		errs = append(errs, errors.New("guests.matching.name_similarity: must be within 0-1"))
+	}
+
+	if c.GuestRetention.Enabled {
+		positive("guest_retention.interval", c.GuestRetention.Interval)
+		positive("guest_retention.inactive_after", c.GuestRetention.InactiveAfter)
+		if c.GuestRetention.BatchSize < 1 {
+			errs = append(errs, errors.New("guest_retention.batch_size: must be at least 1"))
+		}
+	}
+
+	if c.Deposits.Enabled {
+		positive("deposits.interval", c.Deposits.Interval)
+		positive("deposits.retry_interval", c.Deposits.RetryInterval)
+		if c.Deposits.BatchSize < 1 {
+			errs = append(errs, errors.New("deposits.batch_size: must be at least 1"))
+		}
+		if c.Deposits.MaxAttempts < 1 {
+			errs = append(errs, errors.New("deposits.max_attempts: must be at least 1"))
+		}
+	}
+
+	if c.Notifications.Enabled {
+		required("notifications.amqp_url", c.Notifications.AMQPURL)
+		required("notifications.exchange", c.Notifications.Exchange)
+		positive("notifications.interval", c.Notifications.Interval)
+		if c.Notifications.BatchSize < 1 {
+			errs = append(errs, errors.New("notifications.batch_size: must be at least 1"))
+		}
+	}
+
+	positive("timeouts.request", c.Timeouts.Request)
+	positive("timeouts.shutdown", c.Timeouts.Shutdown)
+
+	return errors.Join(errs...)
 }
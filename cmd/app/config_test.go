@@ -0,0 +1,91 @@
+package app
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigPath = "../../internal/config/config.yaml"
+
+func loadTestConfig(t *testing.T) *Config {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	t.Setenv("BOOKING_DB_PASSWORD", "pass")
+
+	a := &App{configPath: testConfigPath}
+	require.NoError(t, a.InitConfig())
+	return a.config
+}
+
+func TestConfigFromFile(t *testing.T) {
+	cfg := loadTestConfig(t)
+
+	assert.Equal(t, RateLimit{Rate: 50, Burst: 100}, cfg.RateLimit.Default)
+	require.Contains(t, cfg.RateLimit.Budgets, "expensive")
+	assert.Equal(t, RateLimit{Rate: 5, Burst: 10}, cfg.RateLimit.Budgets["expensive"].RateLimit)
+	assert.Contains(t, cfg.RateLimit.Budgets["expensive"].Methods, "/booking_service.BookingService/QuoteStay")
+	assert.Empty(t, cfg.Auth.APIKeys)
+}
+
+func TestConfigNestedKeysFromEnv(t *testing.T) {
+	t.Setenv("BOOKING_RATE_LIMIT_DEFAULT_RATE", "7.5")
+	t.Setenv("BOOKING_RATE_LIMIT_DEFAULT_BURST", "15")
+	t.Setenv("BOOKING_RATE_LIMIT_PER_IP_BURST", "300")
+	t.Setenv("BOOKING_RATE_LIMIT_BUDGETS_EXPENSIVE_RATE", "1")
+	t.Setenv("BOOKING_RATE_LIMIT_BUDGETS_EXPENSIVE_METHODS", "/a.S/One /a.S/Two")
+	t.Setenv("BOOKING_AUTH_API_KEYS", `[{"name":"payments","key":"secret"}]`)
+	cfg := loadTestConfig(t)
+
+	assert.Equal(t, RateLimit{Rate: 7.5, Burst: 15}, cfg.RateLimit.Default)
+	assert.Equal(t, RateLimit{Rate: 100, Burst: 300}, cfg.RateLimit.PerIP)
+	assert.Equal(t, RateLimitBudget{
+		RateLimit: RateLimit{Rate: 1, Burst: 10},
+		Methods:   []string{"/a.S/One", "/a.S/Two"},
+	}, cfg.RateLimit.Budgets["expensive"])
+	assert.Equal(t, []APIKeyConfig{{Name: "payments", Key: "secret"}}, cfg.Auth.APIKeys)
+}
+
+func TestConfigBudgetsFromEnvJSON(t *testing.T) {
+	t.Setenv("BOOKING_RATE_LIMIT_BUDGETS", `{"reports":{"rate":2,"burst":4,"methods":["/a.S/Report"]}}`)
+	cfg := loadTestConfig(t)
+
+	assert.Equal(t, map[string]RateLimitBudget{
+		"reports": {RateLimit: RateLimit{Rate: 2, Burst: 4}, Methods: []string{"/a.S/Report"}},
+	}, cfg.RateLimit.Budgets)
+}
+
+func TestConfigRejectsInvalidEnvJSON(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	t.Setenv("BOOKING_DB_PASSWORD", "pass")
+	t.Setenv("BOOKING_AUTH_API_KEYS", `payments`)
+
+	a := &App{configPath: testConfigPath}
+	assert.ErrorContains(t, a.InitConfig(), "auth.api_keys")
+}
+
+func TestConfigTrustedProxyCIDRs(t *testing.T) {
+	cfg := loadTestConfig(t)
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("127.0.0.1/32"),
+		netip.MustParsePrefix("::1/128"),
+	}, cfg.RateLimit.TrustedProxyCIDRs)
+
+	t.Setenv("BOOKING_RATE_LIMIT_TRUSTED_PROXY_CIDRS", "10.0.0.7/32 fd00::/64")
+	cfg = loadTestConfig(t)
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.7/32"),
+		netip.MustParsePrefix("fd00::/64"),
+	}, cfg.RateLimit.TrustedProxyCIDRs)
+
+	t.Setenv("BOOKING_RATE_LIMIT_TRUSTED_PROXY_CIDRS", "127.0.0.1")
+	viper.Reset()
+	a := &App{configPath: testConfigPath}
+	assert.ErrorContains(t, a.InitConfig(), "rate_limit.trusted_proxy_cidrs")
+}
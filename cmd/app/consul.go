@@ -2,9 +2,12 @@ package app
 
 import (
 	"fmt"
-	"log"
+	"net"
+	"os"
 	"strconv"
 
+	"booking-service/internal/discovery"
+
 	"github.com/hashicorp/consul/api"
 )
 
@@ -14,30 +17,90 @@ func (a *App) initConsul() error {
 
 	client, err := api.NewClient(cfg)
 	if err != nil {
-		return fmt.Errorf("consul init err: %v\n", err)
+		return fmt.Errorf("consul init err: %v", err)
 	}
+	a.Clients.consul = client
+	return nil
+}
+
+// registerConsul регистрирует инстанс сервиса с HTTP- и gRPC-проверками здоровья.
+// ID включает адрес и порт инстанса, поэтому реплики не перетирают друг друга.
+// gRPC проверяется на application.grpc.health_port, если он задан: агент не
+// предъявляет клиентский сертификат, которого требует основной порт при mTLS.
+func (a *App) registerConsul() error {
 	port, err := strconv.Atoi(a.config.app.Port)
 	if err != nil {
-		return fmt.Errorf("consul init err: %v\n", err)
+		return fmt.Errorf("consul register err: %v", err)
+	}
+
+	address := a.config.Consul.ServiceAddress
+	if address == "" {
+		if address, err = os.Hostname(); err != nil {
+			return fmt.Errorf("consul register err: resolve hostname: %v", err)
+		}
+	}
+	name := a.config.Consul.ServiceName
+	id := fmt.Sprintf("%s-%s-%s", name, address, a.config.app.Port)
+
+	httpAddr := net.JoinHostPort(address, a.config.app.Port)
+	httpScheme := "http"
+	if a.certs.http != nil {
+		httpScheme = "https"
+	}
+	grpcPort := a.config.app.Grpc.Port
+	if a.config.app.Grpc.HealthPort != "" {
+		grpcPort = a.config.app.Grpc.HealthPort
 	}
+	grpcAddr := net.JoinHostPort(address, grpcPort)
 
 	registration := &api.AgentServiceRegistration{
-		ID:      "booking_service",
-		Name:    "booking_service",
+		ID:      id,
+		Name:    name,
 		Port:    port,
-		Address: "booking_service",
-		Check: &api.AgentServiceCheck{
-			HTTP:     "http://" + "booking_service" + ":" + a.config.app.Port + "/health",
-			Interval: "10s",
-			Timeout:  "10s",
+		Address: address,
+		Tags:    []string{"http", "grpc"},
+		Meta: map[string]string{
+			discovery.GRPCPortMetaKey: a.config.app.Grpc.Port,
+		},
+		Checks: api.AgentServiceChecks{
+			{
+				Name:                           "http health",
+				HTTP:                           httpScheme + "://" + httpAddr + "/health",
+				TLSSkipVerify:                  a.certs.http != nil,
+				Interval:                       a.config.Consul.CheckInterval.String(),
+				Timeout:                        a.config.Consul.CheckTimeout.String(),
+				DeregisterCriticalServiceAfter: a.config.Consul.DeregisterCriticalAfter.String(),
+			},
+			{
+				Name:                           "grpc health",
+				GRPC:                           grpcAddr,
+				GRPCUseTLS:                     a.certs.grpc != nil,
+				TLSSkipVerify:                  a.certs.grpc != nil,
+				Interval:                       a.config.Consul.CheckInterval.String(),
+				Timeout:                        a.config.Consul.CheckTimeout.String(),
+				DeregisterCriticalServiceAfter: a.config.Consul.DeregisterCriticalAfter.String(),
+			},
 		},
 	}
 
-	err = client.Agent().ServiceRegister(registration)
+	err = a.Clients.consul.Agent().ServiceRegister(registration)
 	if err != nil {
-		return fmt.Errorf("consul init err: %v\n", err)
+		return fmt.Errorf("consul register err: %v", err)
 	}
+	a.consulServiceID = id
 
-	log.Println("consul init success.")
+	a.logger.Info("consul registration success", "service_id", id)
 	return nil
 }
+
+func (a *App) deregisterConsul() {
+	if a.consulServiceID == "" {
+		return
+	}
+	if err := a.Clients.consul.Agent().ServiceDeregister(a.consulServiceID); err != nil {
+		a.logger.Error("consul deregistration failed", "service_id", a.consulServiceID, "error", err)
+		return
+	}
+	a.logger.Info("consul deregistration success", "service_id", a.consulServiceID)
+	a.consulServiceID = ""
+}
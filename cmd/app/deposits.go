@@ -0,0 +1,56 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"booking-service/internal/auth"
+	"booking-service/internal/entities"
+)
+
+// balanceChargerSubject под этим именем списание остатков записывается в журнал аудита
+const balanceChargerSubject = "balance-charger"
+
+// initDeposits запускает фоновое списание остатков после депозита. Реплики
+// берут бронирования с блокировкой строки и пропускают занятые, поэтому
+// задача может работать на всех репликах одновременно
+func (a *App) initDeposits() {
+	cfg := a.config.Deposits
+	if !cfg.Enabled {
+		return
+	}
+
+	opts := entities.BalanceChargeOptions{
+		BatchSize:     cfg.BatchSize,
+		MaxAttempts:   cfg.MaxAttempts,
+		RetryInterval: cfg.RetryInterval,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopDeposits = cancel
+	ctx = auth.WithPrincipal(ctx, &auth.Principal{Kind: auth.PrincipalKindService, Subject: balanceChargerSubject})
+	go a.runDeposits(ctx, cfg.Interval, opts)
+
+	a.logger.Info("balance charging enabled", "interval", cfg.Interval, "max_attempts", cfg.MaxAttempts)
+}
+
+func (a *App) runDeposits(ctx context.Context, interval time.Duration, opts entities.BalanceChargeOptions) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			charged, cancelled, err := a.Controllers.BookingController.ChargeDueBalances(ctx, opts)
+			if err != nil && ctx.Err() == nil {
+				a.logger.Warn("balance charging failed", "charged", charged, "cancelled", cancelled, "error", err)
+				continue
+			}
+			if charged > 0 || cancelled > 0 {
+				a.logger.Info("balance charging completed", "charged", charged, "cancelled", cancelled)
+			}
+		}
+	}
+}
@@ -1,25 +1,110 @@
 package app
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"net"
+	"time"
 
+	"booking-service/internal/authz"
 	"booking-service/internal/generated"
+	"booking-service/internal/logger"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 func (a *App) initGRPC() {
-	s := grpc.NewServer()
+	unary := []grpc.UnaryServerInterceptor{
+		logger.UnaryServerInterceptor(a.logger),
+	}
+	stream := []grpc.StreamServerInterceptor{
+		logger.StreamServerInterceptor(a.logger),
+	}
+	// лимит по IP до проверки учетных данных: перебор ключей и токенов
+	// упирается в него раньше, чем в проверку подписи и базу
+	if a.rateLimiter != nil {
+		unary = append(unary, a.rateLimiter.IPUnaryServerInterceptor())
+	}
+	if a.bookingTokens != nil {
+		unary = append(unary, a.bookingTokens.UnaryServerInterceptor())
+	}
+	if a.authenticator != nil {
+		unary = append(unary, a.authenticator.UnaryServerInterceptor())
+		stream = append(stream, a.authenticator.StreamServerInterceptor())
+	}
+	// лимит после аутентификации — клиент известен, но до проверки прав,
+	// которая ходит в базу
+	if a.rateLimiter != nil {
+		unary = append(unary, a.rateLimiter.UnaryServerInterceptor())
+	}
+	if a.authenticator != nil {
+		// права сотрудников проверяются только для аутентифицированных вызовов
+		authorizer := authz.NewAuthorizer(a.Controllers.BookingController, authz.DefaultPolicy(), authz.DefaultGuestPolicy())
+		unary = append(unary, authorizer.UnaryServerInterceptor())
+	}
+	unary = append(unary, a.timeoutInterceptor)
+
+	s := grpc.NewServer(
+		grpc.Creds(a.grpcServerCredentials()),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
 	generated.RegisterBookingServiceServer(s, a.Handlers.booking)
+
+	// стандартный grpc.health.v1 — по нему Consul проверяет gRPC-порт
+	a.healthServer = health.NewServer()
+	a.healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	a.healthServer.SetServingStatus(generated.BookingService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(s, a.healthServer)
+
 	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%s", a.config.app.Host, a.config.app.Grpc.Port))
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		a.fatal("failed to listen", err)
 	}
+	a.grpcServer = s
 
-	log.Printf("gRPC server started on port %s", lis.Addr().String())
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	go func() {
+		a.logger.Info("gRPC server started", "addr", lis.Addr().String())
+		if err := s.Serve(lis); err != nil {
+			a.fatal("failed to serve gRPC", err)
+		}
+	}()
+
+	if a.config.app.Grpc.HealthPort != "" {
+		a.initGRPCHealth()
 	}
 }
+
+// initGRPCHealth поднимает отдельный порт только с grpc.health.v1 и без
+// клиентских сертификатов: при mTLS основного порта по нему проверяет Consul
+func (a *App) initGRPCHealth() {
+	s := grpc.NewServer(grpc.Creds(a.grpcHealthCredentials()))
+	healthpb.RegisterHealthServer(s, a.healthServer)
+
+	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%s", a.config.app.Host, a.config.app.Grpc.HealthPort))
+	if err != nil {
+		a.fatal("failed to listen", err)
+	}
+	a.grpcHealthServer = s
+
+	go func() {
+		a.logger.Info("gRPC health server started", "addr", lis.Addr().String())
+		if err := s.Serve(lis); err != nil {
+			a.fatal("failed to serve gRPC health", err)
+		}
+	}()
+}
+
+// timeoutInterceptor ограничивает время обработки unary-вызова; значение
+// timeouts.request перечитывается при изменении конфига без рестарта
+func (a *App) timeoutInterceptor(
+	ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (any, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(a.requestTimeout.Load()))
+	defer cancel()
+	return handler(ctx, req)
+}
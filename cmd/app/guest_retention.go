@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"booking-service/internal/auth"
+	"booking-service/internal/entities"
+)
+
+// guestRetentionSubject под этим именем обезличивание по сроку хранения записывается в журнал аудита
+const guestRetentionSubject = "guest-retention"
+
+// initGuestRetention запускает обезличивание гостей, неактивных дольше срока
+// хранения. Реплики берут гостей с блокировкой строки и пропускают занятых,
+// поэтому задача может работать на всех репликах одновременно
+func (a *App) initGuestRetention() {
+	cfg := a.config.GuestRetention
+	if !cfg.Enabled {
+		return
+	}
+
+	opts := entities.GuestRetentionOptions{
+		InactiveAfter: cfg.InactiveAfter,
+		BatchSize:     cfg.BatchSize,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopGuestRetention = cancel
+	ctx = auth.WithPrincipal(ctx, &auth.Principal{Kind: auth.PrincipalKindService, Subject: guestRetentionSubject})
+	go a.runGuestRetention(ctx, cfg.Interval, opts)
+
+	a.logger.Info("guest retention enabled", "interval", cfg.Interval, "inactive_after", cfg.InactiveAfter)
+}
+
+func (a *App) runGuestRetention(ctx context.Context, interval time.Duration, opts entities.GuestRetentionOptions) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			erased, err := a.Controllers.BookingController.EraseInactiveGuests(ctx, opts)
+			if err != nil && ctx.Err() == nil {
+				a.logger.Warn("guest retention failed", "erased", erased, "error", err)
+				continue
+			}
+			if erased > 0 {
+				a.logger.Info("inactive guests erased", "erased", erased)
+			}
+		}
+	}
+}
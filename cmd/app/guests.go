@@ -0,0 +1,22 @@
+package app
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"booking-service/internal/pii"
+)
+
+// initGuests готовит шифрование номеров документов гостей
+func (a *App) initGuests() error {
+	key, err := base64.StdEncoding.DecodeString(a.config.Guests.DocumentKey)
+	if err != nil {
+		return fmt.Errorf("guests.document_key: %w", err)
+	}
+	cipher, err := pii.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	a.pii = cipher
+	return nil
+}
@@ -3,23 +3,34 @@ package app
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"errors"
 	"net/http"
 	"os"
 
+	"booking-service/internal/app"
+	"booking-service/internal/auth"
 	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/ratelimit"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	httpSwagger "github.com/swaggo/http-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 func (a *App) initHTTP() {
 	ctx := context.Background()
 	mainMux := http.NewServeMux()
-	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(gatewayOutgoingHeaderMatcher),
+	)
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(a.gatewayCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
 
 	// Регистрируем gRPC endpoint для HTTP шлюза
 	err := generated.RegisterBookingServiceHandlerFromEndpoint(
@@ -29,14 +40,19 @@ func (a *App) initHTTP() {
 		opts,
 	)
 	if err != nil {
-		log.Fatalf("failed to register gateway: %v", err)
+		a.fatal("failed to register gateway", err)
 	}
 
-	mainMux.Handle("/", mux) // grpc-gateway маршруты
+	// grpc-gateway маршруты; otelhttp извлекает traceparent из входящих заголовков
+	mainMux.Handle("/", otelhttp.NewHandler(mux, "grpc-gateway",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		}),
+	))
 
 	swaggerJSON, err := os.ReadFile("internal/generated/booking_service.swagger.json")
 	if err != nil {
-		log.Fatalf("failed to read swagger file: %v", err)
+		a.fatal("failed to read swagger file", err)
 	}
 
 	// Обработчик для самого swagger.json файла
@@ -69,8 +85,53 @@ func (a *App) initHTTP() {
 		w.Write([]byte(`{"status": "healthy"}`))
 	})
 
-	log.Printf("HTTP gateway started on %s", a.config.app.Host+":"+a.config.app.Port)
-	if err := http.ListenAndServe(a.config.app.Host+":"+a.config.app.Port, mainMux); err != nil {
-		log.Fatalf("failed to serve HTTP: %v", err)
+	a.httpServer = &http.Server{
+		Addr:    a.config.app.Host + ":" + a.config.app.Port,
+		Handler: logger.HTTPMiddleware(a.logger, mainMux),
+	}
+	if a.certs.http != nil {
+		a.httpServer.TLSConfig = a.certs.http.ServerConfig(false)
+	}
+
+	go func() {
+		a.logger.Info("HTTP gateway started", "addr", a.httpServer.Addr, "tls", a.certs.http != nil)
+		var err error
+		if a.certs.http != nil {
+			// сертификат берется из TLSConfig, поэтому пути к файлам не нужны
+			err = a.httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = a.httpServer.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			a.fatal("failed to serve HTTP", err)
+		}
+	}()
+}
+
+// gatewayHeaderMatcher пробрасывает X-Request-Id, X-Api-Key и X-Booking-Token из HTTP-запроса
+// в gRPC-метаданные; Authorization и остальные заголовки обрабатываются по
+// правилам grpc-gateway
+func gatewayHeaderMatcher(key string) (string, bool) {
+	switch http.CanonicalHeaderKey(key) {
+	case logger.RequestIDHeader:
+		return logger.RequestIDHeader, true
+	case auth.APIKeyHeader:
+		return auth.APIKeyHeader, true
+	case auth.BookingTokenHeader:
+		return auth.BookingTokenHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// gatewayOutgoingHeaderMatcher отдает retry-after из метаданных ответа как
+// стандартный Retry-After (grpc-gateway сам переводит ResourceExhausted в 429);
+// остальные метаданные по умолчанию получают префикс Grpc-Metadata-
+func gatewayOutgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case ratelimit.RetryAfterMetadataKey:
+		return "Retry-After", true
+	case app.ContentDispositionMetadataKey:
+		return "Content-Disposition", true
 	}
+	return runtime.MetadataHeaderPrefix + key, true
 }
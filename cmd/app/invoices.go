@@ -0,0 +1,15 @@
+package app
+
+import (
+	"booking-service/internal/invoice"
+)
+
+// initInvoices загружает шрифт для печати счетов
+func (a *App) initInvoices() error {
+	renderer, err := invoice.NewRenderer(a.config.Invoices.FontFile)
+	if err != nil {
+		return err
+	}
+	a.invoices = renderer
+	return nil
+}
@@ -0,0 +1,21 @@
+package app
+
+import (
+	"log/slog"
+	"os"
+
+	"booking-service/internal/logger"
+)
+
+func (a *App) initLogger() error {
+	level, err := logger.ParseLevel(a.config.Logger.Level)
+	if err != nil {
+		return err
+	}
+
+	a.logLevel = new(slog.LevelVar)
+	a.logLevel.Set(level)
+	a.logger = logger.New(os.Stdout, a.logLevel)
+	slog.SetDefault(a.logger)
+	return nil
+}
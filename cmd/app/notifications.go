@@ -0,0 +1,57 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"booking-service/internal/notification"
+)
+
+func (a *App) initNotificationPublisher() {
+	cfg := a.config.Notifications
+	if !cfg.Enabled {
+		return
+	}
+	// соединение открывается при первой публикации, поэтому недоступный
+	// брокер не мешает запуску
+	a.Clients.notifications = notification.NewPublisher(cfg.AMQPURL, cfg.Exchange)
+}
+
+// initNotifications запускает отправку уведомлений из outbox. Реплики
+// блокируют уведомления и пропускают занятые другими
+func (a *App) initNotifications() {
+	cfg := a.config.Notifications
+	if !cfg.Enabled {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopNotifications = cancel
+	go a.runNotifications(ctx, cfg.Interval, cfg.BatchSize)
+
+	a.logger.Info("notifications dispatch enabled", "interval", cfg.Interval, "exchange", cfg.Exchange)
+}
+
+func (a *App) runNotifications(ctx context.Context, interval time.Duration, batchSize int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// проход не дольше интервала: зависшая публикация не блокирует следующие
+			passCtx, cancel := context.WithTimeout(ctx, interval)
+			sent, err := a.Controllers.BookingController.DispatchNotifications(passCtx, batchSize)
+			cancel()
+			if err != nil && ctx.Err() == nil {
+				a.logger.Warn("notifications dispatch failed", "sent", sent, "error", err)
+				continue
+			}
+			if sent > 0 {
+				a.logger.Info("notifications dispatched", "sent", sent)
+			}
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package app
+
+import (
+	"booking-service/internal/pricing"
+)
+
+// initPricing настраивает подпись предложений QuoteStay
+func (a *App) initPricing() error {
+	tokens, err := pricing.NewQuoteTokens(a.config.Pricing.QuoteTokenSecret, a.config.Pricing.QuoteTokenTTL)
+	if err != nil {
+		return err
+	}
+	a.quoteTokens = tokens
+	return nil
+}
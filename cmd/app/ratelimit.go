@@ -0,0 +1,47 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/ratelimit"
+)
+
+// initRateLimit настраивает лимиты запросов; с хранилищем postgres корзины
+// общие для всех реплик, иначе каждая реплика считает лимит отдельно
+func (a *App) initRateLimit() {
+	cfg := a.config.RateLimit
+	if !cfg.Enabled {
+		return
+	}
+
+	var store ratelimit.Store
+	switch cfg.Store {
+	case rateLimitStorePostgres:
+		store = ratelimit.NewPostgresStore(a.PostgreSQL)
+	default:
+		store = ratelimit.NewMemoryStore()
+	}
+
+	budgets := make([]ratelimit.Budget, 0, len(cfg.Budgets))
+	for name, b := range cfg.Budgets {
+		budgets = append(budgets, ratelimit.Budget{
+			Name:    name,
+			Limit:   ratelimit.Limit{Rate: b.Rate, Burst: b.Burst},
+			Methods: b.Methods,
+		})
+	}
+
+	a.rateLimiter = ratelimit.NewLimiter(store, ratelimit.Config{
+		Default:        ratelimit.Limit{Rate: cfg.Default.Rate, Burst: cfg.Default.Burst},
+		PerIP:          ratelimit.Limit{Rate: cfg.PerIP.Rate, Burst: cfg.PerIP.Burst},
+		Budgets:        budgets,
+		TrustedProxies: cfg.TrustedProxies,
+		TrustedPeers:   cfg.TrustedProxyCIDRs,
+	}, a.logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopRateLimit = cancel
+	go a.rateLimiter.Run(ctx, cfg.CleanupInterval)
+
+	a.logger.Info("rate limiting enabled", "store", cfg.Store, "budgets", len(budgets))
+}
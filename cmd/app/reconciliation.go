@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"booking-service/internal/auth"
+	"booking-service/internal/entities"
+
+	"github.com/google/uuid"
+)
+
+// reconcilerSubject под этим именем сверка записывается в журнал аудита
+const reconcilerSubject = "payment-reconciler"
+
+// initReconciliation запускает фоновую сверку оплат. Реплики конкурируют за
+// аренду задачи в БД, поэтому проход в каждый момент выполняет одна из них;
+// аренда берется на два интервала и переходит к другой реплике, если держатель остановился
+func (a *App) initReconciliation() {
+	cfg := a.config.Reconciliation
+	if !cfg.Enabled {
+		return
+	}
+
+	holder := uuid.NewString()
+	if host, err := os.Hostname(); err == nil {
+		holder = host + "-" + holder
+	}
+	opts := entities.ReconciliationOptions{
+		Lookback:         cfg.Lookback,
+		RecheckAfter:     cfg.RecheckAfter,
+		PaymentDueBefore: cfg.PaymentDueBefore,
+		BatchSize:        cfg.BatchSize,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopReconciliation = cancel
+	ctx = auth.WithPrincipal(ctx, &auth.Principal{Kind: auth.PrincipalKindService, Subject: reconcilerSubject})
+	go a.runReconciliation(ctx, holder, cfg.Interval, opts)
+
+	a.logger.Info("payment reconciliation enabled", "interval", cfg.Interval, "holder", holder)
+}
+
+func (a *App) runReconciliation(
+	ctx context.Context, holder string, interval time.Duration, opts entities.ReconciliationOptions,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := a.Controllers.BookingController.ReconcilePayments(ctx, holder, 2*interval, opts)
+			if err != nil && ctx.Err() == nil {
+				a.logger.Warn("payment reconciliation failed", "reconciled", n, "error", err)
+				continue
+			}
+			if n > 0 {
+				a.logger.Info("payment reconciliation completed", "reconciled", n)
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"booking-service/internal/logger"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+// watchConfig применяет безопасные к перезагрузке настройки (уровень логов,
+// таймауты запросов) при изменении файла конфига. Остальные параметры —
+// адреса, порты, доступы к БД — требуют рестарта и только логируются.
+func (a *App) watchConfig() {
+	a.applyReloadable(a.config)
+
+	viper.OnConfigChange(func(e fsnotify.Event) {
+		cfg, err := readConfig()
+		if err == nil {
+			err = cfg.Validate()
+		}
+		if err != nil {
+			a.logger.Error("config reload rejected, keeping previous settings", "file", e.Name, "error", err)
+			return
+		}
+
+		a.applyReloadable(cfg)
+		a.logger.Info("config reloaded", "file", e.Name,
+			"logger.level", cfg.Logger.Level,
+			"timeouts.request", cfg.Timeouts.Request,
+		)
+	})
+	viper.WatchConfig()
+}
+
+func (a *App) applyReloadable(cfg *Config) {
+	if level, err := logger.ParseLevel(cfg.Logger.Level); err == nil {
+		a.logLevel.Set(level)
+	}
+	a.requestTimeout.Store(int64(cfg.Timeouts.Request))
+}
@@ -1,8 +1,12 @@
 package app
 
 import (
+	"context"
+
 	"booking-service/internal/app"
 	"booking-service/internal/controllers"
+	"booking-service/internal/entities"
+	"booking-service/internal/payment"
 	"booking-service/internal/storage"
 )
 
@@ -12,14 +16,28 @@ func (a *App) initDB() error {
 		return err
 	}
 	a.PostgreSQL = db
-	a.Storage = storage.New()
+	a.Storage = storage.New(a.pii)
 	return nil
 }
 
 func (a *App) initControllers() {
-	a.Controllers.BookingController = controllers.New(a.PostgreSQL, a.Storage)
+	// без брокера контроллер получает nil: уведомления копятся в outbox
+	var notifier interface {
+		Publish(ctx context.Context, n entities.Notification) error
+	}
+	if a.Clients.notifications != nil {
+		notifier = a.Clients.notifications
+	}
+	matching := entities.GuestMatching{NameSimilarity: a.config.Guests.MatchNameSimilarity}
+	for _, key := range a.config.Guests.MatchKeys {
+		matching.Keys = append(matching.Keys, entities.GuestMatchKey(key))
+	}
+	a.Controllers.BookingController = controllers.New(
+		a.PostgreSQL, a.Storage, payment.New(a.Clients.payment), a.invoices, notifier, matching,
+		a.config.Pricing.BaseCurrency,
+	)
 }
 
 func (a *App) initHandlers() {
-	a.Handlers.booking = app.New(a.Controllers.BookingController)
+	a.Handlers.booking = app.New(a.Controllers.BookingController, a.bookingTokens, a.quoteTokens)
 }
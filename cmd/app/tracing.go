@@ -0,0 +1,22 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/tracing"
+)
+
+func (a *App) initTracing() error {
+	shutdown, err := tracing.Init(context.Background(), tracing.Config{
+		ServiceName: a.config.Tracing.ServiceName,
+		Exporter:    a.config.Tracing.Exporter,
+		Endpoint:    a.config.Tracing.Endpoint,
+		Insecure:    a.config.Tracing.Insecure,
+		SampleRatio: a.config.Tracing.SampleRatio,
+	})
+	if err != nil {
+		return err
+	}
+	a.shutdownTracing = shutdown
+	return nil
+}
@@ -1,10 +1,15 @@
 package main
 
 import (
+	"flag"
+
 	"booking-service/cmd/app"
 )
 
 func main() {
-	app := app.New()
+	configPath := flag.String("config", "", "path to config file or directory (overrides CONFIG_PATH)")
+	flag.Parse()
+
+	app := app.New(*configPath)
 	app.Run()
 }
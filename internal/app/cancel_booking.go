@@ -3,10 +3,11 @@ package app
 import (
 	"context"
 	"errors"
-	"log"
 
 	"booking-service/internal/entities"
 	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -15,7 +16,11 @@ import (
 func (h *Handler) CancelBooking(ctx context.Context, in *generated.CancelBookingRequest) (
 	*generated.CancelBookingResponse, error,
 ) {
-	log.Printf("[handlers.CancelBooking] received request with: %+v", in)
+	ctx, span := tracing.Start(ctx, "handlers.CancelBooking")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "CancelBooking", "request", logger.Redact(in))
 
 	err := h.bookingController.CancelBooking(ctx, in.BookingId)
 	if err != nil {
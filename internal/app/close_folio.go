@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) CloseFolio(ctx context.Context, in *generated.CloseFolioRequest) (
+	*generated.CloseFolioResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.CloseFolio")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "CloseFolio", "request", logger.Redact(in))
+
+	folio, err := h.bookingController.CloseFolio(ctx, in.GetBookingId())
+	if err != nil {
+		return nil, folioError(err)
+	}
+
+	return &generated.CloseFolioResponse{
+		Folio: makeFolioToResponse(folio),
+	}, nil
+}
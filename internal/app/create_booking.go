@@ -3,10 +3,13 @@ package app
 import (
 	"context"
 	"errors"
-	"log"
+	"slices"
 
+	"booking-service/internal/auth"
 	"booking-service/internal/entities"
 	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,31 +19,89 @@ import (
 func (h *Handler) CreateBooking(ctx context.Context, in *generated.CreateBookingRequest) (
 	*generated.CreateBookingResponse, error,
 ) {
-	log.Printf("[handlers.CreateBooking] received request: %v", in)
+	ctx, span := tracing.Start(ctx, "handlers.CreateBooking")
+	defer span.End()
 
-	booking, err := h.bookingController.CreateBooking(ctx, h.makeBookingDTO(in))
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "CreateBooking", "request", logger.Redact(in))
+
+	input := h.makeBookingDTO(in)
+	if in.GetQuoteToken() != "" {
+		quote, err := h.quoteTokens.Parse(in.GetQuoteToken())
+		if err != nil {
+			switch {
+			case errors.Is(err, entities.ErrQuoteExpired):
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			default:
+				return nil, status.Error(codes.InvalidArgument, entities.ErrInvalidQuote.Error())
+			}
+		}
+		input.Quote = &quote
+	}
+
+	booking, newGuestIDs, err := h.bookingController.CreateBooking(ctx, input)
 	if err != nil {
 		switch {
-		case errors.Is(err, entities.ErrStartDateIsAfterEndDate):
+		case errors.Is(err, entities.ErrStartDateIsAfterEndDate) ||
+			errors.Is(err, entities.ErrStartDateInPast) ||
+			errors.Is(err, entities.ErrRateRangeTooLong):
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		case errors.Is(err, entities.ErrRoomNotAvailable):
 			return nil, status.Error(codes.InvalidArgument, "room is not available")
+		case errors.Is(err, entities.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "room not found")
+		case errors.Is(err, entities.ErrNameIsRequired) ||
+			errors.Is(err, entities.ErrNameIsTooLong) ||
+			errors.Is(err, entities.ErrInvalidEmail) ||
+			errors.Is(err, entities.ErrInvalidPhone) ||
+			errors.Is(err, entities.ErrInvalidCountryCode) ||
+			errors.Is(err, entities.ErrInvalidDocument):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, entities.ErrEmptyStay) ||
+			errors.Is(err, entities.ErrQuoteMismatch) ||
+			errors.Is(err, entities.ErrPromoCodeNotFound) ||
+			errors.Is(err, entities.ErrPromoCodeNotApplicable):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, entities.ErrPromoCodeExhausted) ||
+			errors.Is(err, entities.ErrPaymentDeclined):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, entities.ErrPaymentUnavailable):
+			return nil, status.Error(codes.Unavailable, err.Error())
+		case errors.Is(err, entities.ErrNoRateForNight) ||
+			errors.Is(err, entities.ErrCurrencyMismatch) ||
+			errors.Is(err, entities.ErrTaxCurrencyMismatch):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
 		default:
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 	}
 
+	var accessToken string
+	if h.bookingTokens != nil {
+		// найденные сопоставлением профили в токен не попадают
+		accessToken, err = h.bookingTokens.Issue(booking.ID, newGuestIDs, booking.EndDate)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	return &generated.CreateBookingResponse{
-		Booking: h.makeBookingToResponse(booking),
+		Booking:     h.makeBookingToResponse(withGuestProfiles(booking, newGuestIDs)),
+		AccessToken: accessToken,
 	}, nil
 }
 
 func (h *Handler) makeBookingDTO(in *generated.CreateBookingRequest) entities.CreateBookingDTO {
 	guests := make([]entities.GuestDTO, 0, len(in.Guests))
 	for _, g := range in.Guests {
-		guests = append(guests, entities.GuestDTO{
-			Name: g.Name,
-		})
+		guest := entities.GuestDTO{
+			Name:      g.Name,
+			Email:     g.Email,
+			Phone:     g.Phone,
+			TaxExempt: g.TaxExempt,
+		}
+		setGuestDocument(&guest, g.Document)
+		guests = append(guests, guest)
 	}
 
 	return entities.CreateBookingDTO{
@@ -49,28 +110,120 @@ func (h *Handler) makeBookingDTO(in *generated.CreateBookingRequest) entities.Cr
 		EndDate:   in.EndDate.AsTime(),
 		Comment:   in.Comment,
 		Guests:    guests,
+		PromoCode: in.PromoCode,
 	}
 }
 
 func (h *Handler) makeBookingToResponse(in entities.Booking) *generated.Booking {
+	guests := make([]*generated.Guest, 0, len(in.Guests))
+	for _, g := range in.Guests {
+		guests = append(guests, h.makeGuestToResponse(g))
+	}
 
-	return &generated.Booking{
-		Id:        in.ID,
-		CreatedAt: timestamppb.New(in.CreatedAt),
-		UpdatedAt: timestamppb.New(in.UpdatedAt),
-		RoomId:    in.RoomID,
-		StartDate: timestamppb.New(in.StartDate),
-		EndDate:   timestamppb.New(in.EndDate),
-		Comment:   in.Comment,
-		Status:    generated.BookingStatus(in.Status),
+	res := &generated.Booking{
+		Id:            in.ID,
+		CreatedAt:     timestamppb.New(in.CreatedAt),
+		UpdatedAt:     timestamppb.New(in.UpdatedAt),
+		RoomId:        in.RoomID,
+		StartDate:     timestamppb.New(in.StartDate),
+		EndDate:       timestamppb.New(in.EndDate),
+		Comment:       in.Comment,
+		Status:        generated.BookingStatus(in.Status),
+		Guests:        guests,
+		Price:         makePriceToResponse(in.Price),
+		Cancellation:  makeCancellationToResponse(in.Cancellation),
+		PaymentStatus: bookingPaymentStatusesToProto[in.PaymentStatus],
+		AmountPaid:    moneyToProto(in.AmountPaid),
+	}
+	if !in.BalanceDueAt.IsZero() {
+		res.BalanceDueAt = timestamppb.New(in.BalanceDueAt)
+	}
+	return res
+}
+
+var bookingPaymentStatusesToProto = map[entities.BookingPaymentStatus]generated.BookingPaymentStatus{
+	entities.BookingPaymentStatusUnpaid:        generated.BookingPaymentStatus_BOOKING_PAYMENT_STATUS_UNPAID,
+	entities.BookingPaymentStatusPartiallyPaid: generated.BookingPaymentStatus_BOOKING_PAYMENT_STATUS_PARTIALLY_PAID,
+	entities.BookingPaymentStatusPaid:          generated.BookingPaymentStatus_BOOKING_PAYMENT_STATUS_PAID,
+	entities.BookingPaymentStatusRefunded:      generated.BookingPaymentStatus_BOOKING_PAYMENT_STATUS_REFUNDED,
+}
+
+func makePriceToResponse(in entities.PriceSnapshot) *generated.BookingPrice {
+	nights := make([]*generated.NightPrice, 0, len(in.Nights))
+	for _, n := range in.Nights {
+		nights = append(nights, &generated.NightPrice{
+			Date:        timestamppb.New(n.Date),
+			Price:       moneyToProto(n.Price),
+			RatePlanId:  n.RatePlanID,
+			Override:    n.Override,
+			BasePrice:   moneyToProto(n.BasePrice),
+			Adjustments: makeAdjustmentsToResponse(n.Adjustments),
+		})
+	}
+
+	return &generated.BookingPrice{
+		Total:    moneyToProto(in.Total),
+		Nights:   nights,
+		Subtotal: moneyToProto(in.Subtotal),
+		Taxes:    moneyToProto(in.Taxes),
+		Fees:     moneyToProto(in.Fees),
+		Items:    makePriceItemsToResponse(in.Items),
+		Discount: makeDiscountToResponse(in.Discount),
+	}
+}
+
+func makeDiscountToResponse(in *entities.Discount) *generated.Discount {
+	if in == nil {
+		return nil
+	}
+	return &generated.Discount{
+		PromotionId: in.PromotionID,
+		Code:        in.Code,
+		Amount:      moneyToProto(in.Amount),
+	}
+}
+
+func makePriceItemsToResponse(in []entities.PriceItem) []*generated.PriceItem {
+	items := make([]*generated.PriceItem, 0, len(in))
+	for _, i := range in {
+		items = append(items, &generated.PriceItem{
+			TaxRuleId: i.TaxRuleID,
+			Kind:      taxKindToProto(i.Kind),
+			Name:      i.Name,
+			Quantity:  uint32(i.Quantity),
+			Amount:    moneyToProto(i.Amount),
+		})
+	}
+	return items
+}
+
+// guestBooking скрывает от гостя по токену профили попутчиков, не выданные его токену
+func guestBooking(ctx context.Context, in entities.Booking) entities.Booking {
+	if p := auth.PrincipalFromContext(ctx); p != nil && p.Kind == auth.PrincipalKindGuest {
+		return withGuestProfiles(in, p.GuestIDs)
+	}
+	return in
+}
+
+// withGuestProfiles оставляет профиль только гостям guestIDs, у остальных в ответе одно имя
+func withGuestProfiles(in entities.Booking, guestIDs []uint64) entities.Booking {
+	guests := make([]entities.Guest, 0, len(in.Guests))
+	for _, g := range in.Guests {
+		if !slices.Contains(guestIDs, g.ID) {
+			g = entities.Guest{Name: g.Name}
+		}
+		guests = append(guests, g)
 	}
+	in.Guests = guests
+	return in
 }
 
+// makeGuestToResponse гость бронирования: профиль в ответы по бронированиям не попадает
 func (h *Handler) makeGuestToResponse(in entities.Guest) *generated.Guest {
 	return &generated.Guest{
 		Id:        in.ID,
-		CreatedAt: timestamppb.New(in.CreatedAt),
-		UpdatedAt: timestamppb.New(in.UpdatedAt),
+		CreatedAt: optionalTimestamp(in.CreatedAt),
+		UpdatedAt: optionalTimestamp(in.UpdatedAt),
 		Name:      in.Name,
 	}
 }
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) CreateEmployee(ctx context.Context, in *generated.CreateEmployeeRequest) (
+	*generated.CreateEmployeeResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.CreateEmployee")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "CreateEmployee", "request", logger.Redact(in))
+
+	employee, err := h.bookingController.CreateEmployee(ctx, entities.EmployeeDTO{
+		Name:    in.GetName(),
+		Role:    employeeRoleFromProto(in.GetRole()),
+		HotelID: in.GetHotelId(),
+		Subject: in.GetSubject(),
+	})
+	if err != nil {
+		return nil, employeeError(err)
+	}
+
+	return &generated.CreateEmployeeResponse{
+		Employee: h.makeEmployeeToResponse(employee),
+	}, nil
+}
+
+func employeeError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrNameIsRequired) ||
+		errors.Is(err, entities.ErrNameIsTooLong) ||
+		errors.Is(err, entities.ErrInvalidRole) ||
+		errors.Is(err, entities.ErrHotelIsRequired) ||
+		errors.Is(err, entities.ErrSubjectIsRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entities.ErrNotFound):
+		return status.Error(codes.NotFound, "employee not found")
+	case errors.Is(err, entities.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, "employee with this subject already exists")
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+var employeeRolesToProto = map[entities.EmployeeRole]generated.EmployeeRole{
+	entities.EmployeeRoleAdmin:        generated.EmployeeRole_EMPLOYEE_ROLE_ADMIN,
+	entities.EmployeeRoleManager:      generated.EmployeeRole_EMPLOYEE_ROLE_MANAGER,
+	entities.EmployeeRoleReceptionist: generated.EmployeeRole_EMPLOYEE_ROLE_RECEPTIONIST,
+	entities.EmployeeRoleHousekeeping: generated.EmployeeRole_EMPLOYEE_ROLE_HOUSEKEEPING,
+}
+
+func employeeRoleFromProto(role generated.EmployeeRole) entities.EmployeeRole {
+	for k, v := range employeeRolesToProto {
+		if v == role {
+			return k
+		}
+	}
+	return ""
+}
+
+func (h *Handler) makeEmployeeToResponse(in entities.Employee) *generated.Employee {
+	return &generated.Employee{
+		Id:        in.ID,
+		CreatedAt: timestamppb.New(in.CreatedAt),
+		UpdatedAt: timestamppb.New(in.UpdatedAt),
+		Name:      in.Name,
+		Role:      employeeRolesToProto[in.Role],
+		HotelId:   in.HotelID,
+		Subject:   in.Subject,
+	}
+}
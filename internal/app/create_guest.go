@@ -2,33 +2,33 @@ package app
 
 import (
 	"context"
-	"errors"
-	"log"
 
 	"booking-service/internal/entities"
 	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (h *Handler) CreateGuest(ctx context.Context, in *generated.CreateGuestRequest) (
 	*generated.CreateGuestResponse, error,
 ) {
-	log.Printf("[handlers.CreateGuest]: received request: %v", in)
+	ctx, span := tracing.Start(ctx, "handlers.CreateGuest")
+	defer span.End()
 
-	guest, err := h.bookingController.CreateGuest(ctx, entities.GuestDTO{
-		Name: in.GetName(),
-	})
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "CreateGuest", "request", logger.Redact(in))
+
+	input := entities.GuestDTO{
+		Name:  in.GetName(),
+		Email: in.GetEmail(),
+		Phone: in.GetPhone(),
+	}
+	setGuestDocument(&input, in.GetDocument())
+	guest, err := h.bookingController.CreateGuest(ctx, input)
 	if err != nil {
-		switch {
-		case errors.Is(err, entities.ErrNameIsRequired) ||
-			errors.Is(err, entities.ErrNameIsTooLong):
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
-		}
+		return nil, guestError(err)
 	}
 
 	return &generated.CreateGuestResponse{
@@ -40,3 +40,20 @@ func (h *Handler) CreateGuest(ctx context.Context, in *generated.CreateGuestRequ
 		},
 	}, nil
 }
+
+// setGuestDocument переносит документ из запроса в данные гостя
+func setGuestDocument(dto *entities.GuestDTO, d *generated.IdentityDocument) {
+	if d == nil {
+		return
+	}
+	for k, v := range documentTypesToProto {
+		if v == d.GetType() {
+			dto.DocumentType = k
+		}
+	}
+	dto.DocumentNumber = d.GetNumber()
+	dto.DocumentCountry = d.GetIssuingCountry()
+	if d.GetExpiresAt() != nil {
+		dto.DocumentExpiresAt = d.GetExpiresAt().AsTime()
+	}
+}
@@ -2,19 +2,45 @@ package app
 
 import (
 	"context"
-	"log"
+	"errors"
 
+	"booking-service/internal/entities"
 	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (h *Handler) CreateHotel(ctx context.Context, req *generated.CreateHotelRequest) (*generated.CreateHotelResponse, error) {
-	log.Printf("[handlers.CreateHotel] received request: %v", req)
+	ctx, span := tracing.Start(ctx, "handlers.CreateHotel")
+	defer span.End()
 
-	hotel, err := h.bookingController.CreateHotel(ctx, req.GetName())
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "CreateHotel", "request", logger.Redact(req))
+
+	input := entities.CreateHotelDTO{
+		Name:     req.GetName(),
+		Currency: req.GetCurrency(),
+	}
+	if p := req.GetCancellationPolicy(); p != nil {
+		input.CancellationPolicy = &entities.CancellationPolicy{
+			FreeCancellationHours:     int(p.GetFreeCancellationHours()),
+			CancellationPenaltyNights: int(p.GetCancellationPenaltyNights()),
+		}
+	}
+
+	hotel, err := h.bookingController.CreateHotel(ctx, input)
 	if err != nil {
-		return nil, err
+		switch {
+		case errors.Is(err, entities.ErrInvalidCancellation) ||
+			errors.Is(err, entities.ErrInvalidCurrency):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, err
+		}
 	}
 
 	return &generated.CreateHotelResponse{
@@ -23,6 +49,11 @@ func (h *Handler) CreateHotel(ctx context.Context, req *generated.CreateHotelReq
 			CreatedAt: timestamppb.New(hotel.CreatedAt),
 			UpdatedAt: timestamppb.New(hotel.UpdatedAt),
 			Name:      hotel.Name,
+			Currency:  hotel.Currency,
+			CancellationPolicy: &generated.CancellationPolicy{
+				FreeCancellationHours:     uint32(hotel.FreeCancellationHours),
+				CancellationPenaltyNights: uint32(hotel.CancellationPenaltyNights),
+			},
 		},
 	}, nil
 }
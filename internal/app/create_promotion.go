@@ -0,0 +1,120 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var discountTypesToProto = map[entities.DiscountType]generated.DiscountType{
+	entities.DiscountTypePercent: generated.DiscountType_DISCOUNT_TYPE_PERCENT,
+	entities.DiscountTypeFixed:   generated.DiscountType_DISCOUNT_TYPE_FIXED,
+}
+
+func (h *Handler) CreatePromotion(ctx context.Context, in *generated.CreatePromotionRequest) (
+	*generated.CreatePromotionResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.CreatePromotion")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "CreatePromotion", "request", logger.Redact(in))
+
+	promotion, err := h.bookingController.CreatePromotion(ctx, promotionDTOFromProto(in.GetTerms()))
+	if err != nil {
+		return nil, promotionError(err, "hotel not found")
+	}
+
+	return &generated.CreatePromotionResponse{
+		Promotion: makePromotionToResponse(promotion),
+	}, nil
+}
+
+func promotionError(err error, notFound string) error {
+	switch {
+	case errors.Is(err, entities.ErrInvalidPromoCode) ||
+		errors.Is(err, entities.ErrInvalidRoomType) ||
+		errors.Is(err, entities.ErrInvalidDiscount) ||
+		errors.Is(err, entities.ErrInvalidCurrency) ||
+		errors.Is(err, entities.ErrHotelCurrencyMismatch) ||
+		errors.Is(err, entities.ErrInvalidValidityPeriod) ||
+		errors.Is(err, entities.ErrInvalidMinNights) ||
+		errors.Is(err, entities.ErrInvalidMaxRedemptions):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entities.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, "promo code already exists")
+	case errors.Is(err, entities.ErrNotFound):
+		return status.Error(codes.NotFound, notFound)
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func promotionDTOFromProto(in *generated.PromotionTerms) entities.PromotionDTO {
+	roomTypes := make([]string, 0, len(in.GetRoomTypes()))
+	for _, t := range in.GetRoomTypes() {
+		roomTypes = append(roomTypes, roomTypeFromProto(t))
+	}
+
+	var discountType entities.DiscountType
+	for k, v := range discountTypesToProto {
+		if v == in.GetDiscountType() {
+			discountType = k
+		}
+	}
+
+	return entities.PromotionDTO{
+		Code:           in.GetCode(),
+		HotelID:        in.GetHotelId(),
+		RoomTypes:      roomTypes,
+		DiscountType:   discountType,
+		PercentOff:     int(in.GetPercentOff()),
+		AmountOff:      moneyFromProto(in.GetAmountOff()),
+		StayFrom:       optionalTime(in.GetStayFrom()),
+		StayTo:         optionalTime(in.GetStayTo()),
+		MinNights:      int(in.GetMinNights()),
+		BookFrom:       optionalTime(in.GetBookFrom()),
+		BookTo:         optionalTime(in.GetBookTo()),
+		MaxRedemptions: int(in.GetMaxRedemptions()),
+	}
+}
+
+func makePromotionToResponse(in entities.Promotion) *generated.Promotion {
+	roomTypes := make([]generated.RoomType, 0, len(in.RoomTypes))
+	for _, t := range in.RoomTypes {
+		roomTypes = append(roomTypes, generated.RoomType(generated.RoomType_value[t]))
+	}
+
+	terms := &generated.PromotionTerms{
+		Code:           in.Code,
+		HotelId:        in.HotelID,
+		RoomTypes:      roomTypes,
+		DiscountType:   discountTypesToProto[in.DiscountType],
+		PercentOff:     uint32(in.PercentOff),
+		StayFrom:       optionalTimestamp(in.StayFrom),
+		StayTo:         optionalTimestamp(in.StayTo),
+		MinNights:      uint32(in.MinNights),
+		BookFrom:       optionalTimestamp(in.BookFrom),
+		BookTo:         optionalTimestamp(in.BookTo),
+		MaxRedemptions: uint32(in.MaxRedemptions),
+	}
+	if in.DiscountType == entities.DiscountTypeFixed {
+		terms.AmountOff = moneyToProto(in.AmountOff)
+	}
+
+	return &generated.Promotion{
+		Id:          in.ID,
+		CreatedAt:   timestamppb.New(in.CreatedAt),
+		UpdatedAt:   timestamppb.New(in.UpdatedAt),
+		Terms:       terms,
+		Redemptions: uint32(in.Redemptions),
+	}
+}
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) CreateRatePlan(ctx context.Context, in *generated.CreateRatePlanRequest) (
+	*generated.CreateRatePlanResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.CreateRatePlan")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "CreateRatePlan", "request", logger.Redact(in))
+
+	plan, err := h.bookingController.CreateRatePlan(ctx, entities.RatePlanDTO{
+		HotelID:      in.GetHotelId(),
+		RoomType:     roomTypeFromProto(in.GetRoomType()),
+		Name:         in.GetName(),
+		NightlyPrice: moneyFromProto(in.GetNightlyPrice()),
+		ValidFrom:    optionalTime(in.GetValidFrom()),
+		ValidTo:      optionalTime(in.GetValidTo()),
+	})
+	if err != nil {
+		return nil, ratePlanError(err, "hotel not found")
+	}
+
+	return &generated.CreateRatePlanResponse{
+		RatePlan: h.makeRatePlanToResponse(plan),
+	}, nil
+}
+
+func ratePlanError(err error, notFound string) error {
+	switch {
+	case errors.Is(err, entities.ErrNameIsRequired) ||
+		errors.Is(err, entities.ErrNameIsTooLong) ||
+		errors.Is(err, entities.ErrInvalidRoomType) ||
+		errors.Is(err, entities.ErrInvalidPrice) ||
+		errors.Is(err, entities.ErrInvalidCurrency) ||
+		errors.Is(err, entities.ErrHotelCurrencyMismatch) ||
+		errors.Is(err, entities.ErrInvalidValidityPeriod):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entities.ErrNotFound):
+		return status.Error(codes.NotFound, notFound)
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// roomTypeFromProto возвращает тип номера в том виде, в котором он хранится у комнат
+func roomTypeFromProto(t generated.RoomType) string {
+	if t == generated.RoomType_ROOM_TYPE_UNKNOWN {
+		return ""
+	}
+	return t.String()
+}
+
+func moneyFromProto(in *generated.Money) entities.Money {
+	return entities.Money{
+		Amount:   in.GetAmountMinor(),
+		Currency: in.GetCurrency(),
+	}
+}
+
+func moneyToProto(in entities.Money) *generated.Money {
+	return &generated.Money{
+		AmountMinor: in.Amount,
+		Currency:    in.Currency,
+	}
+}
+
+func optionalTime(in *timestamppb.Timestamp) time.Time {
+	if in == nil {
+		return time.Time{}
+	}
+	return in.AsTime()
+}
+
+func optionalTimestamp(in time.Time) *timestamppb.Timestamp {
+	if in.IsZero() {
+		return nil
+	}
+	return timestamppb.New(in)
+}
+
+func (h *Handler) makeRatePlanToResponse(in entities.RatePlan) *generated.RatePlan {
+	return &generated.RatePlan{
+		Id:           in.ID,
+		CreatedAt:    timestamppb.New(in.CreatedAt),
+		UpdatedAt:    timestamppb.New(in.UpdatedAt),
+		HotelId:      in.HotelID,
+		RoomType:     generated.RoomType(generated.RoomType_value[in.RoomType]),
+		Name:         in.Name,
+		NightlyPrice: moneyToProto(in.NightlyPrice),
+		ValidFrom:    timestamppb.New(in.ValidFrom),
+		ValidTo:      optionalTimestamp(in.ValidTo),
+	}
+}
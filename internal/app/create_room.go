@@ -2,14 +2,19 @@ package app
 
 import (
 	"context"
-	"log"
 
 	"booking-service/internal/entities"
 	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
 )
 
 func (h *Handler) CreateRoom(ctx context.Context, in *generated.CreateRoomRequest) (*generated.CreateRoomResponse, error) {
-	log.Printf("[CreateRoom]: Handling CreateRoom request: %+v", in)
+	ctx, span := tracing.Start(ctx, "handlers.CreateRoom")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "CreateRoom", "request", logger.Redact(in))
 
 	err := h.bookingController.CreateRooms(ctx, h.convertRooms(in.GetDto()))
 	if err != nil {
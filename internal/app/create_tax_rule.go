@@ -0,0 +1,97 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var taxKindsToProto = map[entities.TaxKind]generated.TaxKind{
+	entities.TaxKindCityTax: generated.TaxKind_TAX_KIND_CITY_TAX,
+	entities.TaxKindVAT:     generated.TaxKind_TAX_KIND_VAT,
+	entities.TaxKindFee:     generated.TaxKind_TAX_KIND_FEE,
+}
+
+func (h *Handler) CreateTaxRule(ctx context.Context, in *generated.CreateTaxRuleRequest) (
+	*generated.CreateTaxRuleResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.CreateTaxRule")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "CreateTaxRule", "request", logger.Redact(in))
+
+	rule, err := h.bookingController.CreateTaxRule(ctx, entities.TaxRule{
+		HotelID:            in.GetHotelId(),
+		Kind:               taxKindFromProto(in.GetKind()),
+		Name:               in.GetName(),
+		Amount:             moneyFromProto(in.GetAmount()),
+		PercentBasisPoints: int(in.GetPercentBasisPoints()),
+		ValidFrom:          optionalTime(in.GetValidFrom()),
+		ValidTo:            optionalTime(in.GetValidTo()),
+	})
+	if err != nil {
+		return nil, taxRuleError(err, "hotel not found")
+	}
+
+	return &generated.CreateTaxRuleResponse{
+		TaxRule: makeTaxRuleToResponse(rule),
+	}, nil
+}
+
+func taxRuleError(err error, notFound string) error {
+	switch {
+	case errors.Is(err, entities.ErrNameIsRequired) ||
+		errors.Is(err, entities.ErrNameIsTooLong) ||
+		errors.Is(err, entities.ErrInvalidTaxKind) ||
+		errors.Is(err, entities.ErrInvalidTaxPercent) ||
+		errors.Is(err, entities.ErrInvalidPrice) ||
+		errors.Is(err, entities.ErrInvalidCurrency) ||
+		errors.Is(err, entities.ErrHotelCurrencyMismatch) ||
+		errors.Is(err, entities.ErrInvalidValidityPeriod):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entities.ErrNotFound):
+		return status.Error(codes.NotFound, notFound)
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func taxKindFromProto(k generated.TaxKind) entities.TaxKind {
+	for kind, v := range taxKindsToProto {
+		if v == k {
+			return kind
+		}
+	}
+	return ""
+}
+
+func taxKindToProto(k entities.TaxKind) generated.TaxKind {
+	return taxKindsToProto[k]
+}
+
+func makeTaxRuleToResponse(in entities.TaxRule) *generated.TaxRule {
+	res := &generated.TaxRule{
+		Id:                 in.ID,
+		CreatedAt:          timestamppb.New(in.CreatedAt),
+		UpdatedAt:          timestamppb.New(in.UpdatedAt),
+		HotelId:            in.HotelID,
+		Kind:               taxKindToProto(in.Kind),
+		Name:               in.Name,
+		PercentBasisPoints: uint32(in.PercentBasisPoints),
+		ValidFrom:          timestamppb.New(in.ValidFrom),
+		ValidTo:            optionalTimestamp(in.ValidTo),
+	}
+	if in.Kind != entities.TaxKindVAT {
+		res.Amount = moneyToProto(in.Amount)
+	}
+	return res
+}
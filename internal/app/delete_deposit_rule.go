@@ -0,0 +1,25 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) DeleteDepositRule(ctx context.Context, in *generated.DeleteDepositRuleRequest) (
+	*generated.DeleteDepositRuleResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.DeleteDepositRule")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "DeleteDepositRule", "request", logger.Redact(in))
+
+	if err := h.bookingController.DeleteDepositRule(ctx, in.GetHotelId()); err != nil {
+		return nil, depositRuleError(err, "deposit rule not found")
+	}
+
+	return &generated.DeleteDepositRuleResponse{}, nil
+}
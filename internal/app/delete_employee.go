@@ -0,0 +1,25 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) DeleteEmployee(ctx context.Context, in *generated.DeleteEmployeeRequest) (
+	*generated.DeleteEmployeeResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.DeleteEmployee")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "DeleteEmployee", "request", logger.Redact(in))
+
+	if err := h.bookingController.DeleteEmployee(ctx, in.GetEmployeeId()); err != nil {
+		return nil, employeeError(err)
+	}
+
+	return &generated.DeleteEmployeeResponse{}, nil
+}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) DeletePricingRule(ctx context.Context, in *generated.DeletePricingRuleRequest) (
+	*generated.DeletePricingRuleResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.DeletePricingRule")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "DeletePricingRule", "request", logger.Redact(in))
+
+	err := h.bookingController.DeletePricingRule(ctx, in.GetHotelId(), roomTypeFromProto(in.GetRoomType()))
+	if err != nil {
+		return nil, pricingRuleError(err, "pricing rule not found")
+	}
+
+	return &generated.DeletePricingRuleResponse{}, nil
+}
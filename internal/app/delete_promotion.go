@@ -0,0 +1,25 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) DeletePromotion(ctx context.Context, in *generated.DeletePromotionRequest) (
+	*generated.DeletePromotionResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.DeletePromotion")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "DeletePromotion", "request", logger.Redact(in))
+
+	if err := h.bookingController.DeletePromotion(ctx, in.GetPromotionId()); err != nil {
+		return nil, promotionError(err, "promotion not found")
+	}
+
+	return &generated.DeletePromotionResponse{}, nil
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) DeleteRatePlan(ctx context.Context, in *generated.DeleteRatePlanRequest) (
+	*generated.DeleteRatePlanResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.DeleteRatePlan")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "DeleteRatePlan", "request", logger.Redact(in))
+
+	if err := h.bookingController.DeleteRatePlan(ctx, in.GetRatePlanId()); err != nil {
+		return nil, ratePlanError(err, "rate plan not found")
+	}
+
+	return &generated.DeleteRatePlanResponse{}, nil
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) DeleteTaxRule(ctx context.Context, in *generated.DeleteTaxRuleRequest) (
+	*generated.DeleteTaxRuleResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.DeleteTaxRule")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "DeleteTaxRule", "request", logger.Redact(in))
+
+	if err := h.bookingController.DeleteTaxRule(ctx, in.GetTaxRuleId()); err != nil {
+		return nil, taxRuleError(err, "tax rule not found")
+	}
+
+	return &generated.DeleteTaxRuleResponse{}, nil
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"context"
+	"mime"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ContentDispositionMetadataKey заголовок ответа с именем файла; шлюз
+// передает его клиенту как Content-Disposition
+const ContentDispositionMetadataKey = "content-disposition"
+
+var invoiceFormatsFromProto = map[generated.InvoiceFormat]entities.InvoiceFormat{
+	generated.InvoiceFormat_INVOICE_FORMAT_UNSPECIFIED: entities.InvoiceFormatPDF,
+	generated.InvoiceFormat_INVOICE_FORMAT_PDF:         entities.InvoiceFormatPDF,
+	generated.InvoiceFormat_INVOICE_FORMAT_JSON:        entities.InvoiceFormatJSON,
+}
+
+func (h *Handler) DownloadInvoice(ctx context.Context, in *generated.DownloadInvoiceRequest) (*httpbody.HttpBody, error) {
+	ctx, span := tracing.Start(ctx, "handlers.DownloadInvoice")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "DownloadInvoice", "request", logger.Redact(in))
+
+	file, err := h.bookingController.DownloadInvoice(ctx, in.GetInvoiceId(), invoiceFormatsFromProto[in.GetFormat()])
+	if err != nil {
+		return nil, invoiceError(err)
+	}
+
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": file.FileName})
+	_ = grpc.SetHeader(ctx, metadata.Pairs(ContentDispositionMetadataKey, disposition))
+
+	return &httpbody.HttpBody{
+		ContentType: file.ContentType,
+		Data:        file.Data,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) EraseGuest(ctx context.Context, in *generated.EraseGuestRequest) (*generated.EraseGuestResponse, error) {
+	ctx, span := tracing.Start(ctx, "handlers.EraseGuest")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "EraseGuest", "request", logger.Redact(in))
+
+	guest, err := h.bookingController.EraseGuest(ctx, in.GetGuestId())
+	if err != nil {
+		return nil, guestError(err)
+	}
+
+	return &generated.EraseGuestResponse{
+		Guest: makeGuestProfileToResponse(guest),
+	}, nil
+}
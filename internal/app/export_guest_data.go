@@ -0,0 +1,58 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"mime"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) ExportGuestData(ctx context.Context, in *generated.ExportGuestDataRequest) (*httpbody.HttpBody, error) {
+	ctx, span := tracing.Start(ctx, "handlers.ExportGuestData")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "ExportGuestData", "request", logger.Redact(in))
+
+	export, err := h.bookingController.ExportGuestData(ctx, in.GetGuestId())
+	if err != nil {
+		return nil, guestError(err)
+	}
+
+	res := &generated.GuestDataExport{
+		ExportedAt: timestamppb.New(export.ExportedAt),
+		Guest:      makeGuestProfileToResponse(export.Guest),
+		Bookings:   make([]*generated.Booking, 0, len(export.Bookings)),
+		Reviews:    make([]*generated.Review, 0, len(export.Reviews)),
+	}
+	for _, b := range export.Bookings {
+		res.Bookings = append(res.Bookings, h.makeBookingToResponse(b))
+	}
+	for _, r := range export.Reviews {
+		res.Reviews = append(res.Reviews, makeReviewToResponse(r))
+	}
+	data, err := protojson.MarshalOptions{Multiline: true}.Marshal(res)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	fileName := fmt.Sprintf("guest-%d.json", export.Guest.ID)
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": fileName})
+	_ = grpc.SetHeader(ctx, metadata.Pairs(ContentDispositionMetadataKey, disposition))
+
+	return &httpbody.HttpBody{
+		ContentType: "application/json",
+		Data:        data,
+	}, nil
+}
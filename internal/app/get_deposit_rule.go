@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) GetDepositRule(ctx context.Context, in *generated.GetDepositRuleRequest) (
+	*generated.GetDepositRuleResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.GetDepositRule")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "GetDepositRule", "request", logger.Redact(in))
+
+	rule, err := h.bookingController.GetDepositRule(ctx, in.GetHotelId())
+	if err != nil {
+		return nil, depositRuleError(err, "deposit rule not found")
+	}
+
+	return &generated.GetDepositRuleResponse{
+		Rule: makeDepositRuleToResponse(rule),
+	}, nil
+}
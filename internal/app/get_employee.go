@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) GetEmployee(ctx context.Context, in *generated.GetEmployeeRequest) (
+	*generated.GetEmployeeResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.GetEmployee")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "GetEmployee", "request", logger.Redact(in))
+
+	employee, err := h.bookingController.GetEmployee(ctx, in.GetEmployeeId())
+	if err != nil {
+		return nil, employeeError(err)
+	}
+
+	return &generated.GetEmployeeResponse{
+		Employee: h.makeEmployeeToResponse(employee),
+	}, nil
+}
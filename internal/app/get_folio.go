@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	folioStatusesToProto = map[entities.FolioStatus]generated.FolioStatus{
+		entities.FolioStatusOpen:   generated.FolioStatus_FOLIO_STATUS_OPEN,
+		entities.FolioStatusClosed: generated.FolioStatus_FOLIO_STATUS_CLOSED,
+	}
+	chargeCategoriesToProto = map[entities.ChargeCategory]generated.ChargeCategory{
+		entities.ChargeCategoryRoomService: generated.ChargeCategory_CHARGE_CATEGORY_ROOM_SERVICE,
+		entities.ChargeCategoryMinibar:     generated.ChargeCategory_CHARGE_CATEGORY_MINIBAR,
+		entities.ChargeCategoryRestaurant:  generated.ChargeCategory_CHARGE_CATEGORY_RESTAURANT,
+		entities.ChargeCategoryLaundry:     generated.ChargeCategory_CHARGE_CATEGORY_LAUNDRY,
+		entities.ChargeCategoryParking:     generated.ChargeCategory_CHARGE_CATEGORY_PARKING,
+		entities.ChargeCategorySpa:         generated.ChargeCategory_CHARGE_CATEGORY_SPA,
+		entities.ChargeCategoryOther:       generated.ChargeCategory_CHARGE_CATEGORY_OTHER,
+	}
+	settlementStatusesToProto = map[entities.PaymentStatus]generated.SettlementStatus{
+		entities.PaymentStatusSuccess:  generated.SettlementStatus_SETTLEMENT_STATUS_SUCCESS,
+		entities.PaymentStatusFailed:   generated.SettlementStatus_SETTLEMENT_STATUS_FAILED,
+		entities.PaymentStatusCanceled: generated.SettlementStatus_SETTLEMENT_STATUS_CANCELED,
+	}
+)
+
+func (h *Handler) GetFolio(ctx context.Context, in *generated.GetFolioRequest) (*generated.GetFolioResponse, error) {
+	ctx, span := tracing.Start(ctx, "handlers.GetFolio")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "GetFolio", "request", logger.Redact(in))
+
+	folio, err := h.bookingController.GetFolio(ctx, in.GetBookingId())
+	if err != nil {
+		return nil, folioError(err)
+	}
+
+	return &generated.GetFolioResponse{
+		Folio: makeFolioToResponse(folio),
+	}, nil
+}
+
+func folioError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrInvalidChargeCategory) ||
+		errors.Is(err, entities.ErrInvalidQuantity) ||
+		errors.Is(err, entities.ErrInvalidPrice) ||
+		errors.Is(err, entities.ErrInvalidCurrency) ||
+		errors.Is(err, entities.ErrInvalidTaxPercent) ||
+		errors.Is(err, entities.ErrDescriptionTooLong) ||
+		errors.Is(err, entities.ErrHotelCurrencyMismatch) ||
+		errors.Is(err, entities.ErrVoidReasonRequired) ||
+		errors.Is(err, entities.ErrInvalidSettlementAmount):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entities.ErrNotFound):
+		return status.Error(codes.NotFound, "booking or charge not found")
+	case errors.Is(err, entities.ErrBookingCancelled) ||
+		errors.Is(err, entities.ErrFolioClosed) ||
+		errors.Is(err, entities.ErrChargeAlreadyVoided) ||
+		errors.Is(err, entities.ErrChargeSettled) ||
+		errors.Is(err, entities.ErrNothingToPay) ||
+		errors.Is(err, entities.ErrPaymentDeclined) ||
+		errors.Is(err, entities.ErrFolioNotSettled) ||
+		errors.Is(err, entities.ErrBookingNotPaid):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entities.ErrPaymentUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func chargeCategoryFromProto(c generated.ChargeCategory) entities.ChargeCategory {
+	for category, v := range chargeCategoriesToProto {
+		if v == c {
+			return category
+		}
+	}
+	return ""
+}
+
+func makeFolioToResponse(in entities.Folio) *generated.Folio {
+	res := &generated.Folio{
+		Id:          in.ID,
+		BookingId:   in.BookingID,
+		Status:      folioStatusesToProto[in.Status],
+		ClosedAt:    optionalTimestamp(in.ClosedAt),
+		Charges:     make([]*generated.FolioCharge, 0, len(in.Charges)),
+		Settlements: make([]*generated.Settlement, 0, len(in.Payments)),
+		Charged:     moneyToProto(in.Charged),
+		Paid:        moneyToProto(in.Paid),
+		Balance:     moneyToProto(in.Balance),
+	}
+	if in.ID != 0 {
+		res.CreatedAt = timestamppb.New(in.CreatedAt)
+	}
+	for _, ch := range in.Charges {
+		res.Charges = append(res.Charges, makeFolioChargeToResponse(ch))
+	}
+	for _, p := range in.Payments {
+		res.Settlements = append(res.Settlements, &generated.Settlement{
+			Id:          p.ID,
+			Amount:      moneyToProto(p.Amount),
+			Status:      settlementStatusesToProto[p.Status],
+			PaymentDate: timestamppb.New(p.PaymentDate),
+		})
+	}
+	return res
+}
+
+func makeFolioChargeToResponse(in entities.FolioCharge) *generated.FolioCharge {
+	return &generated.FolioCharge{
+		Id:             in.ID,
+		CreatedAt:      timestamppb.New(in.CreatedAt),
+		Category:       chargeCategoriesToProto[in.Category],
+		Description:    in.Description,
+		Quantity:       uint32(in.Quantity),
+		UnitPrice:      moneyToProto(in.UnitPrice),
+		TaxBasisPoints: uint32(in.TaxBasisPoints),
+		Net:            moneyToProto(in.Net),
+		Tax:            moneyToProto(in.Tax),
+		Total:          moneyToProto(in.Total),
+		PostedBy:       in.PostedBy,
+		VoidedAt:       optionalTimestamp(in.VoidedAt),
+		VoidReason:     in.VoidReason,
+	}
+}
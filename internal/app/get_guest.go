@@ -0,0 +1,99 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var documentTypesToProto = map[entities.DocumentType]generated.DocumentType{
+	entities.DocumentTypePassport:       generated.DocumentType_DOCUMENT_TYPE_PASSPORT,
+	entities.DocumentTypeNationalID:     generated.DocumentType_DOCUMENT_TYPE_NATIONAL_ID,
+	entities.DocumentTypeDrivingLicence: generated.DocumentType_DOCUMENT_TYPE_DRIVING_LICENCE,
+}
+
+func (h *Handler) GetGuest(ctx context.Context, in *generated.GetGuestRequest) (*generated.GetGuestResponse, error) {
+	ctx, span := tracing.Start(ctx, "handlers.GetGuest")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "GetGuest", "request", logger.Redact(in))
+
+	guest, err := h.bookingController.GetGuest(ctx, in.GetGuestId())
+	if err != nil {
+		return nil, guestError(err)
+	}
+
+	return &generated.GetGuestResponse{
+		Guest: makeGuestProfileToResponse(guest),
+	}, nil
+}
+
+func guestError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrNameIsRequired) ||
+		errors.Is(err, entities.ErrNameIsTooLong) ||
+		errors.Is(err, entities.ErrInvalidEmail) ||
+		errors.Is(err, entities.ErrInvalidPhone) ||
+		errors.Is(err, entities.ErrInvalidDateOfBirth) ||
+		errors.Is(err, entities.ErrInvalidCountryCode) ||
+		errors.Is(err, entities.ErrInvalidDocument) ||
+		errors.Is(err, entities.ErrEmptyGuestSearch) ||
+		errors.Is(err, entities.ErrMergeSameGuest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entities.ErrGuestErased) ||
+		errors.Is(err, entities.ErrGuestHasActiveBookings):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entities.ErrNotFound):
+		return status.Error(codes.NotFound, "guest not found")
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func makeGuestProfileToResponse(in entities.Guest) *generated.Guest {
+	res := &generated.Guest{
+		Id:          in.ID,
+		CreatedAt:   timestamppb.New(in.CreatedAt),
+		UpdatedAt:   timestamppb.New(in.UpdatedAt),
+		Name:        in.Name,
+		Email:       in.Email,
+		Phone:       in.Phone,
+		Nationality: in.Nationality,
+	}
+	if !in.DateOfBirth.IsZero() {
+		res.DateOfBirth = timestamppb.New(in.DateOfBirth)
+	}
+	if !in.ErasedAt.IsZero() {
+		res.ErasedAt = timestamppb.New(in.ErasedAt)
+	}
+	if !in.Address.IsZero() {
+		res.Address = &generated.Address{
+			Line1:      in.Address.Line1,
+			Line2:      in.Address.Line2,
+			City:       in.Address.City,
+			Region:     in.Address.Region,
+			PostalCode: in.Address.PostalCode,
+			Country:    in.Address.Country,
+		}
+	}
+	if in.DocumentType != "" {
+		res.Document = &generated.IdentityDocument{
+			Type:           documentTypesToProto[in.DocumentType],
+			Number:         in.DocumentNumber,
+			IssuingCountry: in.DocumentCountry,
+		}
+		if !in.DocumentExpiresAt.IsZero() {
+			res.Document.ExpiresAt = timestamppb.New(in.DocumentExpiresAt)
+		}
+	}
+	return res
+}
@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) GetInvoice(ctx context.Context, in *generated.GetInvoiceRequest) (*generated.GetInvoiceResponse, error) {
+	ctx, span := tracing.Start(ctx, "handlers.GetInvoice")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "GetInvoice", "request", logger.Redact(in))
+
+	inv, err := h.bookingController.GetInvoice(ctx, in.GetInvoiceId())
+	if err != nil {
+		return nil, invoiceError(err)
+	}
+
+	return &generated.GetInvoiceResponse{
+		Invoice: makeInvoiceToResponse(inv),
+	}, nil
+}
@@ -0,0 +1,47 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) GetRateCalendar(ctx context.Context, in *generated.GetRateCalendarRequest) (
+	*generated.GetRateCalendarResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.GetRateCalendar")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "GetRateCalendar", "request", logger.Redact(in))
+
+	nights, err := h.bookingController.GetRateCalendar(ctx, in.GetHotelId(), roomTypeFromProto(in.GetRoomType()),
+		optionalTime(in.GetStartDate()), optionalTime(in.GetEndDate()))
+	if err != nil {
+		return nil, rateCalendarError(err)
+	}
+
+	res := &generated.GetRateCalendarResponse{
+		Nights: make([]*generated.CalendarNight, 0, len(nights)),
+	}
+	for _, n := range nights {
+		night := &generated.CalendarNight{
+			Date:             timestamppb.New(n.Date),
+			RatePlanId:       n.RatePlanID,
+			Override:         n.Override,
+			Adjustments:      makeAdjustmentsToResponse(n.Adjustments),
+			OccupancyPercent: uint32(n.OccupancyPercent),
+		}
+		if n.Price.Amount != 0 {
+			night.Price = moneyToProto(n.Price)
+			night.BasePrice = moneyToProto(n.BasePrice)
+		}
+		res.Nights = append(res.Nights, night)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,62 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var reconciliationIssueKindsToProto = map[entities.ReconciliationIssueKind]generated.ReconciliationIssueKind{
+	entities.ReconciliationIssueCancelledButPaid:   generated.ReconciliationIssueKind_RECONCILIATION_ISSUE_KIND_CANCELLED_BUT_PAID,
+	entities.ReconciliationIssueUnpaidPastDeadline: generated.ReconciliationIssueKind_RECONCILIATION_ISSUE_KIND_UNPAID_PAST_DEADLINE,
+	entities.ReconciliationIssueAmountMismatch:     generated.ReconciliationIssueKind_RECONCILIATION_ISSUE_KIND_AMOUNT_MISMATCH,
+}
+
+func (h *Handler) GetReconciliationReport(ctx context.Context, in *generated.GetReconciliationReportRequest) (
+	*generated.GetReconciliationReportResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.GetReconciliationReport")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "GetReconciliationReport", "request", logger.Redact(in))
+
+	issues, err := h.bookingController.ListReconciliationIssues(ctx, entities.ReconciliationFilter{
+		HotelID:         in.GetHotelId(),
+		IncludeResolved: in.GetIncludeResolved(),
+		Limit:           int(in.GetPageSize()),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &generated.GetReconciliationReportResponse{
+		Issues: make([]*generated.ReconciliationIssue, 0, len(issues)),
+	}
+	for _, issue := range issues {
+		res.Issues = append(res.Issues, makeReconciliationIssueToResponse(issue))
+	}
+	return res, nil
+}
+
+func makeReconciliationIssueToResponse(in entities.ReconciliationIssue) *generated.ReconciliationIssue {
+	res := &generated.ReconciliationIssue{
+		Id:         in.ID,
+		BookingId:  in.BookingID,
+		HotelId:    in.HotelID,
+		Kind:       reconciliationIssueKindsToProto[in.Kind],
+		Details:    in.Details,
+		DetectedAt: timestamppb.New(in.DetectedAt),
+	}
+	if !in.ResolvedAt.IsZero() {
+		res.ResolvedAt = timestamppb.New(in.ResolvedAt)
+	}
+	return res
+}
@@ -1,8 +1,10 @@
 package app
 
 import (
+	"booking-service/internal/auth"
 	"booking-service/internal/controllers"
 	"booking-service/internal/generated"
+	"booking-service/internal/pricing"
 )
 
 type (
@@ -10,13 +12,20 @@ type (
 		generated.UnimplementedBookingServiceServer
 
 		bookingController *controllers.Controller
+		// nil, если токены доступа гостей не настроены
+		bookingTokens *auth.BookingTokens
+		quoteTokens   *pricing.QuoteTokens
 	}
 )
 
 func New(
 	bookingController *controllers.Controller,
+	bookingTokens *auth.BookingTokens,
+	quoteTokens *pricing.QuoteTokens,
 ) *Handler {
 	return &Handler{
 		bookingController: bookingController,
+		bookingTokens:     bookingTokens,
+		quoteTokens:       quoteTokens,
 	}
 }
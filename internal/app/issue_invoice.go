@@ -0,0 +1,101 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) IssueInvoice(ctx context.Context, in *generated.IssueInvoiceRequest) (
+	*generated.IssueInvoiceResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.IssueInvoice")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "IssueInvoice", "request", logger.Redact(in))
+
+	buyer := in.GetBuyer()
+	inv, err := h.bookingController.IssueInvoice(ctx, entities.IssueInvoiceDTO{
+		BookingID: in.GetBookingId(),
+		Buyer: entities.InvoiceParty{
+			Name:    buyer.GetName(),
+			TaxID:   buyer.GetTaxId(),
+			Address: buyer.GetAddress(),
+			Email:   buyer.GetEmail(),
+		},
+	})
+	if err != nil {
+		return nil, invoiceError(err)
+	}
+
+	return &generated.IssueInvoiceResponse{
+		Invoice: makeInvoiceToResponse(inv),
+	}, nil
+}
+
+func invoiceError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrInvalidInvoiceFormat):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entities.ErrNotFound):
+		return status.Error(codes.NotFound, "booking or invoice not found")
+	case errors.Is(err, entities.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, "invoice for the booking is already issued")
+	case errors.Is(err, entities.ErrBookingCancelled) ||
+		errors.Is(err, entities.ErrFolioNotClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func makeInvoiceToResponse(in entities.Invoice) *generated.Invoice {
+	party := func(p entities.InvoiceParty) *generated.InvoiceParty {
+		return &generated.InvoiceParty{
+			Name:    p.Name,
+			TaxId:   p.TaxID,
+			Address: p.Address,
+			Email:   p.Email,
+		}
+	}
+
+	res := &generated.Invoice{
+		Id:             in.ID,
+		Number:         in.Number,
+		HotelId:        in.HotelID,
+		BookingId:      in.BookingID,
+		FiscalYear:     uint32(in.FiscalYear),
+		SequenceNumber: uint64(in.SequenceNumber),
+		IssuedAt:       timestamppb.New(in.IssuedAt),
+		Seller:         party(in.Seller),
+		Buyer:          party(in.Buyer),
+		StayStart:      timestamppb.New(in.StayStart),
+		StayEnd:        timestamppb.New(in.StayEnd),
+		Lines:          make([]*generated.InvoiceLine, 0, len(in.Lines)),
+		Net:            moneyToProto(in.Net),
+		Tax:            moneyToProto(in.Tax),
+		Total:          moneyToProto(in.Total),
+		Paid:           moneyToProto(in.Paid),
+		Due:            moneyToProto(in.Due),
+	}
+	for _, l := range in.Lines {
+		res.Lines = append(res.Lines, &generated.InvoiceLine{
+			Description: l.Description,
+			Quantity:    uint32(l.Quantity),
+			UnitPrice:   moneyToProto(l.UnitPrice),
+			Net:         moneyToProto(l.Net),
+			Tax:         moneyToProto(l.Tax),
+			Total:       moneyToProto(l.Total),
+		})
+	}
+	return res
+}
@@ -0,0 +1,124 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var auditEntityTypesToProto = map[entities.AuditEntityType]generated.AuditEntityType{
+	entities.AuditEntityHotel:        generated.AuditEntityType_AUDIT_ENTITY_TYPE_HOTEL,
+	entities.AuditEntityRoom:         generated.AuditEntityType_AUDIT_ENTITY_TYPE_ROOM,
+	entities.AuditEntityBooking:      generated.AuditEntityType_AUDIT_ENTITY_TYPE_BOOKING,
+	entities.AuditEntityGuest:        generated.AuditEntityType_AUDIT_ENTITY_TYPE_GUEST,
+	entities.AuditEntityReview:       generated.AuditEntityType_AUDIT_ENTITY_TYPE_REVIEW,
+	entities.AuditEntityEmployee:     generated.AuditEntityType_AUDIT_ENTITY_TYPE_EMPLOYEE,
+	entities.AuditEntityRatePlan:     generated.AuditEntityType_AUDIT_ENTITY_TYPE_RATE_PLAN,
+	entities.AuditEntityRateCalendar: generated.AuditEntityType_AUDIT_ENTITY_TYPE_RATE_CALENDAR,
+	entities.AuditEntityPricingRule:  generated.AuditEntityType_AUDIT_ENTITY_TYPE_PRICING_RULE,
+	entities.AuditEntityTaxRule:      generated.AuditEntityType_AUDIT_ENTITY_TYPE_TAX_RULE,
+	entities.AuditEntityPromotion:    generated.AuditEntityType_AUDIT_ENTITY_TYPE_PROMOTION,
+	entities.AuditEntityExchangeRate: generated.AuditEntityType_AUDIT_ENTITY_TYPE_EXCHANGE_RATE,
+	entities.AuditEntityFolio:        generated.AuditEntityType_AUDIT_ENTITY_TYPE_FOLIO,
+	entities.AuditEntityFolioCharge:  generated.AuditEntityType_AUDIT_ENTITY_TYPE_FOLIO_CHARGE,
+	entities.AuditEntityPayment:      generated.AuditEntityType_AUDIT_ENTITY_TYPE_PAYMENT,
+	entities.AuditEntityInvoice:      generated.AuditEntityType_AUDIT_ENTITY_TYPE_INVOICE,
+	entities.AuditEntityDepositRule:  generated.AuditEntityType_AUDIT_ENTITY_TYPE_DEPOSIT_RULE,
+}
+
+func (h *Handler) ListAuditEvents(ctx context.Context, in *generated.ListAuditEventsRequest) (
+	*generated.ListAuditEventsResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.ListAuditEvents")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "ListAuditEvents", "request", logger.Redact(in))
+
+	filter := entities.AuditFilter{
+		EntityID: in.GetEntityId(),
+		HotelID:  in.GetHotelId(),
+		Limit:    int(in.GetPageSize()),
+	}
+	for k, v := range auditEntityTypesToProto {
+		if v == in.GetEntityType() {
+			filter.EntityType = k
+		}
+	}
+	if in.GetFrom() != nil {
+		filter.From = in.GetFrom().AsTime()
+	}
+	if in.GetTo() != nil {
+		filter.To = in.GetTo().AsTime()
+	}
+	if in.GetPageToken() != "" {
+		beforeID, err := strconv.ParseUint(in.GetPageToken(), 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		filter.BeforeID = beforeID
+	}
+
+	events, next, err := h.bookingController.ListAuditEvents(ctx, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrStartDateIsAfterEndDate):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	res := &generated.ListAuditEventsResponse{
+		Events: make([]*generated.AuditEvent, 0, len(events)),
+	}
+	for _, e := range events {
+		event, err := h.makeAuditEventToResponse(e)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res.Events = append(res.Events, event)
+	}
+	if next != 0 {
+		res.NextPageToken = strconv.FormatUint(next, 10)
+	}
+
+	return res, nil
+}
+
+func (h *Handler) makeAuditEventToResponse(in entities.AuditEvent) (*generated.AuditEvent, error) {
+	var diff map[string]any
+	if err := json.Unmarshal(in.Diff, &diff); err != nil {
+		return nil, err
+	}
+	diffStruct, err := structpb.NewStruct(diff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &generated.AuditEvent{
+		Id:         in.ID,
+		OccurredAt: timestamppb.New(in.OccurredAt),
+		ActorKind:  in.ActorKind,
+		Actor:      in.Actor,
+		EmployeeId: in.EmployeeID,
+		Rpc:        in.RPC,
+		EntityType: auditEntityTypesToProto[in.EntityType],
+		EntityId:   in.EntityID,
+		HotelId:    in.HotelID,
+		Action:     string(in.Action),
+		Diff:       diffStruct,
+		RequestId:  in.RequestID,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+var guestMatchKeysToProto = map[entities.GuestMatchKey]generated.GuestMatchKey{
+	entities.GuestMatchEmail:    generated.GuestMatchKey_GUEST_MATCH_KEY_EMAIL,
+	entities.GuestMatchPhone:    generated.GuestMatchKey_GUEST_MATCH_KEY_PHONE,
+	entities.GuestMatchDocument: generated.GuestMatchKey_GUEST_MATCH_KEY_DOCUMENT,
+	entities.GuestMatchName:     generated.GuestMatchKey_GUEST_MATCH_KEY_NAME,
+}
+
+func (h *Handler) ListDuplicateGuests(ctx context.Context, in *generated.ListDuplicateGuestsRequest) (
+	*generated.ListDuplicateGuestsResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.ListDuplicateGuests")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "ListDuplicateGuests", "request", logger.Redact(in))
+
+	duplicates, err := h.bookingController.ListDuplicateGuests(ctx, int(in.GetPageSize()))
+	if err != nil {
+		return nil, guestError(err)
+	}
+
+	res := &generated.ListDuplicateGuestsResponse{
+		Duplicates: make([]*generated.DuplicateGuests, 0, len(duplicates)),
+	}
+	for _, d := range duplicates {
+		item := &generated.DuplicateGuests{
+			Guest:          makeGuestProfileToResponse(d.Guest),
+			Duplicate:      makeGuestProfileToResponse(d.Duplicate),
+			NameSimilarity: d.NameSimilarity,
+		}
+		for _, key := range d.MatchedBy {
+			item.MatchedBy = append(item.MatchedBy, guestMatchKeysToProto[key])
+		}
+		res.Duplicates = append(res.Duplicates, item)
+	}
+
+	return res, nil
+}
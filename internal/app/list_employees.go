@@ -0,0 +1,33 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) ListEmployees(ctx context.Context, in *generated.ListEmployeesRequest) (
+	*generated.ListEmployeesResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.ListEmployees")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "ListEmployees", "request", logger.Redact(in))
+
+	employees, err := h.bookingController.ListEmployees(ctx, in.GetHotelId())
+	if err != nil {
+		return nil, employeeError(err)
+	}
+
+	res := make([]*generated.Employee, 0, len(employees))
+	for _, e := range employees {
+		res = append(res, h.makeEmployeeToResponse(e))
+	}
+
+	return &generated.ListEmployeesResponse{
+		Employees: res,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) ListExchangeRates(ctx context.Context, in *generated.ListExchangeRatesRequest) (
+	*generated.ListExchangeRatesResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.ListExchangeRates")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "ListExchangeRates", "request", logger.Redact(in))
+
+	rates, err := h.bookingController.ListExchangeRates(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &generated.ListExchangeRatesResponse{
+		Rates: makeExchangeRatesToResponse(rates),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/auth"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) ListMyBookings(ctx context.Context, in *generated.ListMyBookingsRequest) (
+	*generated.ListMyBookingsResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.ListMyBookings")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "ListMyBookings", "request", logger.Redact(in))
+
+	p := auth.PrincipalFromContext(ctx)
+	if p == nil || p.Kind != auth.PrincipalKindGuest {
+		return nil, status.Error(codes.Unauthenticated, "booking access token is required")
+	}
+
+	bookings, err := h.bookingController.ListGuestBookings(ctx, p.BookingID, p.GuestIDs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := make([]*generated.Booking, 0, len(bookings))
+	for _, b := range bookings {
+		res = append(res, h.makeBookingToResponse(guestBooking(ctx, b)))
+	}
+
+	return &generated.ListMyBookingsResponse{
+		Bookings: res,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) ListPricingRules(ctx context.Context, in *generated.ListPricingRulesRequest) (
+	*generated.ListPricingRulesResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.ListPricingRules")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "ListPricingRules", "request", logger.Redact(in))
+
+	rules, err := h.bookingController.ListPricingRules(ctx, in.GetHotelId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &generated.ListPricingRulesResponse{
+		Rules: make([]*generated.PricingRule, 0, len(rules)),
+	}
+	for _, r := range rules {
+		res.Rules = append(res.Rules, h.makePricingRuleToResponse(r))
+	}
+
+	return res, nil
+}
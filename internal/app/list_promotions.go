@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) ListPromotions(ctx context.Context, in *generated.ListPromotionsRequest) (
+	*generated.ListPromotionsResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.ListPromotions")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "ListPromotions", "request", logger.Redact(in))
+
+	promotions, err := h.bookingController.ListPromotions(ctx, in.GetHotelId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &generated.ListPromotionsResponse{
+		Promotions: make([]*generated.Promotion, 0, len(promotions)),
+	}
+	for _, p := range promotions {
+		res.Promotions = append(res.Promotions, makePromotionToResponse(p))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) ListRatePlans(ctx context.Context, in *generated.ListRatePlansRequest) (
+	*generated.ListRatePlansResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.ListRatePlans")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "ListRatePlans", "request", logger.Redact(in))
+
+	plans, err := h.bookingController.ListRatePlans(ctx, in.GetHotelId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &generated.ListRatePlansResponse{
+		RatePlans: make([]*generated.RatePlan, 0, len(plans)),
+	}
+	for _, p := range plans {
+		res.RatePlans = append(res.RatePlans, h.makeRatePlanToResponse(p))
+	}
+
+	return res, nil
+}
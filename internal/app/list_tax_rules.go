@@ -0,0 +1,36 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) ListTaxRules(ctx context.Context, in *generated.ListTaxRulesRequest) (
+	*generated.ListTaxRulesResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.ListTaxRules")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "ListTaxRules", "request", logger.Redact(in))
+
+	rules, err := h.bookingController.ListTaxRules(ctx, in.GetHotelId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &generated.ListTaxRulesResponse{
+		TaxRules: make([]*generated.TaxRule, 0, len(rules)),
+	}
+	for _, r := range rules {
+		res.TaxRules = append(res.TaxRules, makeTaxRuleToResponse(r))
+	}
+
+	return res, nil
+}
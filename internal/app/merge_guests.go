@@ -0,0 +1,30 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) MergeGuests(ctx context.Context, in *generated.MergeGuestsRequest) (
+	*generated.MergeGuestsResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.MergeGuests")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "MergeGuests", "request", logger.Redact(in))
+
+	res, err := h.bookingController.MergeGuests(ctx, in.GetTargetGuestId(), in.GetSourceGuestId())
+	if err != nil {
+		return nil, guestError(err)
+	}
+
+	return &generated.MergeGuestsResponse{
+		Guest:         makeGuestProfileToResponse(res.Guest),
+		BookingsMoved: uint32(res.BookingsMoved),
+		ReviewsMoved:  uint32(res.ReviewsMoved),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) ModifyBooking(ctx context.Context, in *generated.ModifyBookingRequest) (
+	*generated.ModifyBookingResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.ModifyBooking")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "ModifyBooking", "request", logger.Redact(in))
+
+	booking, err := h.bookingController.ModifyBooking(ctx, entities.ModifyBookingDTO{
+		BookingID: in.GetBookingId(),
+		StartDate: in.GetStartDate().AsTime(),
+		EndDate:   in.GetEndDate().AsTime(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrStartDateIsAfterEndDate) ||
+			errors.Is(err, entities.ErrStartDateInPast) ||
+			errors.Is(err, entities.ErrRateRangeTooLong):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, entities.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "booking not found")
+		case errors.Is(err, entities.ErrRoomNotAvailable):
+			return nil, status.Error(codes.InvalidArgument, "room is not available")
+		case errors.Is(err, entities.ErrBookingCancelled) ||
+			errors.Is(err, entities.ErrNoRateForNight) ||
+			errors.Is(err, entities.ErrCurrencyMismatch) ||
+			errors.Is(err, entities.ErrTaxCurrencyMismatch) ||
+			errors.Is(err, entities.ErrPromoCodeNotFound) ||
+			errors.Is(err, entities.ErrPromoCodeNotApplicable) ||
+			errors.Is(err, entities.ErrPriceBelowAmountPaid):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, entities.ErrEmptyStay):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &generated.ModifyBookingResponse{
+		Booking: h.makeBookingToResponse(guestBooking(ctx, booking)),
+	}, nil
+}
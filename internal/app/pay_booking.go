@@ -0,0 +1,43 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) PayBooking(ctx context.Context, in *generated.PayBookingRequest) (*generated.PayBookingResponse, error) {
+	ctx, span := tracing.Start(ctx, "handlers.PayBooking")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "PayBooking", "request", logger.Redact(in))
+
+	booking, err := h.bookingController.PayBooking(ctx, in.GetBookingId())
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "booking not found")
+		case errors.Is(err, entities.ErrBookingCancelled) ||
+			errors.Is(err, entities.ErrBookingAlreadyPaid) ||
+			errors.Is(err, entities.ErrNothingToPay) ||
+			errors.Is(err, entities.ErrPaymentDeclined):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, entities.ErrPaymentUnavailable):
+			return nil, status.Error(codes.Unavailable, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &generated.PayBookingResponse{
+		Booking: h.makeBookingToResponse(guestBooking(ctx, booking)),
+	}, nil
+}
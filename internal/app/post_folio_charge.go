@@ -0,0 +1,37 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) PostFolioCharge(ctx context.Context, in *generated.PostFolioChargeRequest) (
+	*generated.PostFolioChargeResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.PostFolioCharge")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "PostFolioCharge", "request", logger.Redact(in))
+
+	charge, folio, err := h.bookingController.PostFolioCharge(ctx, entities.FolioChargeDTO{
+		BookingID:      in.GetBookingId(),
+		Category:       chargeCategoryFromProto(in.GetCategory()),
+		Description:    in.GetDescription(),
+		Quantity:       int(in.GetQuantity()),
+		UnitPrice:      moneyFromProto(in.GetUnitPrice()),
+		TaxBasisPoints: int(in.GetTaxBasisPoints()),
+	})
+	if err != nil {
+		return nil, folioError(err)
+	}
+
+	return &generated.PostFolioChargeResponse{
+		Charge: makeFolioChargeToResponse(charge),
+		Folio:  makeFolioToResponse(folio),
+	}, nil
+}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) QuoteStay(ctx context.Context, in *generated.QuoteStayRequest) (*generated.QuoteStayResponse, error) {
+	ctx, span := tracing.Start(ctx, "handlers.QuoteStay")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "QuoteStay", "request", logger.Redact(in))
+
+	quote, err := h.bookingController.QuoteStay(ctx, entities.QuoteDTO{
+		HotelID:         in.GetHotelId(),
+		RoomType:        roomTypeFromProto(in.GetRoomType()),
+		StartDate:       optionalTime(in.GetStartDate()),
+		EndDate:         optionalTime(in.GetEndDate()),
+		Guests:          int(in.GetGuests()),
+		TaxExemptGuests: int(in.GetTaxExemptGuests()),
+		PromoCode:       in.GetPromoCode(),
+		DisplayCurrency: in.GetDisplayCurrency(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidRoomType) ||
+			errors.Is(err, entities.ErrInvalidGuestCount) ||
+			errors.Is(err, entities.ErrStartDateIsAfterEndDate) ||
+			errors.Is(err, entities.ErrStartDateInPast) ||
+			errors.Is(err, entities.ErrRateRangeTooLong) ||
+			errors.Is(err, entities.ErrEmptyStay) ||
+			errors.Is(err, entities.ErrInvalidTaxExemptGuests) ||
+			errors.Is(err, entities.ErrInvalidCurrency) ||
+			errors.Is(err, entities.ErrPromoCodeNotFound) ||
+			errors.Is(err, entities.ErrPromoCodeNotApplicable):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, entities.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "hotel not found")
+		case errors.Is(err, entities.ErrRoomNotAvailable) ||
+			errors.Is(err, entities.ErrNoRateForNight) ||
+			errors.Is(err, entities.ErrCurrencyMismatch) ||
+			errors.Is(err, entities.ErrTaxCurrencyMismatch) ||
+			errors.Is(err, entities.ErrPromoCodeExhausted) ||
+			errors.Is(err, entities.ErrNoExchangeRate):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	token, quote, err := h.quoteTokens.Issue(quote)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &generated.QuoteStayResponse{
+		Price:        makePriceToResponse(quote.Price),
+		Cancellation: makeCancellationToResponse(quote.Cancellation),
+		QuoteToken:   token,
+		ExpiresAt:    timestamppb.New(quote.ExpiresAt),
+		DisplayPrice: makeConvertedPriceToResponse(quote.Display),
+	}, nil
+}
+
+func makeConvertedPriceToResponse(in *entities.ConvertedPrice) *generated.ConvertedPrice {
+	if in == nil {
+		return nil
+	}
+	return &generated.ConvertedPrice{
+		Total:    moneyToProto(in.Total),
+		Subtotal: moneyToProto(in.Subtotal),
+		Taxes:    moneyToProto(in.Taxes),
+		Fees:     moneyToProto(in.Fees),
+		Rate:     makeExchangeRateToResponse(in.Rate),
+	}
+}
+
+func makeCancellationToResponse(in entities.CancellationTerms) *generated.CancellationTerms {
+	if in.FreeUntil.IsZero() {
+		return nil
+	}
+	return &generated.CancellationTerms{
+		FreeUntil: timestamppb.New(in.FreeUntil),
+		Penalty:   moneyToProto(in.Penalty),
+	}
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"context"
+	"strconv"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) SearchGuests(ctx context.Context, in *generated.SearchGuestsRequest) (
+	*generated.SearchGuestsResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.SearchGuests")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "SearchGuests", "request", logger.Redact(in))
+
+	filter := entities.GuestFilter{
+		Name:           in.GetName(),
+		Email:          in.GetEmail(),
+		Phone:          in.GetPhone(),
+		DocumentNumber: in.GetDocumentNumber(),
+		HotelID:        in.GetHotelId(),
+		Limit:          int(in.GetPageSize()),
+	}
+	if in.GetPageToken() != "" {
+		afterID, err := strconv.ParseUint(in.GetPageToken(), 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		filter.AfterID = afterID
+	}
+
+	guests, next, err := h.bookingController.SearchGuests(ctx, filter)
+	if err != nil {
+		return nil, guestError(err)
+	}
+
+	res := &generated.SearchGuestsResponse{
+		Guests: make([]*generated.Guest, 0, len(guests)),
+	}
+	for _, g := range guests {
+		res.Guests = append(res.Guests, makeGuestProfileToResponse(g))
+	}
+	if next != 0 {
+		res.NextPageToken = strconv.FormatUint(next, 10)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var depositKindsToProto = map[entities.DepositKind]generated.DepositKind{
+	entities.DepositKindPercent:    generated.DepositKind_DEPOSIT_KIND_PERCENT,
+	entities.DepositKindFirstNight: generated.DepositKind_DEPOSIT_KIND_FIRST_NIGHT,
+}
+
+func (h *Handler) SetDepositRule(ctx context.Context, in *generated.SetDepositRuleRequest) (
+	*generated.SetDepositRuleResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.SetDepositRule")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "SetDepositRule", "request", logger.Redact(in))
+
+	rule := entities.DepositRule{
+		HotelID:            in.GetHotelId(),
+		PercentBasisPoints: int(in.GetPercentBasisPoints()),
+		BalanceDaysBefore:  int(in.GetBalanceDaysBefore()),
+	}
+	for k, v := range depositKindsToProto {
+		if v == in.GetKind() {
+			rule.Kind = k
+		}
+	}
+
+	res, err := h.bookingController.SetDepositRule(ctx, rule)
+	if err != nil {
+		return nil, depositRuleError(err, "hotel not found")
+	}
+
+	return &generated.SetDepositRuleResponse{
+		Rule: makeDepositRuleToResponse(res),
+	}, nil
+}
+
+func depositRuleError(err error, notFound string) error {
+	switch {
+	case errors.Is(err, entities.ErrInvalidDepositKind) ||
+		errors.Is(err, entities.ErrInvalidDepositPercent) ||
+		errors.Is(err, entities.ErrInvalidBalanceDays):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entities.ErrNotFound):
+		return status.Error(codes.NotFound, notFound)
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func makeDepositRuleToResponse(in entities.DepositRule) *generated.DepositRule {
+	return &generated.DepositRule{
+		HotelId:            in.HotelID,
+		Kind:               depositKindsToProto[in.Kind],
+		PercentBasisPoints: uint32(in.PercentBasisPoints),
+		BalanceDaysBefore:  uint32(in.BalanceDaysBefore),
+		UpdatedAt:          timestamppb.New(in.UpdatedAt),
+	}
+}
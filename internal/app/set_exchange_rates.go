@@ -0,0 +1,74 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/pricing"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) SetExchangeRates(ctx context.Context, in *generated.SetExchangeRatesRequest) (
+	*generated.SetExchangeRatesResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.SetExchangeRates")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "SetExchangeRates", "request", logger.Redact(in))
+
+	rates := make([]entities.ExchangeRate, 0, len(in.GetRates()))
+	for _, r := range in.GetRates() {
+		rates = append(rates, entities.ExchangeRate{
+			Base:  r.GetBaseCurrency(),
+			Quote: r.GetQuoteCurrency(),
+			Rate:  r.GetRate(),
+		})
+	}
+	if in.GetCsv() != "" {
+		fromCSV, err := pricing.ParseExchangeRatesCSV(in.GetCsv())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		rates = append(rates, fromCSV...)
+	}
+
+	rates, err := h.bookingController.SetExchangeRates(ctx, rates, in.GetReplace())
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrEmptyExchangeRates) ||
+			errors.Is(err, entities.ErrInvalidExchangeRate) ||
+			errors.Is(err, entities.ErrInvalidCurrency):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &generated.SetExchangeRatesResponse{
+		Rates: makeExchangeRatesToResponse(rates),
+	}, nil
+}
+
+func makeExchangeRatesToResponse(in []entities.ExchangeRate) []*generated.ExchangeRate {
+	res := make([]*generated.ExchangeRate, 0, len(in))
+	for _, r := range in {
+		res = append(res, makeExchangeRateToResponse(r))
+	}
+	return res
+}
+
+func makeExchangeRateToResponse(in entities.ExchangeRate) *generated.ExchangeRate {
+	return &generated.ExchangeRate{
+		BaseCurrency:  in.Base,
+		QuoteCurrency: in.Quote,
+		Rate:          in.Rate,
+		UpdatedAt:     optionalTimestamp(in.UpdatedAt),
+	}
+}
@@ -0,0 +1,112 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var pricingStrategiesToProto = map[entities.PricingStrategy]generated.PricingStrategy{
+	entities.PricingStrategyFixed:     generated.PricingStrategy_PRICING_STRATEGY_FIXED,
+	entities.PricingStrategyOccupancy: generated.PricingStrategy_PRICING_STRATEGY_OCCUPANCY,
+}
+
+func (h *Handler) SetPricingRule(ctx context.Context, in *generated.SetPricingRuleRequest) (
+	*generated.SetPricingRuleResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.SetPricingRule")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "SetPricingRule", "request", logger.Redact(in))
+
+	rule := entities.PricingRule{
+		HotelID:        in.GetHotelId(),
+		RoomType:       roomTypeFromProto(in.GetRoomType()),
+		OccupancyTiers: make([]entities.OccupancyTier, 0, len(in.GetOccupancyTiers())),
+		MinPrice:       moneyFromProto(in.GetMinPrice()),
+		MaxPrice:       moneyFromProto(in.GetMaxPrice()),
+	}
+	for k, v := range pricingStrategiesToProto {
+		if v == in.GetStrategy() {
+			rule.Strategy = k
+		}
+	}
+	for _, t := range in.GetOccupancyTiers() {
+		rule.OccupancyTiers = append(rule.OccupancyTiers, entities.OccupancyTier{
+			MinOccupancyPercent: int(t.GetMinOccupancyPercent()),
+			AdjustmentPercent:   int(t.GetAdjustmentPercent()),
+		})
+	}
+
+	res, err := h.bookingController.SetPricingRule(ctx, rule)
+	if err != nil {
+		return nil, pricingRuleError(err, "hotel not found")
+	}
+
+	return &generated.SetPricingRuleResponse{
+		Rule: h.makePricingRuleToResponse(res),
+	}, nil
+}
+
+func pricingRuleError(err error, notFound string) error {
+	switch {
+	case errors.Is(err, entities.ErrInvalidRoomType) ||
+		errors.Is(err, entities.ErrInvalidStrategy) ||
+		errors.Is(err, entities.ErrInvalidOccupancyTier) ||
+		errors.Is(err, entities.ErrInvalidPrice) ||
+		errors.Is(err, entities.ErrInvalidCurrency) ||
+		errors.Is(err, entities.ErrHotelCurrencyMismatch) ||
+		errors.Is(err, entities.ErrInvalidPriceGuard):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entities.ErrNotFound):
+		return status.Error(codes.NotFound, notFound)
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (h *Handler) makePricingRuleToResponse(in entities.PricingRule) *generated.PricingRule {
+	tiers := make([]*generated.OccupancyTier, 0, len(in.OccupancyTiers))
+	for _, t := range in.OccupancyTiers {
+		tiers = append(tiers, &generated.OccupancyTier{
+			MinOccupancyPercent: uint32(t.MinOccupancyPercent),
+			AdjustmentPercent:   int32(t.AdjustmentPercent),
+		})
+	}
+
+	res := &generated.PricingRule{
+		HotelId:        in.HotelID,
+		RoomType:       generated.RoomType(generated.RoomType_value[in.RoomType]),
+		Strategy:       pricingStrategiesToProto[in.Strategy],
+		OccupancyTiers: tiers,
+		UpdatedAt:      timestamppb.New(in.UpdatedAt),
+	}
+	if in.MinPrice.Amount > 0 {
+		res.MinPrice = moneyToProto(in.MinPrice)
+	}
+	if in.MaxPrice.Amount > 0 {
+		res.MaxPrice = moneyToProto(in.MaxPrice)
+	}
+	return res
+}
+
+func makeAdjustmentsToResponse(in []entities.PriceAdjustment) []*generated.PriceAdjustment {
+	res := make([]*generated.PriceAdjustment, 0, len(in))
+	for _, a := range in {
+		res = append(res, &generated.PriceAdjustment{
+			Rule:        a.Rule,
+			Description: a.Description,
+			Amount:      moneyToProto(a.Amount),
+		})
+	}
+	return res
+}
@@ -0,0 +1,74 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *Handler) SetRates(ctx context.Context, in *generated.SetRatesRequest) (*generated.SetRatesResponse, error) {
+	ctx, span := tracing.Start(ctx, "handlers.SetRates")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "SetRates", "request", logger.Redact(in))
+
+	input := entities.SetRatesDTO{
+		HotelID: in.GetHotelId(),
+		Ranges:  make([]entities.RateRange, 0, len(in.GetRanges())),
+	}
+	for _, r := range in.GetRanges() {
+		weekdays := make([]time.Weekday, 0, len(r.GetWeekdays()))
+		for _, w := range r.GetWeekdays() {
+			weekdays = append(weekdays, weekdayFromProto(w))
+		}
+		input.Ranges = append(input.Ranges, entities.RateRange{
+			RoomType:  roomTypeFromProto(r.GetRoomType()),
+			StartDate: optionalTime(r.GetStartDate()),
+			EndDate:   optionalTime(r.GetEndDate()),
+			Weekdays:  weekdays,
+			Price:     moneyFromProto(r.GetPrice()),
+		})
+	}
+
+	if err := h.bookingController.SetRates(ctx, input); err != nil {
+		return nil, rateCalendarError(err)
+	}
+
+	return &generated.SetRatesResponse{}, nil
+}
+
+func rateCalendarError(err error) error {
+	switch {
+	case errors.Is(err, entities.ErrEmptyRates) ||
+		errors.Is(err, entities.ErrInvalidRoomType) ||
+		errors.Is(err, entities.ErrStartDateIsAfterEndDate) ||
+		errors.Is(err, entities.ErrRateRangeTooLong) ||
+		errors.Is(err, entities.ErrInvalidWeekday) ||
+		errors.Is(err, entities.ErrInvalidPrice) ||
+		errors.Is(err, entities.ErrInvalidCurrency) ||
+		errors.Is(err, entities.ErrHotelCurrencyMismatch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entities.ErrNotFound):
+		return status.Error(codes.NotFound, "hotel not found")
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// weekdayFromProto переводит день недели в time.Weekday; неизвестное значение
+// превращается в недопустимый день и отклоняется валидацией
+func weekdayFromProto(w generated.Weekday) time.Weekday {
+	if w < generated.Weekday_WEEKDAY_MONDAY || w > generated.Weekday_WEEKDAY_SUNDAY {
+		return -1
+	}
+	return time.Weekday(w % 7)
+}
@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) SettleFolio(ctx context.Context, in *generated.SettleFolioRequest) (
+	*generated.SettleFolioResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.SettleFolio")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "SettleFolio", "request", logger.Redact(in))
+
+	folio, err := h.bookingController.SettleFolio(ctx, in.GetBookingId(), moneyFromProto(in.GetAmount()))
+	if err != nil {
+		return nil, folioError(err)
+	}
+
+	return &generated.SettleFolioResponse{
+		Folio: makeFolioToResponse(folio),
+	}, nil
+}
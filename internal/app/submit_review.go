@@ -2,16 +2,27 @@ package app
 
 import (
 	"context"
+	"errors"
 
 	"booking-service/internal/entities"
 	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (h *Handler) SubmitReview(ctx context.Context, in *generated.SubmitReviewRequest) (
 	*generated.SubmitReviewResponse, error,
 ) {
+	ctx, span := tracing.Start(ctx, "handlers.SubmitReview")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "SubmitReview", "request", logger.Redact(in))
+
 	review, err := h.bookingController.SubmitReview(ctx, entities.ReviewDTO{
 		BookingID: in.BookingId,
 		GuestID:   in.GuestId,
@@ -19,17 +30,29 @@ func (h *Handler) SubmitReview(ctx context.Context, in *generated.SubmitReviewRe
 		Comment:   in.Comment,
 	})
 	if err != nil {
-		return nil, err
+		switch {
+		case errors.Is(err, entities.ErrGuestNotInBooking):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, entities.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "booking not found")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	}
 
 	return &generated.SubmitReviewResponse{
-		Review: &generated.Review{
-			Id:        review.ID,
-			CreatedAt: timestamppb.New(review.CreatedAt),
-			UpdatedAt: timestamppb.New(review.UpdatedAt),
-			BookingId: review.BookingID,
-			Rating:    int32(review.Rating),
-			Comment:   review.Comment,
-		},
+		Review: makeReviewToResponse(review),
 	}, nil
 }
+
+func makeReviewToResponse(in entities.Review) *generated.Review {
+	return &generated.Review{
+		Id:        in.ID,
+		CreatedAt: timestamppb.New(in.CreatedAt),
+		UpdatedAt: timestamppb.New(in.UpdatedAt),
+		BookingId: in.BookingID,
+		GuestId:   in.GuestID,
+		Rating:    int32(in.Rating),
+		Comment:   in.Comment,
+	}
+}
@@ -0,0 +1,34 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) UpdateEmployee(ctx context.Context, in *generated.UpdateEmployeeRequest) (
+	*generated.UpdateEmployeeResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.UpdateEmployee")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "UpdateEmployee", "request", logger.Redact(in))
+
+	employee, err := h.bookingController.UpdateEmployee(ctx, in.GetEmployeeId(), entities.EmployeeDTO{
+		Name:    in.GetName(),
+		Role:    employeeRoleFromProto(in.GetRole()),
+		HotelID: in.GetHotelId(),
+		Subject: in.GetSubject(),
+	})
+	if err != nil {
+		return nil, employeeError(err)
+	}
+
+	return &generated.UpdateEmployeeResponse{
+		Employee: h.makeEmployeeToResponse(employee),
+	}, nil
+}
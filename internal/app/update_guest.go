@@ -0,0 +1,62 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) UpdateGuest(ctx context.Context, in *generated.UpdateGuestRequest) (
+	*generated.UpdateGuestResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.UpdateGuest")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "UpdateGuest", "request", logger.Redact(in))
+
+	guest := entities.Guest{
+		ID:          in.GetGuestId(),
+		Name:        in.GetName(),
+		Email:       in.GetEmail(),
+		Phone:       in.GetPhone(),
+		Nationality: in.GetNationality(),
+	}
+	if in.GetDateOfBirth() != nil {
+		guest.DateOfBirth = in.GetDateOfBirth().AsTime()
+	}
+	if a := in.GetAddress(); a != nil {
+		guest.Address = entities.Address{
+			Line1:      a.GetLine1(),
+			Line2:      a.GetLine2(),
+			City:       a.GetCity(),
+			Region:     a.GetRegion(),
+			PostalCode: a.GetPostalCode(),
+			Country:    a.GetCountry(),
+		}
+	}
+	if d := in.GetDocument(); d != nil {
+		for k, v := range documentTypesToProto {
+			if v == d.GetType() {
+				guest.DocumentType = k
+			}
+		}
+		guest.DocumentNumber = d.GetNumber()
+		guest.DocumentCountry = d.GetIssuingCountry()
+		if d.GetExpiresAt() != nil {
+			guest.DocumentExpiresAt = d.GetExpiresAt().AsTime()
+		}
+	}
+
+	res, err := h.bookingController.UpdateGuest(ctx, guest)
+	if err != nil {
+		return nil, guestError(err)
+	}
+
+	return &generated.UpdateGuestResponse{
+		Guest: makeGuestProfileToResponse(res),
+	}, nil
+}
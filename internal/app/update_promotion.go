@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) UpdatePromotion(ctx context.Context, in *generated.UpdatePromotionRequest) (
+	*generated.UpdatePromotionResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.UpdatePromotion")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "UpdatePromotion", "request", logger.Redact(in))
+
+	promotion, err := h.bookingController.UpdatePromotion(ctx, in.GetPromotionId(), promotionDTOFromProto(in.GetTerms()))
+	if err != nil {
+		return nil, promotionError(err, "promotion not found")
+	}
+
+	return &generated.UpdatePromotionResponse{
+		Promotion: makePromotionToResponse(promotion),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) UpdateRatePlan(ctx context.Context, in *generated.UpdateRatePlanRequest) (
+	*generated.UpdateRatePlanResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.UpdateRatePlan")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "UpdateRatePlan", "request", logger.Redact(in))
+
+	plan, err := h.bookingController.UpdateRatePlan(ctx, in.GetRatePlanId(), entities.RatePlanDTO{
+		RoomType:     roomTypeFromProto(in.GetRoomType()),
+		Name:         in.GetName(),
+		NightlyPrice: moneyFromProto(in.GetNightlyPrice()),
+		ValidFrom:    optionalTime(in.GetValidFrom()),
+		ValidTo:      optionalTime(in.GetValidTo()),
+	})
+	if err != nil {
+		return nil, ratePlanError(err, "rate plan not found")
+	}
+
+	return &generated.UpdateRatePlanResponse{
+		RatePlan: h.makeRatePlanToResponse(plan),
+	}, nil
+}
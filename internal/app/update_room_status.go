@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) UpdateRoomStatus(ctx context.Context, in *generated.UpdateRoomStatusRequest) (
+	*generated.UpdateRoomStatusResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.UpdateRoomStatus")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "UpdateRoomStatus", "request", logger.Redact(in))
+
+	room, err := h.bookingController.UpdateRoomStatus(ctx, in.GetRoomId(), entities.RoomStatus(in.GetStatus()))
+	if err != nil {
+		switch {
+		case errors.Is(err, entities.ErrInvalidRoomStatus):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, entities.ErrNotFound):
+			return nil, status.Error(codes.NotFound, "room not found")
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &generated.UpdateRoomStatusResponse{
+		Room: h.makeRoomToResponse(room),
+	}, nil
+}
+
+func (h *Handler) makeRoomToResponse(in entities.Room) *generated.Room {
+	return &generated.Room{
+		Id:        in.ID,
+		CreatedAt: timestamppb.New(in.CreatedAt),
+		UpdatedAt: timestamppb.New(in.UpdatedAt),
+		Number:    in.Number,
+		Type:      generated.RoomType(generated.RoomType_value[in.Type]),
+		HotelId:   in.HotelID,
+		Status:    generated.RoomStatus(in.Status),
+	}
+}
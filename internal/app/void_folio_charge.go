@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+
+	"booking-service/internal/generated"
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
+)
+
+func (h *Handler) VoidFolioCharge(ctx context.Context, in *generated.VoidFolioChargeRequest) (
+	*generated.VoidFolioChargeResponse, error,
+) {
+	ctx, span := tracing.Start(ctx, "handlers.VoidFolioCharge")
+	defer span.End()
+
+	logger.FromContext(ctx).DebugContext(ctx, "received request",
+		"handler", "VoidFolioCharge", "request", logger.Redact(in))
+
+	folio, err := h.bookingController.VoidFolioCharge(ctx, in.GetBookingId(), in.GetChargeId(), in.GetReason())
+	if err != nil {
+		return nil, folioError(err)
+	}
+
+	return &generated.VoidFolioChargeResponse{
+		Folio: makeFolioToResponse(folio),
+	}, nil
+}
@@ -0,0 +1,64 @@
+// Package audit собирает записи журнала аудита: кто, через какой RPC и в
+// рамках какого запроса изменил сущность, и что именно изменилось
+package audit
+
+import (
+	"context"
+	"strconv"
+
+	"booking-service/internal/auth"
+	"booking-service/internal/authz"
+	"booking-service/internal/entities"
+	"booking-service/internal/logger"
+
+	"google.golang.org/grpc"
+)
+
+const actorAnonymous = "anonymous"
+
+// NewEvent заполняет событие данными вызывающего из контекста запроса.
+// before — состояние до изменения (nil при создании), after — после (nil при удалении)
+func NewEvent(
+	ctx context.Context, entityType entities.AuditEntityType, entityID, hotelID uint64,
+	action entities.AuditAction, before, after any,
+) (entities.AuditEvent, error) {
+	diff, err := Diff(before, after)
+	if err != nil {
+		return entities.AuditEvent{}, err
+	}
+
+	event := entities.AuditEvent{
+		ActorKind:  actorAnonymous,
+		Actor:      actorAnonymous,
+		EntityType: entityType,
+		EntityID:   entityID,
+		HotelID:    hotelID,
+		Action:     action,
+		Diff:       diff,
+		RequestID:  logger.RequestIDFromContext(ctx),
+	}
+	if method, ok := grpc.Method(ctx); ok {
+		event.RPC = method
+	}
+	if p := auth.PrincipalFromContext(ctx); p != nil {
+		event.ActorKind = string(p.Kind)
+	}
+	if e := authz.EmployeeFromContext(ctx); e != nil {
+		event.EmployeeID = e.ID
+	}
+	event.Actor = Actor(ctx)
+
+	return event, nil
+}
+
+// Actor возвращает того, кто выполняет запрос, в том виде, в котором он
+// записывается в журнал
+func Actor(ctx context.Context) string {
+	if p := auth.PrincipalFromContext(ctx); p != nil && p.Subject != "" {
+		return p.Subject
+	}
+	if e := authz.EmployeeFromContext(ctx); e != nil {
+		return "employee:" + strconv.FormatUint(e.ID, 10)
+	}
+	return actorAnonymous
+}
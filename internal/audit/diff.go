@@ -0,0 +1,109 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// служебные поля меняются при каждом сохранении и в diff не попадают
+var skipFields = map[string]struct{}{
+	"created_at": {},
+	"updated_at": {},
+}
+
+// redacted заменяет в журнале значения полей с тегом audit:"redact": видно,
+// что поле изменилось, но не само значение
+const redacted = "[REDACTED]"
+
+type change struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// Diff возвращает изменившиеся поля в виде {"column": {"before": ..., "after": ...}}.
+// Поля берутся по тегам db, так что имена совпадают с колонками таблиц
+func Diff(before, after any) (json.RawMessage, error) {
+	b, err := snapshot(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := snapshot(after)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]change)
+	for k, v := range a {
+		if old, ok := b[k]; !ok || !equal(old.value, v.value) {
+			res[k] = change{Before: b[k].logged(), After: v.logged()}
+		}
+	}
+	for k, v := range b {
+		if _, ok := a[k]; !ok {
+			res[k] = change{Before: v.logged()}
+		}
+	}
+
+	raw, err := json.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("[audit.Diff]: %w", err)
+	}
+	return raw, nil
+}
+
+type field struct {
+	value  any
+	redact bool
+}
+
+// logged значение поля в том виде, в котором оно попадает в журнал
+func (f field) logged() any {
+	if f.redact && f.value != nil && !reflect.ValueOf(f.value).IsZero() {
+		return redacted
+	}
+	return f.value
+}
+
+func snapshot(v any) (map[string]field, error) {
+	res := make(map[string]field)
+	if v == nil {
+		return res, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return res, nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("[audit.Diff]: %T is not a struct", v)
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		tag := f.Tag.Get("db")
+		if !f.IsExported() || tag == "" || tag == "-" {
+			continue
+		}
+		if _, skip := skipFields[tag]; skip {
+			continue
+		}
+		res[tag] = field{value: rv.Field(i).Interface(), redact: f.Tag.Get("audit") == "redact"}
+	}
+	return res, nil
+}
+
+// equal сравнивает значения в том виде, в котором они попадут в журнал:
+// time.Time из базы и из запроса отличаются локацией, но не моментом
+func equal(a, b any) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return string(ja) == string(jb)
+}
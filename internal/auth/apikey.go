@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+)
+
+// APIKey статический ключ для межсервисных вызовов
+type APIKey struct {
+	// Name имя сервиса-владельца, становится Subject принципала
+	Name string
+	Key  string
+}
+
+// APIKeys сверяет ключи за постоянное время, храня только их хеши
+type APIKeys struct {
+	keys []apiKeyHash
+}
+
+type apiKeyHash struct {
+	name string
+	hash [sha256.Size]byte
+}
+
+func NewAPIKeys(keys []APIKey) (*APIKeys, error) {
+	res := &APIKeys{keys: make([]apiKeyHash, 0, len(keys))}
+	for _, k := range keys {
+		if k.Name == "" || k.Key == "" {
+			return nil, errors.New("[auth.NewAPIKeys]: api key must have name and key")
+		}
+		res.keys = append(res.keys, apiKeyHash{name: k.Name, hash: sha256.Sum256([]byte(k.Key))})
+	}
+	return res, nil
+}
+
+func (a *APIKeys) Validate(key string) (*Principal, bool) {
+	hash := sha256.Sum256([]byte(key))
+	var match *apiKeyHash
+	// проходим по всем ключам, чтобы время не зависело от позиции совпадения
+	for i := range a.keys {
+		if subtle.ConstantTimeCompare(hash[:], a.keys[i].hash[:]) == 1 {
+			match = &a.keys[i]
+		}
+	}
+	if match == nil {
+		return nil, false
+	}
+	return &Principal{
+		Kind:    PrincipalKindService,
+		Subject: match.name,
+	}, true
+}
@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"booking-service/internal/logger"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// BookingTokenHeader HTTP-заголовок с токеном доступа гостя к бронированию
+	BookingTokenHeader      = "X-Booking-Token"
+	bookingTokenMetadataKey = "x-booking-token"
+	bookingTokenIssuer      = "booking_service"
+	bookingTokenAudience    = "booking_guest"
+)
+
+// BookingTokens выпускает и проверяет токены доступа гостя к бронированиям
+// (HS256 с секретом из конфига). Токен выдается при создании бронирования
+// и дает доступ к нему, а также к профилям гостей, созданным этим бронированием,
+// и к их бронированиям. Профили, найденные сопоставлением, в токен не попадают:
+// данные гостя никто не подтверждал.
+type BookingTokens struct {
+	secret []byte
+	ttl    time.Duration
+	parser *jwt.Parser
+	now    func() time.Time
+}
+
+type bookingClaims struct {
+	jwt.RegisteredClaims
+	BookingID uint64   `json:"booking_id"`
+	GuestIDs  []uint64 `json:"guest_ids"`
+}
+
+// NewBookingTokens; ttl отсчитывается от даты выезда, чтобы гость мог
+// оставить отзыв после проживания
+func NewBookingTokens(secret string, ttl time.Duration) (*BookingTokens, error) {
+	if len(secret) < 32 {
+		return nil, errors.New("[auth.NewBookingTokens]: secret must be at least 32 bytes")
+	}
+	return &BookingTokens{
+		secret: []byte(secret),
+		ttl:    ttl,
+		parser: jwt.NewParser(
+			jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+			jwt.WithExpirationRequired(),
+			jwt.WithIssuer(bookingTokenIssuer),
+			jwt.WithAudience(bookingTokenAudience),
+		),
+		now: time.Now,
+	}, nil
+}
+
+func (t *BookingTokens) Issue(bookingID uint64, guestIDs []uint64, endDate time.Time) (string, error) {
+	now := t.now()
+	expiresAt := endDate
+	if expiresAt.Before(now) {
+		expiresAt = now
+	}
+	claims := bookingClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    bookingTokenIssuer,
+			Audience:  jwt.ClaimStrings{bookingTokenAudience},
+			Subject:   "booking:" + strconv.FormatUint(bookingID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt.Add(t.ttl)),
+		},
+		BookingID: bookingID,
+		GuestIDs:  guestIDs,
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(t.secret)
+	if err != nil {
+		return "", fmt.Errorf("[auth.BookingTokens]: sign: %w", err)
+	}
+	return token, nil
+}
+
+func (t *BookingTokens) Validate(token string) (*Principal, error) {
+	var claims bookingClaims
+	_, err := t.parser.ParseWithClaims(token, &claims, func(*jwt.Token) (any, error) {
+		return t.secret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if claims.BookingID == 0 {
+		return nil, errors.New("token has no booking")
+	}
+	return &Principal{
+		Kind:      PrincipalKindGuest,
+		Subject:   claims.Subject,
+		BookingID: claims.BookingID,
+		GuestIDs:  claims.GuestIDs,
+	}, nil
+}
+
+// UnaryServerInterceptor кладет в контекст гостя, если передан X-Booking-Token.
+// Ставится перед Authenticator: запрос с валидным токеном гостя считается
+// аутентифицированным, а доступные гостю методы определяет authz.
+func (t *BookingTokens) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		token := first(md, bookingTokenMetadataKey)
+		// учетные данные сотрудника или сервиса имеют приоритет
+		if token == "" || first(md, authorizationKey) != "" || first(md, apiKeyMetadataKey) != "" {
+			return handler(ctx, req)
+		}
+
+		p, err := t.Validate(token)
+		if err != nil {
+			logger.FromContext(ctx).WarnContext(ctx, "invalid booking token", "error", err)
+			return nil, status.Errorf(codes.Unauthenticated, "invalid booking token: %v", err)
+		}
+		ctx = logger.WithContext(ctx, logger.FromContext(ctx).With("principal", p.Subject))
+		return handler(WithPrincipal(ctx, p), req)
+	}
+}
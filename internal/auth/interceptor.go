@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"booking-service/internal/logger"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// APIKeyHeader HTTP-заголовок с API-ключом, шлюз пробрасывает его в метаданные
+	APIKeyHeader            = "X-Api-Key"
+	apiKeyMetadataKey       = "x-api-key"
+	authorizationKey        = "authorization"
+	bearerPrefix            = "bearer "
+	healthServicePrefix     = "/grpc.health.v1.Health/"
+	reflectionServicePrefix = "/grpc.reflection."
+)
+
+// Authenticator проверяет JWT или API-ключ и кладет Principal в контекст
+type Authenticator struct {
+	jwt     *JWTValidator
+	apiKeys *APIKeys
+	public  map[string]struct{}
+}
+
+// NewAuthenticator; любой из валидаторов может быть nil, если способ не настроен.
+// publicMethods — полные имена gRPC-методов, доступных без аутентификации.
+func NewAuthenticator(jwt *JWTValidator, apiKeys *APIKeys, publicMethods ...string) *Authenticator {
+	public := make(map[string]struct{}, len(publicMethods))
+	for _, m := range publicMethods {
+		public[m] = struct{}{}
+	}
+	return &Authenticator{
+		jwt:     jwt,
+		apiKeys: apiKeys,
+		public:  public,
+	}
+}
+
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (a *Authenticator) isPublic(method string) bool {
+	if strings.HasPrefix(method, healthServicePrefix) || strings.HasPrefix(method, reflectionServicePrefix) {
+		return true
+	}
+	_, ok := a.public[method]
+	return ok
+}
+
+func (a *Authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if a.isPublic(method) {
+		return ctx, nil
+	}
+	// гость уже аутентифицирован токеном бронирования
+	if p := PrincipalFromContext(ctx); p != nil && p.Kind == PrincipalKindGuest {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	p, err := a.principal(md)
+	if err != nil {
+		logger.FromContext(ctx).WarnContext(ctx, "authentication failed", "error", err)
+		return ctx, err
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.String("enduser.id", p.Subject),
+		attribute.String("enduser.kind", string(p.Kind)),
+	)
+	ctx = logger.WithContext(ctx, logger.FromContext(ctx).With("principal", p.Subject))
+	return WithPrincipal(ctx, p), nil
+}
+
+func (a *Authenticator) principal(md metadata.MD) (*Principal, error) {
+	if key := first(md, apiKeyMetadataKey); key != "" {
+		if a.apiKeys == nil {
+			return nil, status.Error(codes.Unauthenticated, "api keys are not accepted")
+		}
+		p, ok := a.apiKeys.Validate(key)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "invalid api key")
+		}
+		return p, nil
+	}
+
+	if header := first(md, authorizationKey); header != "" {
+		if len(header) < len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+			return nil, status.Error(codes.Unauthenticated, "authorization header must use Bearer scheme")
+		}
+		if a.jwt == nil {
+			return nil, status.Error(codes.Unauthenticated, "bearer tokens are not accepted")
+		}
+		p, err := a.jwt.Validate(strings.TrimSpace(header[len(bearerPrefix):]))
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+		}
+		return p, nil
+	}
+
+	return nil, status.Error(codes.Unauthenticated, "missing credentials")
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
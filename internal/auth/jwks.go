@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const maxJWKSSize = 1 << 20
+
+// KeySet набор публичных ключей из JWKS (RFC 7517), загружаемый из файла или по URL
+type KeySet struct {
+	source   string
+	isURL    bool
+	client   *http.Client
+	interval time.Duration
+	logger   *slog.Logger
+
+	keys atomic.Pointer[map[string]crypto.PublicKey]
+}
+
+// NewKeySet загружает JWKS; source — путь к файлу или http(s) URL.
+// Набор перечитывается каждые refreshInterval, если он больше нуля.
+func NewKeySet(ctx context.Context, source string, refreshInterval time.Duration, logger *slog.Logger) (*KeySet, error) {
+	ks := &KeySet{
+		source:   source,
+		isURL:    strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://"),
+		client:   &http.Client{Timeout: 10 * time.Second},
+		interval: refreshInterval,
+		logger:   logger,
+	}
+	if err := ks.refresh(ctx); err != nil {
+		return nil, fmt.Errorf("[auth.NewKeySet]: %w", err)
+	}
+	return ks, nil
+}
+
+// Run периодически перечитывает JWKS до отмены контекста
+func (ks *KeySet) Run(ctx context.Context) {
+	if ks.interval <= 0 {
+		return
+	}
+	t := time.NewTicker(ks.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := ks.refresh(ctx); err != nil {
+				ks.logger.Error("jwks refresh failed, keeping previous keys", "source", ks.source, "error", err)
+			}
+		}
+	}
+}
+
+// Key возвращает ключ по kid. Если kid пустой, а ключ в наборе один — возвращает его.
+func (ks *KeySet) Key(kid string) (crypto.PublicKey, bool) {
+	keys := *ks.keys.Load()
+	if kid == "" && len(keys) == 1 {
+		for _, k := range keys {
+			return k, true
+		}
+	}
+	k, ok := keys[kid]
+	return k, ok
+}
+
+func (ks *KeySet) refresh(ctx context.Context) error {
+	raw, err := ks.read(ctx)
+	if err != nil {
+		return err
+	}
+	keys, err := parseJWKS(raw)
+	if err != nil {
+		return err
+	}
+	ks.keys.Store(&keys)
+	return nil
+}
+
+func (ks *KeySet) read(ctx context.Context) ([]byte, error) {
+	if !ks.isURL {
+		return os.ReadFile(ks.source)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.source, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := ks.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("jwks %s: unexpected status %s", ks.source, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+}
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func parseJWKS(raw []byte) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(raw, &set); err != nil {
+		return nil, fmt.Errorf("parse jwks: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("jwk %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = pub
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("jwks contains no signing keys")
+	}
+	return keys, nil
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("modulus: %w", err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("exponent: %w", err)
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("exponent is too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("x: %w", err)
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("y: %w", err)
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWTValidator проверяет bearer-токены, подписанные ключами из JWKS (RS256/ES256)
+type JWTValidator struct {
+	keys   *KeySet
+	parser *jwt.Parser
+}
+
+func NewJWTValidator(keys *KeySet, issuer, audience string, leeway time.Duration) *JWTValidator {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	}
+	if issuer != "" {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+	if audience != "" {
+		opts = append(opts, jwt.WithAudience(audience))
+	}
+	return &JWTValidator{
+		keys:   keys,
+		parser: jwt.NewParser(opts...),
+	}
+}
+
+func (v *JWTValidator) Validate(token string) (*Principal, error) {
+	claims := jwt.MapClaims{}
+	_, err := v.parser.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, ok := v.keys.Key(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		return key, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := claims.GetSubject()
+	if err != nil || sub == "" {
+		return nil, errors.New("token has no subject")
+	}
+	return &Principal{
+		Kind:    PrincipalKindUser,
+		Subject: sub,
+		Claims:  claims,
+	}, nil
+}
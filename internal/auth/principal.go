@@ -0,0 +1,39 @@
+package auth
+
+import "context"
+
+type PrincipalKind string
+
+const (
+	// PrincipalKindUser пользователь, аутентифицированный JWT
+	PrincipalKindUser PrincipalKind = "user"
+	// PrincipalKindService сервис, аутентифицированный статическим API-ключом
+	PrincipalKindService PrincipalKind = "service"
+	// PrincipalKindGuest гость, предъявивший токен доступа к бронированию
+	PrincipalKindGuest PrincipalKind = "guest"
+)
+
+// Principal аутентифицированный вызывающий
+type Principal struct {
+	Kind    PrincipalKind
+	Subject string
+	// Claims исходные claims JWT; для API-ключей пусто
+	Claims map[string]any
+	// BookingID бронирование, для которого выдан токен гостя
+	BookingID uint64
+	// GuestIDs гости, профили которых созданы вместе с бронированием токена
+	GuestIDs []uint64
+}
+
+type ctxPrincipalKey struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, ctxPrincipalKey{}, p)
+}
+
+// PrincipalFromContext возвращает вызывающего или nil, если запрос анонимный
+// (публичный метод или аутентификация выключена)
+func PrincipalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(ctxPrincipalKey{}).(*Principal)
+	return p
+}
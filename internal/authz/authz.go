@@ -0,0 +1,60 @@
+// Package authz проверяет права сотрудников на вызов RPC: роль сотрудника
+// и отель, к которому он привязан, сверяются с таблицей политик
+package authz
+
+import (
+	"context"
+
+	"booking-service/internal/entities"
+)
+
+// Directory источник данных о сотрудниках и принадлежности сущностей отелям
+type Directory interface {
+	FindEmployeeBySubject(ctx context.Context, subject string) (entities.Employee, error)
+	HotelIDByRoomID(ctx context.Context, roomID uint64) (uint64, error)
+	HotelIDByBookingID(ctx context.Context, bookingID uint64) (uint64, error)
+	HotelIDByEmployeeID(ctx context.Context, employeeID uint64) (uint64, error)
+	HotelIDByRatePlanID(ctx context.Context, ratePlanID uint64) (uint64, error)
+	HotelIDByTaxRuleID(ctx context.Context, taxRuleID uint64) (uint64, error)
+	HotelIDByPromotionID(ctx context.Context, promotionID uint64) (uint64, error)
+	HotelIDByInvoiceID(ctx context.Context, invoiceID uint64) (uint64, error)
+	IsGuestInBooking(ctx context.Context, bookingID uint64, guestIDs []uint64) (bool, error)
+	IsGuestInHotel(ctx context.Context, guestID, hotelID uint64) (bool, error)
+}
+
+type ctxEmployeeKey struct{}
+
+func WithEmployee(ctx context.Context, e *entities.Employee) context.Context {
+	return context.WithValue(ctx, ctxEmployeeKey{}, e)
+}
+
+// EmployeeFromContext возвращает сотрудника, выполняющего запрос, или nil,
+// если вызывающий не сотрудник (сервис или аутентификация выключена)
+func EmployeeFromContext(ctx context.Context) *entities.Employee {
+	e, _ := ctx.Value(ctxEmployeeKey{}).(*entities.Employee)
+	return e
+}
+
+// Allowed проверяет, может ли сотрудник выполнить действие с ролями roles
+// в отелях hotelIDs. Администратор не привязан к отелю
+func Allowed(e entities.Employee, roles []entities.EmployeeRole, hotelIDs []uint64) bool {
+	if e.Role == entities.EmployeeRoleAdmin {
+		return true
+	}
+	permitted := false
+	for _, r := range roles {
+		if r == e.Role {
+			permitted = true
+			break
+		}
+	}
+	if !permitted {
+		return false
+	}
+	for _, id := range hotelIDs {
+		if id != e.HotelID {
+			return false
+		}
+	}
+	return true
+}
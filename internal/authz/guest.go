@@ -0,0 +1,96 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"booking-service/internal/auth"
+	"booking-service/internal/generated"
+)
+
+var (
+	errNotOwner     = errors.New("booking does not belong to guest")
+	errNotSameGuest = errors.New("guest profile belongs to another guest")
+)
+
+// GuestRule проверяет, что запрос гостя касается только его бронирований
+type GuestRule func(ctx context.Context, d Directory, p *auth.Principal, req any) error
+
+// DefaultGuestPolicy методы, доступные гостю по токену бронирования.
+// Сотрудники выполняют те же методы по DefaultPolicy в пределах своего отеля
+func DefaultGuestPolicy() map[string]GuestRule {
+	return map[string]GuestRule{
+		generated.BookingService_ListMyBookings_FullMethodName: func(context.Context, Directory, *auth.Principal, any) error {
+			return nil
+		},
+		// гость видит и правит только свой профиль. В токене только профили,
+		// созданные вместе с бронированием: найденный сопоставлением профиль
+		// по токену недоступен
+		generated.BookingService_GetGuest_FullMethodName: isGuest(
+			func(req any) uint64 { return req.(*generated.GetGuestRequest).GetGuestId() },
+		),
+		generated.BookingService_UpdateGuest_FullMethodName: isGuest(
+			func(req any) uint64 { return req.(*generated.UpdateGuestRequest).GetGuestId() },
+		),
+		// выгрузка своих данных по запросу субъекта данных; как и для GetGuest,
+		// доступна только по профилю из токена
+		generated.BookingService_ExportGuestData_FullMethodName: isGuest(
+			func(req any) uint64 { return req.(*generated.ExportGuestDataRequest).GetGuestId() },
+		),
+		generated.BookingService_ModifyBooking_FullMethodName: ownsBooking(
+			func(req any) uint64 { return req.(*generated.ModifyBookingRequest).GetBookingId() },
+		),
+		generated.BookingService_CancelBooking_FullMethodName: ownsBooking(
+			func(req any) uint64 { return req.(*generated.CancelBookingRequest).GetBookingId() },
+		),
+		generated.BookingService_PayBooking_FullMethodName: ownsBooking(
+			func(req any) uint64 { return req.(*generated.PayBookingRequest).GetBookingId() },
+		),
+		// гость видит свой счет и может оплатить его сам
+		generated.BookingService_GetFolio_FullMethodName: ownsBooking(
+			func(req any) uint64 { return req.(*generated.GetFolioRequest).GetBookingId() },
+		),
+		generated.BookingService_SettleFolio_FullMethodName: ownsBooking(
+			func(req any) uint64 { return req.(*generated.SettleFolioRequest).GetBookingId() },
+		),
+		generated.BookingService_SubmitReview_FullMethodName: func(
+			ctx context.Context, d Directory, p *auth.Principal, req any,
+		) error {
+			in := req.(*generated.SubmitReviewRequest)
+			// гость пишет отзыв только от своего имени
+			if !slices.Contains(p.GuestIDs, in.GetGuestId()) {
+				return errNotOwner
+			}
+			return ownsBooking(func(any) uint64 { return in.GetBookingId() })(ctx, d, p, req)
+		},
+	}
+}
+
+func ownsBooking(id func(req any) uint64) GuestRule {
+	return func(ctx context.Context, d Directory, p *auth.Principal, req any) error {
+		if p.BookingID == id(req) {
+			return nil
+		}
+		if len(p.GuestIDs) == 0 {
+			return errNotOwner
+		}
+		ok, err := d.IsGuestInBooking(ctx, id(req), p.GuestIDs)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errNotOwner
+		}
+		return nil
+	}
+}
+
+func isGuest(id func(req any) uint64) GuestRule {
+	return func(_ context.Context, _ Directory, p *auth.Principal, req any) error {
+		if !slices.Contains(p.GuestIDs, id(req)) {
+			return errNotSameGuest
+		}
+		return nil
+	}
+}
@@ -0,0 +1,129 @@
+package authz
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/auth"
+	"booking-service/internal/entities"
+	"booking-service/internal/logger"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Authorizer пропускает сервисы, аутентифицированные API-ключом,
+// пользователей сопоставляет с сотрудниками и проверяет по таблице политик,
+// а гостям разрешает действия только со своими бронированиями.
+// Должен стоять в цепочке после auth.Authenticator
+type Authorizer struct {
+	dir         Directory
+	policy      map[string]Rule
+	guestPolicy map[string]GuestRule
+}
+
+func NewAuthorizer(dir Directory, policy map[string]Rule, guestPolicy map[string]GuestRule) *Authorizer {
+	return &Authorizer{
+		dir:         dir,
+		policy:      policy,
+		guestPolicy: guestPolicy,
+	}
+}
+
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (a *Authorizer) authorize(ctx context.Context, method string, req any) (context.Context, error) {
+	p := auth.PrincipalFromContext(ctx)
+	// публичный метод: Authenticator не положил вызывающего
+	if p == nil || p.Kind == auth.PrincipalKindService {
+		return ctx, nil
+	}
+
+	log := logger.FromContext(ctx)
+
+	if p.Kind == auth.PrincipalKindGuest {
+		return ctx, a.authorizeGuest(ctx, p, method, req)
+	}
+
+	employee, err := a.dir.FindEmployeeBySubject(ctx, p.Subject)
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			log.WarnContext(ctx, "access denied: caller is not an employee", "method", method)
+			return ctx, status.Error(codes.PermissionDenied, "caller is not an employee")
+		}
+		return ctx, status.Error(codes.Internal, err.Error())
+	}
+
+	rule, ok := a.policy[method]
+	if !ok {
+		log.WarnContext(ctx, "access denied: method is not in policy", "method", method)
+		return ctx, status.Error(codes.PermissionDenied, "method is not allowed")
+	}
+
+	var hotelIDs []uint64
+	if rule.Scope != nil && employee.Role != entities.EmployeeRoleAdmin {
+		hotelIDs, err = rule.Scope(ctx, a.dir, req)
+		switch {
+		case errors.Is(err, errAdminRoleForbidden):
+			return ctx, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, entities.ErrNotFound):
+			return ctx, status.Error(codes.NotFound, "resource not found")
+		case err != nil:
+			return ctx, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	if !Allowed(employee, rule.Roles, hotelIDs) {
+		log.WarnContext(ctx, "access denied",
+			"method", method, "role", employee.Role, "employee_hotel_id", employee.HotelID)
+		return ctx, status.Error(codes.PermissionDenied, "insufficient permissions")
+	}
+	if rule.Guest != nil && employee.Role != entities.EmployeeRoleAdmin {
+		ok, err := a.dir.IsGuestInHotel(ctx, rule.Guest(req), employee.HotelID)
+		if err != nil {
+			return ctx, status.Error(codes.Internal, err.Error())
+		}
+		if !ok {
+			log.WarnContext(ctx, "access denied: guest has no bookings in the hotel",
+				"method", method, "role", employee.Role, "employee_hotel_id", employee.HotelID)
+			return ctx, status.Error(codes.PermissionDenied, "insufficient permissions")
+		}
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.Int64("enduser.employee_id", int64(employee.ID)),
+		attribute.String("enduser.role", string(employee.Role)),
+	)
+	ctx = logger.WithContext(ctx, log.With("employee_id", employee.ID, "role", employee.Role))
+	return WithEmployee(ctx, &employee), nil
+}
+
+func (a *Authorizer) authorizeGuest(ctx context.Context, p *auth.Principal, method string, req any) error {
+	rule, ok := a.guestPolicy[method]
+	if !ok {
+		logger.FromContext(ctx).WarnContext(ctx, "access denied: method is not allowed for guests", "method", method)
+		return status.Error(codes.PermissionDenied, "method is not allowed for guests")
+	}
+
+	err := rule(ctx, a.dir, p, req)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, errNotOwner) || errors.Is(err, errNotSameGuest):
+		logger.FromContext(ctx).WarnContext(ctx, "access denied: not an owner", "method", method)
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,120 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"booking-service/internal/auth"
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeDirectory сотрудники по subject и отели, в которых есть бронирования гостей
+type fakeDirectory struct {
+	Directory
+
+	employees   map[string]entities.Employee
+	guestHotels map[uint64][]uint64
+}
+
+func (d *fakeDirectory) FindEmployeeBySubject(_ context.Context, subject string) (entities.Employee, error) {
+	e, ok := d.employees[subject]
+	if !ok {
+		return entities.Employee{}, entities.ErrNotFound
+	}
+	return e, nil
+}
+
+func (d *fakeDirectory) IsGuestInHotel(_ context.Context, guestID, hotelID uint64) (bool, error) {
+	for _, id := range d.guestHotels[guestID] {
+		if id == hotelID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+const (
+	guestOfHotel1 = 10
+	hotel1        = 1
+	hotel2        = 2
+)
+
+func newTestAuthorizer() *Authorizer {
+	dir := &fakeDirectory{
+		employees: map[string]entities.Employee{
+			"admin":        {ID: 1, Role: entities.EmployeeRoleAdmin},
+			"reception-1":  {ID: 2, Role: entities.EmployeeRoleReceptionist, HotelID: hotel1},
+			"reception-2":  {ID: 3, Role: entities.EmployeeRoleReceptionist, HotelID: hotel2},
+			"manager-1":    {ID: 4, Role: entities.EmployeeRoleManager, HotelID: hotel1},
+			"housekeeping": {ID: 5, Role: entities.EmployeeRoleHousekeeping, HotelID: hotel1},
+		},
+		guestHotels: map[uint64][]uint64{guestOfHotel1: {hotel1}},
+	}
+	return NewAuthorizer(dir, DefaultPolicy(), DefaultGuestPolicy())
+}
+
+func authorizeAs(a *Authorizer, subject, method string, req any) codes.Code {
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Kind: auth.PrincipalKindUser, Subject: subject})
+	_, err := a.authorize(ctx, method, req)
+	return status.Code(err)
+}
+
+func TestGuestAccessIsScopedToEmployeeHotel(t *testing.T) {
+	a := newTestAuthorizer()
+	get := &generated.GetGuestRequest{GuestId: guestOfHotel1}
+	update := &generated.UpdateGuestRequest{GuestId: guestOfHotel1}
+
+	for _, tc := range []struct {
+		subject string
+		want    codes.Code
+	}{
+		{"reception-1", codes.OK},
+		{"manager-1", codes.OK},
+		{"admin", codes.OK},
+		// у гостя нет бронирований в отеле сотрудника
+		{"reception-2", codes.PermissionDenied},
+		{"housekeeping", codes.PermissionDenied},
+	} {
+		assert.Equal(t, tc.want, authorizeAs(a, tc.subject, generated.BookingService_GetGuest_FullMethodName, get),
+			"GetGuest as %s", tc.subject)
+		assert.Equal(t, tc.want, authorizeAs(a, tc.subject, generated.BookingService_UpdateGuest_FullMethodName, update),
+			"UpdateGuest as %s", tc.subject)
+	}
+
+	// гость без бронирований недоступен никому, кроме администратора
+	get = &generated.GetGuestRequest{GuestId: 99}
+	assert.Equal(t, codes.PermissionDenied, authorizeAs(a, "reception-1", generated.BookingService_GetGuest_FullMethodName, get))
+	assert.Equal(t, codes.OK, authorizeAs(a, "admin", generated.BookingService_GetGuest_FullMethodName, get))
+}
+
+func TestSearchGuestsRequiresEmployeeHotel(t *testing.T) {
+	a := newTestAuthorizer()
+	method := generated.BookingService_SearchGuests_FullMethodName
+
+	assert.Equal(t, codes.OK, authorizeAs(a, "reception-1", method, &generated.SearchGuestsRequest{HotelId: hotel1}))
+	assert.Equal(t, codes.PermissionDenied, authorizeAs(a, "reception-1", method, &generated.SearchGuestsRequest{HotelId: hotel2}))
+	assert.Equal(t, codes.PermissionDenied, authorizeAs(a, "reception-1", method, &generated.SearchGuestsRequest{}))
+	assert.Equal(t, codes.OK, authorizeAs(a, "admin", method, &generated.SearchGuestsRequest{}))
+}
+
+func TestExportGuestDataIsAdminOnly(t *testing.T) {
+	a := newTestAuthorizer()
+	method := generated.BookingService_ExportGuestData_FullMethodName
+	req := &generated.ExportGuestDataRequest{GuestId: guestOfHotel1}
+
+	assert.Equal(t, codes.PermissionDenied, authorizeAs(a, "reception-1", method, req))
+	assert.Equal(t, codes.PermissionDenied, authorizeAs(a, "manager-1", method, req))
+	assert.Equal(t, codes.OK, authorizeAs(a, "admin", method, req))
+
+	// гость выгружает свои данные по токену бронирования
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{
+		Kind: auth.PrincipalKindGuest, BookingID: 1, GuestIDs: []uint64{guestOfHotel1},
+	})
+	_, err := a.authorize(ctx, method, req)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,314 @@
+package authz
+
+import (
+	"context"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+)
+
+var errAdminRoleForbidden = errors.New("only admin can grant admin role")
+
+// Rule правило доступа к RPC. Scope возвращает отели, которых касается запрос;
+// сотрудник должен быть привязан к каждому из них. Пустой список — запрос не
+// относится к конкретному отелю. Guest возвращает гостя, которого касается
+// запрос: гость не принадлежит отелю, поэтому сотруднику доступен, только если
+// у гостя есть бронирование в отеле сотрудника
+type Rule struct {
+	Roles []entities.EmployeeRole
+	Scope func(ctx context.Context, d Directory, req any) ([]uint64, error)
+	Guest func(req any) uint64
+}
+
+var (
+	managers   = []entities.EmployeeRole{entities.EmployeeRoleManager}
+	frontDesk  = []entities.EmployeeRole{entities.EmployeeRoleManager, entities.EmployeeRoleReceptionist}
+	roomStatus = []entities.EmployeeRole{
+		entities.EmployeeRoleManager, entities.EmployeeRoleReceptionist, entities.EmployeeRoleHousekeeping,
+	}
+	adminsOnly []entities.EmployeeRole
+)
+
+// DefaultPolicy таблица прав для BookingService. Методы, которых нет в
+// таблице, сотрудникам запрещены
+func DefaultPolicy() map[string]Rule {
+	return map[string]Rule{
+		generated.BookingService_CreateHotel_FullMethodName: {Roles: adminsOnly},
+
+		generated.BookingService_CreateRoom_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			in := req.(*generated.CreateRoomRequest)
+			ids := make([]uint64, 0, len(in.GetDto()))
+			for _, dto := range in.GetDto() {
+				ids = append(ids, dto.GetHotelId())
+			}
+			return ids, nil
+		}},
+		generated.BookingService_UpdateRoom_FullMethodName: {Roles: managers, Scope: func(
+			ctx context.Context, d Directory, req any,
+		) ([]uint64, error) {
+			in := req.(*generated.UpdateRoomRequest)
+			current, err := d.HotelIDByRoomID(ctx, in.GetRoomId())
+			if err != nil {
+				return nil, err
+			}
+			return []uint64{current, in.GetHotelId()}, nil
+		}},
+		generated.BookingService_UpdateRoomStatus_FullMethodName: {Roles: roomStatus, Scope: byRoom(
+			func(req any) uint64 { return req.(*generated.UpdateRoomStatusRequest).GetRoomId() },
+		)},
+
+		generated.BookingService_CreateBooking_FullMethodName: {Roles: frontDesk, Scope: byRoom(
+			func(req any) uint64 { return req.(*generated.CreateBookingRequest).GetRoomId() },
+		)},
+		generated.BookingService_ModifyBooking_FullMethodName: {Roles: frontDesk, Scope: byBooking(
+			func(req any) uint64 { return req.(*generated.ModifyBookingRequest).GetBookingId() },
+		)},
+		generated.BookingService_CancelBooking_FullMethodName: {Roles: frontDesk, Scope: byBooking(
+			func(req any) uint64 { return req.(*generated.CancelBookingRequest).GetBookingId() },
+		)},
+		generated.BookingService_SubmitReview_FullMethodName: {Roles: frontDesk, Scope: byBooking(
+			func(req any) uint64 { return req.(*generated.SubmitReviewRequest).GetBookingId() },
+		)},
+		// в ответе CreateGuest нет персональных данных, даже если профиль найден сопоставлением
+		generated.BookingService_CreateGuest_FullMethodName: {Roles: frontDesk},
+		generated.BookingService_GetGuest_FullMethodName: {Roles: frontDesk, Guest: func(req any) uint64 {
+			return req.(*generated.GetGuestRequest).GetGuestId()
+		}},
+		generated.BookingService_UpdateGuest_FullMethodName: {Roles: frontDesk, Guest: func(req any) uint64 {
+			return req.(*generated.UpdateGuestRequest).GetGuestId()
+		}},
+		// без hotel_id гостей всех отелей ищет только администратор
+		generated.BookingService_SearchGuests_FullMethodName: {Roles: frontDesk, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.SearchGuestsRequest).GetHotelId()}, nil
+		}},
+		// выгрузка, объединение, отчет о дубликатах и удаление данных затрагивают
+		// гостей всех отелей
+		generated.BookingService_ExportGuestData_FullMethodName:     {Roles: adminsOnly},
+		generated.BookingService_MergeGuests_FullMethodName:         {Roles: adminsOnly},
+		generated.BookingService_ListDuplicateGuests_FullMethodName: {Roles: adminsOnly},
+		generated.BookingService_EraseGuest_FullMethodName:          {Roles: adminsOnly},
+
+		generated.BookingService_CreateEmployee_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			in := req.(*generated.CreateEmployeeRequest)
+			if in.GetRole() == generated.EmployeeRole_EMPLOYEE_ROLE_ADMIN {
+				return nil, errAdminRoleForbidden
+			}
+			return []uint64{in.GetHotelId()}, nil
+		}},
+		generated.BookingService_GetEmployee_FullMethodName: {Roles: managers, Scope: byEmployee(
+			func(req any) uint64 { return req.(*generated.GetEmployeeRequest).GetEmployeeId() },
+		)},
+		generated.BookingService_ListEmployees_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.ListEmployeesRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_UpdateEmployee_FullMethodName: {Roles: managers, Scope: func(
+			ctx context.Context, d Directory, req any,
+		) ([]uint64, error) {
+			in := req.(*generated.UpdateEmployeeRequest)
+			if in.GetRole() == generated.EmployeeRole_EMPLOYEE_ROLE_ADMIN {
+				return nil, errAdminRoleForbidden
+			}
+			current, err := d.HotelIDByEmployeeID(ctx, in.GetEmployeeId())
+			if err != nil {
+				return nil, err
+			}
+			return []uint64{current, in.GetHotelId()}, nil
+		}},
+		generated.BookingService_DeleteEmployee_FullMethodName: {Roles: managers, Scope: byEmployee(
+			func(req any) uint64 { return req.(*generated.DeleteEmployeeRequest).GetEmployeeId() },
+		)},
+
+		generated.BookingService_CreateRatePlan_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.CreateRatePlanRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_ListRatePlans_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.ListRatePlansRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_UpdateRatePlan_FullMethodName: {Roles: managers, Scope: byRatePlan(
+			func(req any) uint64 { return req.(*generated.UpdateRatePlanRequest).GetRatePlanId() },
+		)},
+		generated.BookingService_DeleteRatePlan_FullMethodName: {Roles: managers, Scope: byRatePlan(
+			func(req any) uint64 { return req.(*generated.DeleteRatePlanRequest).GetRatePlanId() },
+		)},
+		generated.BookingService_SetRates_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.SetRatesRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_GetRateCalendar_FullMethodName: {Roles: frontDesk, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.GetRateCalendarRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_SetPricingRule_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.SetPricingRuleRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_ListPricingRules_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.ListPricingRulesRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_DeletePricingRule_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.DeletePricingRuleRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_SetDepositRule_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.SetDepositRuleRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_GetDepositRule_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.GetDepositRuleRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_DeleteDepositRule_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.DeleteDepositRuleRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_QuoteStay_FullMethodName: {Roles: frontDesk, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.QuoteStayRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_CreateTaxRule_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.CreateTaxRuleRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_ListTaxRules_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.ListTaxRulesRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_DeleteTaxRule_FullMethodName: {Roles: managers, Scope: byTaxRule(
+			func(req any) uint64 { return req.(*generated.DeleteTaxRuleRequest).GetTaxRuleId() },
+		)},
+		generated.BookingService_PayBooking_FullMethodName: {Roles: frontDesk, Scope: byBooking(
+			func(req any) uint64 { return req.(*generated.PayBookingRequest).GetBookingId() },
+		)},
+		generated.BookingService_GetFolio_FullMethodName: {Roles: frontDesk, Scope: byBooking(
+			func(req any) uint64 { return req.(*generated.GetFolioRequest).GetBookingId() },
+		)},
+		generated.BookingService_PostFolioCharge_FullMethodName: {Roles: frontDesk, Scope: byBooking(
+			func(req any) uint64 { return req.(*generated.PostFolioChargeRequest).GetBookingId() },
+		)},
+		generated.BookingService_VoidFolioCharge_FullMethodName: {Roles: frontDesk, Scope: byBooking(
+			func(req any) uint64 { return req.(*generated.VoidFolioChargeRequest).GetBookingId() },
+		)},
+		generated.BookingService_SettleFolio_FullMethodName: {Roles: frontDesk, Scope: byBooking(
+			func(req any) uint64 { return req.(*generated.SettleFolioRequest).GetBookingId() },
+		)},
+		generated.BookingService_CloseFolio_FullMethodName: {Roles: frontDesk, Scope: byBooking(
+			func(req any) uint64 { return req.(*generated.CloseFolioRequest).GetBookingId() },
+		)},
+		generated.BookingService_IssueInvoice_FullMethodName: {Roles: frontDesk, Scope: byBooking(
+			func(req any) uint64 { return req.(*generated.IssueInvoiceRequest).GetBookingId() },
+		)},
+		generated.BookingService_GetInvoice_FullMethodName: {Roles: frontDesk, Scope: byInvoice(
+			func(req any) uint64 { return req.(*generated.GetInvoiceRequest).GetInvoiceId() },
+		)},
+		generated.BookingService_DownloadInvoice_FullMethodName: {Roles: frontDesk, Scope: byInvoice(
+			func(req any) uint64 { return req.(*generated.DownloadInvoiceRequest).GetInvoiceId() },
+		)},
+		// промокоды всех отелей (без hotel_id) создает и меняет только администратор
+		generated.BookingService_CreatePromotion_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.CreatePromotionRequest).GetTerms().GetHotelId()}, nil
+		}},
+		generated.BookingService_ListPromotions_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.ListPromotionsRequest).GetHotelId()}, nil
+		}},
+		generated.BookingService_UpdatePromotion_FullMethodName: {Roles: managers, Scope: byPromotion(
+			func(req any) uint64 { return req.(*generated.UpdatePromotionRequest).GetPromotionId() },
+		)},
+		generated.BookingService_DeletePromotion_FullMethodName: {Roles: managers, Scope: byPromotion(
+			func(req any) uint64 { return req.(*generated.DeletePromotionRequest).GetPromotionId() },
+		)},
+		generated.BookingService_SetExchangeRates_FullMethodName:  {Roles: adminsOnly},
+		generated.BookingService_ListExchangeRates_FullMethodName: {Roles: frontDesk},
+
+		// без hotel_id отчет по всем отелям видит только администратор
+		generated.BookingService_GetReconciliationReport_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.GetReconciliationReportRequest).GetHotelId()}, nil
+		}},
+
+		// без hotel_id события всех отелей видит только администратор
+		generated.BookingService_ListAuditEvents_FullMethodName: {Roles: managers, Scope: func(
+			_ context.Context, _ Directory, req any,
+		) ([]uint64, error) {
+			return []uint64{req.(*generated.ListAuditEventsRequest).GetHotelId()}, nil
+		}},
+	}
+}
+
+func byRoom(id func(req any) uint64) func(context.Context, Directory, any) ([]uint64, error) {
+	return func(ctx context.Context, d Directory, req any) ([]uint64, error) {
+		hotelID, err := d.HotelIDByRoomID(ctx, id(req))
+		return []uint64{hotelID}, err
+	}
+}
+
+func byBooking(id func(req any) uint64) func(context.Context, Directory, any) ([]uint64, error) {
+	return func(ctx context.Context, d Directory, req any) ([]uint64, error) {
+		hotelID, err := d.HotelIDByBookingID(ctx, id(req))
+		return []uint64{hotelID}, err
+	}
+}
+
+func byEmployee(id func(req any) uint64) func(context.Context, Directory, any) ([]uint64, error) {
+	return func(ctx context.Context, d Directory, req any) ([]uint64, error) {
+		hotelID, err := d.HotelIDByEmployeeID(ctx, id(req))
+		return []uint64{hotelID}, err
+	}
+}
+
+func byRatePlan(id func(req any) uint64) func(context.Context, Directory, any) ([]uint64, error) {
+	return func(ctx context.Context, d Directory, req any) ([]uint64, error) {
+		hotelID, err := d.HotelIDByRatePlanID(ctx, id(req))
+		return []uint64{hotelID}, err
+	}
+}
+
+func byTaxRule(id func(req any) uint64) func(context.Context, Directory, any) ([]uint64, error) {
+	return func(ctx context.Context, d Directory, req any) ([]uint64, error) {
+		hotelID, err := d.HotelIDByTaxRuleID(ctx, id(req))
+		return []uint64{hotelID}, err
+	}
+}
+
+func byPromotion(id func(req any) uint64) func(context.Context, Directory, any) ([]uint64, error) {
+	return func(ctx context.Context, d Directory, req any) ([]uint64, error) {
+		hotelID, err := d.HotelIDByPromotionID(ctx, id(req))
+		return []uint64{hotelID}, err
+	}
+}
+
+func byInvoice(id func(req any) uint64) func(context.Context, Directory, any) ([]uint64, error) {
+	return func(ctx context.Context, d Directory, req any) ([]uint64, error) {
+		hotelID, err := d.HotelIDByInvoiceID(ctx, id(req))
+		return []uint64{hotelID}, err
+	}
+}
@@ -0,0 +1,222 @@
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Files пути к PEM-файлам. CAFile необязателен для сервера без проверки
+// клиентских сертификатов, CertFile/KeyFile — для клиента без mTLS.
+type Files struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
+}
+
+// Reloader держит актуальные сертификат и CA, периодически перечитывая файлы
+// с диска. Проверяется время модификации, поэтому подходит и для подмены
+// симлинков, которой cert-manager/kubelet обновляют смонтированные секреты.
+type Reloader struct {
+	files    Files
+	interval time.Duration
+	logger   *slog.Logger
+
+	cert atomic.Pointer[tls.Certificate]
+	pool atomic.Pointer[x509.CertPool]
+
+	mu      sync.Mutex
+	modTime map[string]time.Time
+}
+
+func NewReloader(files Files, interval time.Duration, logger *slog.Logger) (*Reloader, error) {
+	if (files.CertFile == "") != (files.KeyFile == "") {
+		return nil, errors.New("[certs.NewReloader]: cert_file and key_file must be set together")
+	}
+	r := &Reloader{
+		files:    files,
+		interval: interval,
+		logger:   logger,
+		modTime:  make(map[string]time.Time),
+	}
+	if err := r.reload(); err != nil {
+		return nil, fmt.Errorf("[certs.NewReloader]: %w", err)
+	}
+	return r, nil
+}
+
+// Run перечитывает файлы каждые interval до отмены контекста. Ошибка чтения
+// (например, файл записан наполовину) не сбрасывает ранее загруженные данные.
+func (r *Reloader) Run(ctx context.Context) {
+	t := time.NewTicker(r.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if !r.changed() {
+				continue
+			}
+			if err := r.reload(); err != nil {
+				r.logger.Error("certificate reload failed, keeping previous", "cert_file", r.files.CertFile, "error", err)
+				continue
+			}
+			r.logger.Info("certificates reloaded", "cert_file", r.files.CertFile, "ca_file", r.files.CAFile)
+		}
+	}
+}
+
+func (r *Reloader) changed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, path := range r.paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if !info.ModTime().Equal(r.modTime[path]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reloader) reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	modTimes := make(map[string]time.Time)
+	for _, path := range r.paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		modTimes[path] = info.ModTime()
+	}
+
+	if r.files.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(r.files.CertFile, r.files.KeyFile)
+		if err != nil {
+			return fmt.Errorf("load key pair: %w", err)
+		}
+		r.cert.Store(&cert)
+	}
+	if r.files.CAFile != "" {
+		pem, err := os.ReadFile(r.files.CAFile)
+		if err != nil {
+			return fmt.Errorf("read ca: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in %s", r.files.CAFile)
+		}
+		r.pool.Store(pool)
+	}
+
+	r.modTime = modTimes
+	return nil
+}
+
+func (r *Reloader) paths() []string {
+	var paths []string
+	for _, p := range []string{r.files.CertFile, r.files.KeyFile, r.files.CAFile} {
+		if p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// ServerConfig конфиг для TLS-сервера. При requireClientCert клиент обязан
+// предъявить сертификат, подписанный CA из CAFile (mTLS). Сертификат и CA
+// берутся при каждом рукопожатии, остальные поля (ALPN и т.п.) дополняют
+// grpc/net/http сами.
+func (r *Reloader) ServerConfig(requireClientCert bool) *tls.Config {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return r.cert.Load(), nil
+		},
+	}
+	if requireClientCert {
+		// цепочку проверяем сами, т.к. ClientCAs нельзя подменить на лету
+		cfg.ClientAuth = tls.RequireAnyClientCert
+		cfg.VerifyPeerCertificate = r.verifyClient
+	}
+	return cfg
+}
+
+func (r *Reloader) verifyClient(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return errors.New("client presented no certificate")
+	}
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, raw := range rawCerts {
+		c, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("parse client certificate: %w", err)
+		}
+		certs = append(certs, c)
+	}
+	opts := x509.VerifyOptions{
+		Roots:         r.pool.Load(),
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, c := range certs[1:] {
+		opts.Intermediates.AddCert(c)
+	}
+	_, err := certs[0].Verify(opts)
+	return err
+}
+
+// ClientConfig конфиг для TLS-клиента. Серверный сертификат проверяется по
+// текущему CA из CAFile (или системному пулу, если CAFile не задан); клиентский
+// сертификат предъявляется, если задан CertFile.
+func (r *Reloader) ClientConfig(serverName string) *tls.Config {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+	}
+	if r.files.CertFile != "" {
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.cert.Load(), nil
+		}
+	}
+	if r.files.CAFile != "" {
+		// RootCAs нельзя подменить на лету, поэтому стандартную проверку
+		// отключаем и проверяем цепочку сами по актуальному пулу
+		cfg.InsecureSkipVerify = true
+		cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			return r.verifyServer(cs, serverName)
+		}
+	}
+	return cfg
+}
+
+func (r *Reloader) verifyServer(cs tls.ConnectionState, serverName string) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+	if serverName == "" {
+		serverName = cs.ServerName
+	}
+	opts := x509.VerifyOptions{
+		Roots:         r.pool.Load(),
+		DNSName:       serverName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, c := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(c)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
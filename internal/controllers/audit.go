@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+
+	"booking-service/internal/audit"
+	"booking-service/internal/entities"
+	"booking-service/internal/storage"
+)
+
+const (
+	defaultAuditPageSize = 50
+	maxAuditPageSize     = 500
+)
+
+// audit пишет событие в журнал в транзакции изменения: если изменение
+// откатится, записи в журнале тоже не будет
+func (c *Controller) audit(
+	ctx context.Context, tx *sql.Tx, entityType entities.AuditEntityType, entityID, hotelID uint64,
+	action entities.AuditAction, before, after any,
+) error {
+	event, err := audit.NewEvent(ctx, entityType, entityID, hotelID, action, before, after)
+	if err != nil {
+		return err
+	}
+	return c.ds.SaveAuditEvent(ctx, tx, event)
+}
+
+// ListAuditEvents возвращает страницу событий и курсор следующей страницы (0 — страница последняя)
+func (c *Controller) ListAuditEvents(ctx context.Context, filter entities.AuditFilter) ([]entities.AuditEvent, uint64, error) {
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.From.After(filter.To) {
+		return nil, 0, entities.ErrStartDateIsAfterEndDate
+	}
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultAuditPageSize
+	case filter.Limit > maxAuditPageSize:
+		filter.Limit = maxAuditPageSize
+	}
+
+	limit := filter.Limit
+	// лишняя запись показывает, что есть следующая страница
+	filter.Limit++
+
+	var events []entities.AuditEvent
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		events, errTx = c.ds.FindAuditEvents(ctx, tx, filter)
+		return errTx
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var next uint64
+	if len(events) > limit {
+		events = events[:limit]
+		next = events[limit-1].ID
+	}
+
+	return events, next, nil
+}
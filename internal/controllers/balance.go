@@ -0,0 +1,157 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/storage"
+)
+
+type balanceOutcome int
+
+const (
+	balanceNoneDue balanceOutcome = iota
+	balanceCharged
+	balanceRescheduled
+	balanceCancelled
+)
+
+// ChargeDueBalances списывает остатки бронирований, срок которых наступил, по
+// одному бронированию в транзакции. Отклоненное списание повторяется через
+// opts.RetryInterval; после opts.MaxAttempts отказов бронирование отменяется и
+// в outbox ставится уведомление. Если платежный сервис недоступен, проход
+// прерывается до следующего запуска. Реплики не мешают друг другу: занятые
+// бронирования пропускаются
+func (c *Controller) ChargeDueBalances(
+	ctx context.Context, opts entities.BalanceChargeOptions,
+) (charged, cancelled int, err error) {
+	var errs []error
+	for range opts.BatchSize {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+		outcome, chargeErr, err := c.chargeNextBalance(ctx, opts, time.Now().UTC())
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if chargeErr != nil {
+			errs = append(errs, chargeErr)
+		}
+		switch outcome {
+		case balanceNoneDue:
+			return charged, cancelled, errors.Join(errs...)
+		case balanceCharged:
+			charged++
+		case balanceCancelled:
+			cancelled++
+		}
+	}
+	return charged, cancelled, errors.Join(errs...)
+}
+
+// chargeNextBalance списывает остаток следующего бронирования. Ошибка
+// платежного сервиса, кроме отказа и недоступности, возвращается в chargeErr:
+// попытка не засчитывается, а бронирование откладывается, чтобы не задерживать остальные
+func (c *Controller) chargeNextBalance(
+	ctx context.Context, opts entities.BalanceChargeOptions, now time.Time,
+) (outcome balanceOutcome, chargeErr, err error) {
+	err = storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		outcome, chargeErr = balanceNoneDue, nil
+		bookingID, attempts, errTx := c.ds.LockNextDueBalance(ctx, tx)
+		if errTx != nil {
+			if errors.Is(errTx, entities.ErrNotFound) {
+				return nil
+			}
+			return errTx
+		}
+		booking, errTx := c.ds.FindBookingById(ctx, tx, bookingID)
+		if errTx != nil {
+			return errTx
+		}
+		hotelID, errTx := c.ds.FindHotelIDByBookingID(ctx, tx, bookingID)
+		if errTx != nil {
+			return errTx
+		}
+
+		before := booking
+		due := balanceDue(booking)
+		if due.Amount > 0 {
+			booking, errTx = c.chargeBooking(ctx, tx, booking, hotelID, due)
+		} else {
+			// стоимость снизилась до уже оплаченной суммы
+			booking.PaymentStatus = entities.BookingPaymentStatusPaid
+			booking.IsPaid = true
+		}
+		switch {
+		case errTx == nil:
+			if booking, errTx = c.ds.SaveBooking(ctx, tx, booking); errTx != nil {
+				return errTx
+			}
+			outcome = balanceCharged
+			return c.auditBooking(ctx, tx, before, booking)
+		case errors.Is(errTx, entities.ErrPaymentUnavailable):
+			return errTx
+		case errors.Is(errTx, entities.ErrPaymentDeclined):
+			attempts++
+		default:
+			chargeErr = fmt.Errorf("booking %d: %w", bookingID, errTx)
+		}
+
+		if attempts < opts.MaxAttempts {
+			outcome = balanceRescheduled
+			return c.ds.ScheduleBalanceRetry(ctx, tx, bookingID, attempts, now.Add(opts.RetryInterval))
+		}
+		outcome = balanceCancelled
+		return c.cancelUnpaidBooking(ctx, tx, before, hotelID, due, errTx)
+	})
+	return outcome, chargeErr, err
+}
+
+// cancelUnpaidBooking отменяет бронирование, остаток которого так и не удалось
+// списать, и ставит в outbox уведомление гостям
+func (c *Controller) cancelUnpaidBooking(
+	ctx context.Context, tx *sql.Tx, booking entities.Booking, hotelID uint64, due entities.Money,
+	reason error,
+) error {
+	before := booking
+	booking.Status = entities.BookingStatusCancelled
+	booking, err := c.ds.SaveBooking(ctx, tx, booking)
+	if err != nil {
+		return err
+	}
+	booking.Guests = before.Guests
+	if err = c.audit(ctx, tx, entities.AuditEntityBooking, booking.ID, hotelID,
+		entities.AuditActionUpdate, before, booking); err != nil {
+		return err
+	}
+
+	guestIDs := make([]uint64, 0, len(booking.Guests))
+	for _, g := range booking.Guests {
+		guestIDs = append(guestIDs, g.ID)
+	}
+	payload, err := json.Marshal(entities.BookingAutoCancelled{
+		BookingID: booking.ID,
+		HotelID:   hotelID,
+		GuestIDs:  guestIDs,
+		StartDate: booking.StartDate,
+		EndDate:   booking.EndDate,
+		AmountDue: due,
+		Reason:    reason.Error(),
+	})
+	if err != nil {
+		return fmt.Errorf("[controllers.cancelUnpaidBooking]: %w", err)
+	}
+	_, err = c.ds.SaveNotification(ctx, tx, entities.Notification{
+		Kind:      entities.NotificationBookingAutoCancelled,
+		BookingID: booking.ID,
+		Payload:   payload,
+	})
+	return err
+}
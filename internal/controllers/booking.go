@@ -1,67 +1,245 @@
 package controllers
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
+	"errors"
+	"slices"
+	"time"
 
 	"booking-service/internal/entities"
+	"booking-service/internal/pricing"
 	"booking-service/internal/storage"
 )
 
-func (c *Controller) CreateBooking(ctx context.Context, input entities.CreateBookingDTO) (entities.Booking, error) {
-	if input.StartDate.After(input.EndDate) {
-		return entities.Booking{}, entities.ErrStartDateIsAfterEndDate
+// CreateBooking создает бронирование и возвращает его вместе с гостями, профили
+// которых созданы этим запросом. Найденные сопоставлением профили вызывающему
+// не принадлежат: токен гостя на них не выдается
+func (c *Controller) CreateBooking(ctx context.Context, input entities.CreateBookingDTO) (
+	entities.Booking, []uint64, error,
+) {
+	if err := validateStay(input.StartDate, input.EndDate, time.Time{}); err != nil {
+		return entities.Booking{}, nil, err
 	}
 
 	var available bool
 	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
 		var errTx error
-		if available, errTx = c.ds.IsRoomAvailableForBooking(ctx, tx, input.RoomID, input.StartDate, input.EndDate); errTx != nil {
+		if available, errTx = c.ds.IsRoomAvailableForBooking(ctx, tx, input.RoomID, 0, input.StartDate, input.EndDate); errTx != nil {
 			return errTx
 		}
 
 		return nil
 	})
 	if err != nil {
-		return entities.Booking{}, err
+		return entities.Booking{}, nil, err
 	}
 	if !available {
-		return entities.Booking{}, entities.ErrRoomNotAvailable
+		return entities.Booking{}, nil, entities.ErrRoomNotAvailable
 	}
 
-	var guests []entities.Guest
-	for _, guest := range input.Guests {
-		guests = append(guests, entities.Guest{
-			Name: guest.Name,
-		})
+	guests := make([]entities.Guest, 0, len(input.Guests))
+	for _, dto := range input.Guests {
+		guest, err := newGuest(dto, time.Now().UTC())
+		if err != nil {
+			return entities.Booking{}, nil, err
+		}
+		guests = append(guests, guest)
 	}
 
-	var booking entities.Booking
+	var (
+		booking        entities.Booking
+		newGuestIDs    []uint64
+		depositCharged bool
+	)
 	err = storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
-		var errTx error
+		room, errTx := c.ds.FindRoomById(ctx, tx, int64(input.RoomID))
+		if errTx != nil {
+			return errTx
+		}
+
+		guestIDs := make([]uint64, 0, len(guests))
 		for i := range guests {
-			var guest entities.Guest
-			guest, errTx = c.ds.SaveGuestAndReturnIt(ctx, tx, guests[i])
+			var created bool
+			if guests[i], created, errTx = c.findOrCreateGuest(ctx, tx, guests[i]); errTx != nil {
+				return errTx
+			}
+			guestIDs = append(guestIDs, guests[i].ID)
+			if created {
+				newGuestIDs = append(newGuestIDs, guests[i].ID)
+			}
+		}
+
+		var (
+			price        entities.PriceSnapshot
+			cancellation entities.CancellationTerms
+		)
+		if input.Quote != nil {
+			// цена из предложения: гость платит ровно показанную сумму
+			if errTx = checkQuote(*input.Quote, room, input); errTx != nil {
+				return errTx
+			}
+			price, cancellation = input.Quote.Price, input.Quote.Cancellation
+		} else {
+			s := stay{
+				hotelID:   room.HotelID,
+				roomType:  room.Type,
+				start:     input.StartDate,
+				end:       input.EndDate,
+				guests:    len(input.Guests),
+				taxExempt: taxExemptGuests(input.Guests),
+			}
+			if input.PromoCode != "" {
+				if s.promotion, errTx = c.findPromotion(ctx, tx, input.PromoCode, s, time.Now()); errTx != nil {
+					return errTx
+				}
+			}
+			price, cancellation, errTx = c.priceStayWithTerms(ctx, tx, s)
 			if errTx != nil {
 				return errTx
 			}
-			guests[i] = guest
+		}
+		// использование засчитывается в той же транзакции: если лимит исчерпан
+		// параллельным бронированием, бронирование не создается
+		if price.Discount != nil {
+			if errTx = c.ds.RedeemPromotion(ctx, tx, price.Discount.PromotionID); errTx != nil {
+				return errTx
+			}
 		}
 
-		booking = entities.Booking{
-			RoomID:    input.RoomID,
-			StartDate: input.StartDate,
-			EndDate:   input.EndDate,
-			Comment:   input.Comment,
-			Status:    entities.BookingStatusConfirmed,
+		booking, errTx = c.ds.SaveBooking(ctx, tx, entities.Booking{
+			RoomID:       input.RoomID,
+			StartDate:    input.StartDate,
+			EndDate:      input.EndDate,
+			Comment:      input.Comment,
+			Status:       entities.BookingStatusConfirmed,
+			Price:        price,
+			Cancellation: cancellation,
+		})
+		if errTx != nil {
+			return errTx
 		}
 
-		errTx = c.ds.SaveBooking(ctx, tx, booking)
+		if errTx = c.ds.AttachGuestsToBooking(ctx, tx, booking.ID, guestIDs); errTx != nil {
+			return errTx
+		}
+		// депозит списывается последним: если списание не прошло, бронирование не создается,
+		// а если не зафиксировалось бронирование, списанный депозит возвращается
+		booking, depositCharged, errTx = c.takeDeposit(ctx, tx, booking, room.HotelID, time.Now().UTC())
 		if errTx != nil {
 			return errTx
 		}
+		if booking.AmountPaid.Amount > 0 || !booking.BalanceDueAt.IsZero() {
+			if booking, errTx = c.ds.SaveBooking(ctx, tx, booking); errTx != nil {
+				return errTx
+			}
+		}
+		booking.Guests = guests
 
-		return nil
+		return c.audit(ctx, tx, entities.AuditEntityBooking, booking.ID, room.HotelID,
+			entities.AuditActionCreate, nil, booking)
+	})
+	if err != nil {
+		if depositCharged {
+			err = c.refundDeposit(ctx, booking.ID, err)
+		}
+		return entities.Booking{}, nil, err
+	}
+
+	return booking, newGuestIDs, nil
+}
+
+// ModifyBooking переносит даты бронирования, если номер свободен на новые даты
+func (c *Controller) ModifyBooking(ctx context.Context, input entities.ModifyBookingDTO) (entities.Booking, error) {
+	var booking entities.Booking
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		// блокировка как в PayBooking: все колонки бронирования записываются
+		// обратно, параллельная оплата не должна потеряться
+		errTx := c.ds.LockBooking(ctx, tx, input.BookingID)
+		if errTx != nil {
+			return errTx
+		}
+		booking, errTx = c.ds.FindBookingById(ctx, tx, input.BookingID)
+		if errTx != nil {
+			return errTx
+		}
+		if booking.Status == entities.BookingStatusCancelled {
+			return entities.ErrBookingCancelled
+		}
+		if errTx = validateStay(input.StartDate, input.EndDate, booking.StartDate); errTx != nil {
+			return errTx
+		}
+
+		available, errTx := c.ds.IsRoomAvailableForBooking(
+			ctx, tx, booking.RoomID, booking.ID, input.StartDate, input.EndDate,
+		)
+		if errTx != nil {
+			return errTx
+		}
+		if !available {
+			return entities.ErrRoomNotAvailable
+		}
+
+		room, errTx := c.ds.FindRoomById(ctx, tx, int64(booking.RoomID))
+		if errTx != nil {
+			return errTx
+		}
+		// новые даты — новая цена по тарифам на момент изменения
+		// состав гостей не меняется; у старых бронирований он не записан в цене
+		s := stay{
+			hotelID:   room.HotelID,
+			roomType:  room.Type,
+			start:     input.StartDate,
+			end:       input.EndDate,
+			guests:    booking.Price.Guests,
+			taxExempt: booking.Price.TaxExemptGuests,
+		}
+		if s.guests == 0 {
+			s.guests = len(booking.Guests)
+		}
+		// скидка сохраняется, если промокод действует и на новые даты; окно
+		// бронирования проверяется на момент создания бронирования, повторно
+		// использование не засчитывается
+		if d := booking.Price.Discount; d != nil {
+			promotion, errTx := c.ds.FindPromotionByID(ctx, tx, d.PromotionID)
+			if errTx != nil {
+				if errors.Is(errTx, entities.ErrNotFound) {
+					return entities.ErrPromoCodeNotFound
+				}
+				return errTx
+			}
+			errTx = pricing.CheckPromotion(promotion, s.hotelID, s.roomType, s.start, s.end, booking.CreatedAt)
+			if errTx != nil {
+				return errTx
+			}
+			s.promotion = &promotion
+		}
+		price, cancellation, errTx := c.priceStayWithTerms(ctx, tx, s)
+		if errTx != nil {
+			return errTx
+		}
+
+		before := booking
+		// остаток списывается за то же число дней до нового заезда
+		if !booking.BalanceDueAt.IsZero() {
+			booking.BalanceDueAt = booking.BalanceDueAt.Add(
+				pricing.Date(input.StartDate).Sub(pricing.Date(booking.StartDate)))
+		}
+		booking.StartDate = input.StartDate
+		booking.EndDate = input.EndDate
+		booking.Price = price
+		booking.Cancellation = cancellation
+		if booking, errTx = c.repriceBooking(ctx, tx, booking, room.HotelID); errTx != nil {
+			return errTx
+		}
+		booking, errTx = c.ds.SaveBooking(ctx, tx, booking)
+		if errTx != nil {
+			return errTx
+		}
+		booking.Guests = before.Guests
+
+		return c.auditBooking(ctx, tx, before, booking)
 	})
 	if err != nil {
 		return entities.Booking{}, err
@@ -70,24 +248,125 @@ func (c *Controller) CreateBooking(ctx context.Context, input entities.CreateBoo
 	return booking, nil
 }
 
+// repriceBooking обновляет состояние оплаты бронирования под новую цену.
+// Частичного возврата платежный сервис не поддерживает, поэтому цена ниже уже
+// оплаченной суммы не принимается. Недостающая сумма списывается как остаток
+// по правилу предоплаты отеля, а без правила гость доплачивает через PayBooking
+func (c *Controller) repriceBooking(
+	ctx context.Context, tx *sql.Tx, booking entities.Booking, hotelID uint64,
+) (entities.Booking, error) {
+	if booking.AmountPaid.Amount == 0 {
+		return booking, nil
+	}
+	if booking.AmountPaid.Amount > booking.Price.Total.Amount {
+		return booking, entities.ErrPriceBelowAmountPaid
+	}
+	if booking.AmountPaid.Amount == booking.Price.Total.Amount {
+		booking.PaymentStatus = entities.BookingPaymentStatusPaid
+		booking.IsPaid = true
+		return booking, nil
+	}
+
+	booking.PaymentStatus = entities.BookingPaymentStatusPartiallyPaid
+	booking.IsPaid = false
+	if !booking.BalanceDueAt.IsZero() {
+		return booking, nil
+	}
+	rule, err := c.ds.FindDepositRule(ctx, tx, hotelID)
+	switch {
+	case errors.Is(err, entities.ErrNotFound):
+		return booking, nil
+	case err != nil:
+		return booking, err
+	}
+	booking.BalanceDueAt = pricing.BalanceDueAt(rule, booking.StartDate)
+	return booking, nil
+}
+
 func (c *Controller) CancelBooking(ctx context.Context, bookingID uint64) error {
 	var booking entities.Booking
 	if err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
-		var errTx error
+		errTx := c.ds.LockBooking(ctx, tx, bookingID)
+		if errTx != nil {
+			return errTx
+		}
 		booking, errTx = c.ds.FindBookingById(ctx, tx, bookingID)
 		if errTx != nil {
 			return errTx
 		}
 
+		before := booking
 		booking.Status = entities.BookingStatusCancelled
-		errTx = c.ds.SaveBooking(ctx, tx, booking)
+		booking, errTx = c.ds.SaveBooking(ctx, tx, booking)
 		if errTx != nil {
 			return errTx
 		}
-		return nil
+		return c.auditBooking(ctx, tx, before, booking)
 	}); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// ListGuestBookings возвращает бронирование bookingID и бронирования, к которым
+// привязан хотя бы один из гостей
+func (c *Controller) ListGuestBookings(ctx context.Context, bookingID uint64, guestIDs []uint64) ([]entities.Booking, error) {
+	var bookings []entities.Booking
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		if len(guestIDs) > 0 {
+			if bookings, errTx = c.ds.FindBookingsByGuestIDs(ctx, tx, guestIDs); errTx != nil {
+				return errTx
+			}
+		}
+		if bookingID == 0 || slices.ContainsFunc(bookings, func(b entities.Booking) bool { return b.ID == bookingID }) {
+			return nil
+		}
+		booking, errTx := c.ds.FindBookingById(ctx, tx, bookingID)
+		if errTx != nil {
+			return errTx
+		}
+		// порядок как у FindBookingsByGuestIDs: сначала поздние заезды
+		i, _ := slices.BinarySearchFunc(bookings, booking, func(b, t entities.Booking) int {
+			if d := t.StartDate.Compare(b.StartDate); d != 0 {
+				return d
+			}
+			return cmp.Compare(t.ID, b.ID)
+		})
+		bookings = slices.Insert(bookings, i, booking)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bookings, nil
+}
+
+// IsGuestInBooking используется проверкой прав гостя на бронирование
+func (c *Controller) IsGuestInBooking(ctx context.Context, bookingID uint64, guestIDs []uint64) (bool, error) {
+	var ok bool
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		ok, errTx = c.ds.IsGuestInBooking(ctx, tx, bookingID, guestIDs)
+		return errTx
+	})
+	return ok, err
+}
+
+// IsGuestInHotel используется проверкой прав сотрудника на гостя
+func (c *Controller) IsGuestInHotel(ctx context.Context, guestID, hotelID uint64) (bool, error) {
+	var ok bool
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		ok, errTx = c.ds.IsGuestInHotel(ctx, tx, guestID, hotelID)
+		return errTx
+	})
+	return ok, err
+}
+
+func (c *Controller) auditBooking(ctx context.Context, tx *sql.Tx, before, after entities.Booking) error {
+	hotelID, err := c.ds.FindHotelIDByBookingID(ctx, tx, after.ID)
+	if err != nil {
+		return err
+	}
+	return c.audit(ctx, tx, entities.AuditEntityBooking, after.ID, hotelID, entities.AuditActionUpdate, before, after)
+}
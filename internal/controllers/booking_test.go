@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/pricing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// paidBooking бронирование на nights ночей по 100 RUB, оплаченное на paid копеек
+func paidBooking(store *fakeDS, nights int, paid int64) entities.Booking {
+	total := entities.Money{Amount: int64(nights) * 10000, Currency: "RUB"}
+	b := entities.Booking{
+		ID:            1,
+		RoomID:        store.room.ID,
+		StartDate:     day(30),
+		EndDate:       day(30 + nights),
+		Status:        entities.BookingStatusConfirmed,
+		PaymentStatus: entities.BookingPaymentStatusPartiallyPaid,
+		AmountPaid:    entities.Money{Amount: paid, Currency: "RUB"},
+		Price:         entities.PriceSnapshot{Total: total, Guests: 1},
+	}
+	if paid >= total.Amount {
+		b.PaymentStatus, b.IsPaid = entities.BookingPaymentStatusPaid, true
+	}
+	store.bookings[b.ID] = b
+	return b
+}
+
+func TestModifyPaidBookingToMoreExpensiveDates(t *testing.T) {
+	store, payments := newFakeDS(), &fakePayments{}
+	c := newTestController(t, store, payments)
+	b := paidBooking(store, 2, 20000)
+
+	modified, err := c.ModifyBooking(context.Background(), entities.ModifyBookingDTO{
+		BookingID: b.ID,
+		StartDate: b.StartDate,
+		EndDate:   day(33),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(30000), modified.Price.Total.Amount)
+	assert.False(t, modified.IsPaid)
+	assert.Equal(t, entities.BookingPaymentStatusPartiallyPaid, modified.PaymentStatus)
+	assert.Equal(t, int64(10000), balanceDue(modified).Amount)
+	// без правила предоплаты остаток не планируется: гость доплачивает сам
+	assert.True(t, modified.BalanceDueAt.IsZero())
+
+	paid, err := c.PayBooking(context.Background(), b.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []entities.Money{{Amount: 10000, Currency: "RUB"}}, payments.charges)
+	assert.True(t, paid.IsPaid)
+	assert.Equal(t, entities.BookingPaymentStatusPaid, paid.PaymentStatus)
+}
+
+func TestModifyPaidBookingSchedulesBalance(t *testing.T) {
+	store := newFakeDS()
+	store.depositRule = &entities.DepositRule{HotelID: 1, Kind: entities.DepositKindPercent, BalanceDaysBefore: 7}
+	c := newTestController(t, store, &fakePayments{})
+	b := paidBooking(store, 2, 20000)
+
+	modified, err := c.ModifyBooking(context.Background(), entities.ModifyBookingDTO{
+		BookingID: b.ID,
+		StartDate: day(40),
+		EndDate:   day(43),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, entities.BookingPaymentStatusPartiallyPaid, modified.PaymentStatus)
+	assert.Equal(t, pricing.BalanceDueAt(*store.depositRule, day(40)), modified.BalanceDueAt)
+}
+
+func TestModifyPaidBookingToCheaperDates(t *testing.T) {
+	store := newFakeDS()
+	c := newTestController(t, store, &fakePayments{})
+	b := paidBooking(store, 3, 30000)
+
+	_, err := c.ModifyBooking(context.Background(), entities.ModifyBookingDTO{
+		BookingID: b.ID,
+		StartDate: b.StartDate,
+		EndDate:   day(32),
+	})
+	require.ErrorIs(t, err, entities.ErrPriceBelowAmountPaid)
+	assert.Equal(t, b, store.bookings[b.ID])
+}
+
+func TestModifyPartiallyPaidBookingToCheaperDates(t *testing.T) {
+	store := newFakeDS()
+	c := newTestController(t, store, &fakePayments{})
+	b := paidBooking(store, 3, 10000)
+
+	// новая цена покрыта уже оплаченным депозитом
+	modified, err := c.ModifyBooking(context.Background(), entities.ModifyBookingDTO{
+		BookingID: b.ID,
+		StartDate: b.StartDate,
+		EndDate:   day(31),
+	})
+	require.NoError(t, err)
+	assert.True(t, modified.IsPaid)
+	assert.Equal(t, entities.BookingPaymentStatusPaid, modified.PaymentStatus)
+
+	// цена ниже депозита не принимается
+	b = paidBooking(store, 3, 20000)
+	_, err = c.ModifyBooking(context.Background(), entities.ModifyBookingDTO{
+		BookingID: b.ID,
+		StartDate: b.StartDate,
+		EndDate:   day(31),
+	})
+	require.ErrorIs(t, err, entities.ErrPriceBelowAmountPaid)
+}
+
+func TestModifyAndCancelLockBookingBeforeRead(t *testing.T) {
+	store := newFakeDS()
+	c := newTestController(t, store, &fakePayments{})
+	b := paidBooking(store, 2, 20000)
+
+	_, err := c.ModifyBooking(context.Background(), entities.ModifyBookingDTO{
+		BookingID: b.ID,
+		StartDate: b.StartDate,
+		EndDate:   b.EndDate,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"LockBooking", "FindBookingById", "SaveBooking"}, store.calls)
+
+	store.calls = nil
+	require.NoError(t, c.CancelBooking(context.Background(), b.ID))
+	assert.Equal(t, []string{"LockBooking", "FindBookingById", "SaveBooking"}, store.calls)
+}
+
+func TestCreateBookingValidatesStay(t *testing.T) {
+	c := newTestController(t, newFakeDS(), &fakePayments{})
+	guests := []entities.GuestDTO{{Name: "Guest"}}
+
+	for _, tc := range []struct {
+		name       string
+		start, end int
+		want       error
+	}{
+		{"start in past", -1, 2, entities.ErrStartDateInPast},
+		{"start after end", 3, 2, entities.ErrStartDateIsAfterEndDate},
+		{"too long", 1, 2 + maxRateRangeNights, entities.ErrRateRangeTooLong},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := c.CreateBooking(context.Background(), entities.CreateBookingDTO{
+				RoomID:    1,
+				StartDate: day(tc.start),
+				EndDate:   day(tc.end),
+				Guests:    guests,
+			})
+			require.ErrorIs(t, err, tc.want)
+		})
+	}
+}
+
+func TestModifyBookingValidatesStay(t *testing.T) {
+	store := newFakeDS()
+	c := newTestController(t, store, &fakePayments{})
+	started := entities.Booking{
+		ID:        1,
+		RoomID:    store.room.ID,
+		StartDate: day(-2),
+		EndDate:   day(1),
+		Status:    entities.BookingStatusConfirmed,
+		Price:     entities.PriceSnapshot{Total: entities.Money{Amount: 30000, Currency: "RUB"}, Guests: 1},
+	}
+	store.bookings[started.ID] = started
+
+	// начавшееся проживание продлевается без переноса заезда
+	modified, err := c.ModifyBooking(context.Background(), entities.ModifyBookingDTO{
+		BookingID: started.ID,
+		StartDate: started.StartDate,
+		EndDate:   day(3),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(50000), modified.Price.Total.Amount)
+
+	_, err = c.ModifyBooking(context.Background(), entities.ModifyBookingDTO{
+		BookingID: started.ID,
+		StartDate: day(-1),
+		EndDate:   day(3),
+	})
+	require.ErrorIs(t, err, entities.ErrStartDateInPast)
+
+	_, err = c.ModifyBooking(context.Background(), entities.ModifyBookingDTO{
+		BookingID: started.ID,
+		StartDate: day(1),
+		EndDate:   day(2 + maxRateRangeNights),
+	})
+	require.ErrorIs(t, err, entities.ErrRateRangeTooLong)
+}
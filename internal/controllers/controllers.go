@@ -14,33 +14,171 @@ type (
 	ds interface {
 		FindRoomById(ctx context.Context, tx *sql.Tx, roomId int64) (entities.Room, error)
 		SaveRoom(ctx context.Context, tx *sql.Tx, room *entities.Room) error
-		SaveAllRooms(ctx context.Context, tx *sql.Tx, rooms []entities.Room) error
-		SaveGuestAndReturnIt(ctx context.Context, tx *sql.Tx, input entities.Guest) (entities.Guest, error)
+		SaveGuest(ctx context.Context, tx *sql.Tx, guest entities.Guest) (entities.Guest, error)
+		FindGuestByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.Guest, error)
+		UpdateGuest(ctx context.Context, tx *sql.Tx, guest entities.Guest) (entities.Guest, error)
+		FindGuests(ctx context.Context, tx *sql.Tx, filter entities.GuestFilter) ([]entities.Guest, error)
+		LockGuests(ctx context.Context, tx *sql.Tx, ids []uint64) error
+		MoveGuestBookings(ctx context.Context, tx *sql.Tx, fromID, toID uint64) (int, error)
+		MoveGuestReviews(ctx context.Context, tx *sql.Tx, fromID, toID uint64) (int, error)
+		DeleteGuest(ctx context.Context, tx *sql.Tx, id uint64) error
+		SaveGuestMerge(ctx context.Context, tx *sql.Tx, sourceID, targetID uint64) error
+		FindMergedGuestIDs(ctx context.Context, tx *sql.Tx, guestID uint64) ([]uint64, error)
+		FindDuplicateGuests(ctx context.Context, tx *sql.Tx, limit int) ([]entities.DuplicateGuests, error)
+		EraseGuest(ctx context.Context, tx *sql.Tx, id uint64) (entities.Guest, error)
+		LockNextInactiveGuest(ctx context.Context, tx *sql.Tx, inactiveAfter time.Duration) (uint64, error)
+		HasActiveGuestBookings(ctx context.Context, tx *sql.Tx, guestID uint64) (bool, error)
+		FindReviewsByGuestID(ctx context.Context, tx *sql.Tx, guestID uint64) ([]entities.Review, error)
+		EraseGuestReviews(ctx context.Context, tx *sql.Tx, guestID uint64) error
+		RedactAuditEvents(ctx context.Context, tx *sql.Tx, entityType entities.AuditEntityType, entityIDs []uint64) error
 		SaveReview(ctx context.Context, tx *sql.Tx, review entities.Review) (entities.Review, error)
-		SaveBooking(ctx context.Context, tx *sql.Tx, booking entities.Booking) error
+		SaveBooking(ctx context.Context, tx *sql.Tx, booking entities.Booking) (entities.Booking, error)
+		AttachGuestsToBooking(ctx context.Context, tx *sql.Tx, bookingID uint64, guestIDs []uint64) error
+		FindBookingsByGuestIDs(ctx context.Context, tx *sql.Tx, guestIDs []uint64) ([]entities.Booking, error)
+		IsGuestInBooking(ctx context.Context, tx *sql.Tx, bookingID uint64, guestIDs []uint64) (bool, error)
+		IsGuestInHotel(ctx context.Context, tx *sql.Tx, guestID, hotelID uint64) (bool, error)
 		FindBookingById(ctx context.Context, tx *sql.Tx, bookingID uint64) (entities.Booking, error)
 		FindBookingByDate(ctx context.Context, tx *sql.Tx, startDate time.Time, endDate time.Time) ([]entities.Booking, error)
 		DeleteBooking(ctx context.Context, tx *sql.Tx, bookingID uint64) error
 		FindBookingByRoomIDAndDate(
 			ctx context.Context, tx *sql.Tx, roomID uint64, startDate time.Time, endDate time.Time,
 		) ([]entities.Booking, error)
-		IsRoomAvailableForBooking(ctx context.Context, tx *sql.Tx, roomID uint64, startDate, endDate time.Time) (bool, error)
+		IsRoomAvailableForBooking(
+			ctx context.Context, tx *sql.Tx, roomID, excludeBookingID uint64, startDate, endDate time.Time,
+		) (bool, error)
 		SaveHotel(ctx context.Context, tx *sql.Tx, hotel entities.Hotel) (entities.Hotel, error)
 		FindHotelByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.Hotel, error)
+		FindHotelIDByBookingID(ctx context.Context, tx *sql.Tx, bookingID uint64) (uint64, error)
+		UpdateRoomStatus(ctx context.Context, tx *sql.Tx, roomID uint64, status entities.RoomStatus) (entities.Room, error)
+		SaveEmployee(ctx context.Context, tx *sql.Tx, employee entities.Employee) (entities.Employee, error)
+		UpdateEmployee(ctx context.Context, tx *sql.Tx, employee entities.Employee) (entities.Employee, error)
+		FindEmployeeByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.Employee, error)
+		FindEmployeeBySubject(ctx context.Context, tx *sql.Tx, subject string) (entities.Employee, error)
+		FindEmployeesByHotelID(ctx context.Context, tx *sql.Tx, hotelID uint64) ([]entities.Employee, error)
+		DeleteEmployee(ctx context.Context, tx *sql.Tx, id uint64) error
+		SaveRatePlan(ctx context.Context, tx *sql.Tx, plan entities.RatePlan) (entities.RatePlan, error)
+		UpdateRatePlan(ctx context.Context, tx *sql.Tx, plan entities.RatePlan) (entities.RatePlan, error)
+		FindRatePlanByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.RatePlan, error)
+		FindRatePlansByHotelID(ctx context.Context, tx *sql.Tx, hotelID uint64) ([]entities.RatePlan, error)
+		FindRatePlansForStay(
+			ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string, start, end time.Time,
+		) ([]entities.RatePlan, error)
+		DeleteRatePlan(ctx context.Context, tx *sql.Tx, id uint64) error
+		SaveRateOverrides(
+			ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string, dates []time.Time, price entities.Money,
+		) error
+		DeleteRateOverrides(ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string, dates []time.Time) error
+		FindRateOverrides(
+			ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string, start, end time.Time,
+		) ([]entities.RateOverride, error)
+		HasAvailableRoom(
+			ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string, startDate, endDate time.Time,
+		) (bool, error)
+		SavePricingRule(ctx context.Context, tx *sql.Tx, rule entities.PricingRule) (entities.PricingRule, error)
+		FindPricingRule(ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string) (entities.PricingRule, error)
+		FindPricingRulesByHotelID(ctx context.Context, tx *sql.Tx, hotelID uint64) ([]entities.PricingRule, error)
+		DeletePricingRule(ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string) error
+		FindOccupancy(
+			ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string, start, end time.Time,
+		) (entities.Occupancy, error)
+		SaveTaxRule(ctx context.Context, tx *sql.Tx, rule entities.TaxRule) (entities.TaxRule, error)
+		FindTaxRuleByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.TaxRule, error)
+		FindTaxRulesByHotelID(ctx context.Context, tx *sql.Tx, hotelID uint64) ([]entities.TaxRule, error)
+		FindTaxRulesForStay(ctx context.Context, tx *sql.Tx, hotelID uint64, start, end time.Time) ([]entities.TaxRule, error)
+		DeleteTaxRule(ctx context.Context, tx *sql.Tx, id uint64) error
+		LockBooking(ctx context.Context, tx *sql.Tx, bookingID uint64) error
+		SavePromotion(ctx context.Context, tx *sql.Tx, p entities.Promotion) (entities.Promotion, error)
+		UpdatePromotion(ctx context.Context, tx *sql.Tx, p entities.Promotion) (entities.Promotion, error)
+		FindPromotionByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.Promotion, error)
+		FindPromotionByCode(ctx context.Context, tx *sql.Tx, code string) (entities.Promotion, error)
+		FindPromotions(ctx context.Context, tx *sql.Tx, hotelID uint64) ([]entities.Promotion, error)
+		RedeemPromotion(ctx context.Context, tx *sql.Tx, id uint64) error
+		DeletePromotion(ctx context.Context, tx *sql.Tx, id uint64) error
+		SaveExchangeRates(ctx context.Context, tx *sql.Tx, rates []entities.ExchangeRate) error
+		DeleteExchangeRates(ctx context.Context, tx *sql.Tx) error
+		FindExchangeRates(ctx context.Context, tx *sql.Tx) ([]entities.ExchangeRate, error)
+		FindExchangeRatesForPair(ctx context.Context, tx *sql.Tx, a, b string) ([]entities.ExchangeRate, error)
+		FindOrCreateFolio(ctx context.Context, tx *sql.Tx, bookingID uint64, currency string) (entities.Folio, error)
+		FindFolioByBookingID(ctx context.Context, tx *sql.Tx, bookingID uint64) (entities.Folio, error)
+		UpdateFolio(ctx context.Context, tx *sql.Tx, folio entities.Folio) (entities.Folio, error)
+		SaveFolioCharge(ctx context.Context, tx *sql.Tx, ch entities.FolioCharge) (entities.FolioCharge, error)
+		FindFolioChargeByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.FolioCharge, error)
+		FindFolioCharges(ctx context.Context, tx *sql.Tx, folioID uint64) ([]entities.FolioCharge, error)
+		VoidFolioCharge(ctx context.Context, tx *sql.Tx, id uint64, reason string) (entities.FolioCharge, error)
+		SavePayment(ctx context.Context, tx *sql.Tx, p entities.Payment) (entities.Payment, error)
+		FindPaymentsByBookingID(ctx context.Context, tx *sql.Tx, bookingID uint64) ([]entities.Payment, error)
+		NextInvoiceNumber(ctx context.Context, tx *sql.Tx, hotelID uint64, fiscalYear int) (int64, error)
+		SaveInvoice(ctx context.Context, tx *sql.Tx, inv entities.Invoice, pdf []byte) (entities.Invoice, error)
+		FindInvoiceByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.Invoice, error)
+		FindInvoiceFile(ctx context.Context, tx *sql.Tx, id uint64, format entities.InvoiceFormat) (string, []byte, error)
+		FindBookingsToReconcile(ctx context.Context, tx *sql.Tx, opts entities.ReconciliationOptions) ([]uint64, error)
+		MarkBookingReconciled(ctx context.Context, tx *sql.Tx, bookingID uint64) error
+		SyncReconciliationIssues(
+			ctx context.Context, tx *sql.Tx, bookingID, hotelID uint64, issues []entities.ReconciliationIssue,
+		) error
+		FindReconciliationIssues(
+			ctx context.Context, tx *sql.Tx, filter entities.ReconciliationFilter,
+		) ([]entities.ReconciliationIssue, error)
+		AcquireJobLease(ctx context.Context, tx *sql.Tx, name, holder string, ttl time.Duration) (bool, error)
+		SaveDepositRule(ctx context.Context, tx *sql.Tx, rule entities.DepositRule) (entities.DepositRule, error)
+		FindDepositRule(ctx context.Context, tx *sql.Tx, hotelID uint64) (entities.DepositRule, error)
+		DeleteDepositRule(ctx context.Context, tx *sql.Tx, hotelID uint64) error
+		LockNextDueBalance(ctx context.Context, tx *sql.Tx) (uint64, int, error)
+		ScheduleBalanceRetry(ctx context.Context, tx *sql.Tx, bookingID uint64, attempts int, next time.Time) error
+		SaveNotification(ctx context.Context, tx *sql.Tx, n entities.Notification) (entities.Notification, error)
+		LockPendingNotifications(ctx context.Context, tx *sql.Tx, limit int) ([]entities.Notification, error)
+		MarkNotificationSent(ctx context.Context, tx *sql.Tx, id uint64) error
+		MarkNotificationFailed(ctx context.Context, tx *sql.Tx, id uint64, reason string) error
+		SaveAuditEvent(ctx context.Context, tx *sql.Tx, event entities.AuditEvent) error
+		FindAuditEvents(ctx context.Context, tx *sql.Tx, filter entities.AuditFilter) ([]entities.AuditEvent, error)
+	}
+
+	// payments платежный сервис
+	payments interface {
+		Charge(ctx context.Context, bookingID uint64, amount entities.Money) error
+		Cancel(ctx context.Context, bookingID uint64) error
+		Payments(ctx context.Context, bookingID uint64, currency string) ([]entities.Payment, error)
+	}
+
+	// invoices печать счетов
+	invoices interface {
+		PDF(inv entities.Invoice) ([]byte, error)
+	}
+
+	// notifier сервис уведомлений
+	notifier interface {
+		Publish(ctx context.Context, n entities.Notification) error
 	}
 
 	Controller struct {
-		sql *sqlx.DB
-		ds  ds
+		sql      *sqlx.DB
+		ds       ds
+		payments payments
+		invoices invoices
+		notifier notifier
+		matching entities.GuestMatching
+		// baseCurrency валюта отеля по умолчанию
+		baseCurrency string
 	}
 )
 
+// New создает контроллер; notifier может быть nil, тогда уведомления копятся в outbox
 func New(
 	db *sqlx.DB,
 	ds ds,
+	payments payments,
+	invoices invoices,
+	notifier notifier,
+	matching entities.GuestMatching,
+	baseCurrency string,
 ) *Controller {
 	return &Controller{
-		sql: db,
-		ds:  ds,
+		sql:          db,
+		ds:           ds,
+		payments:     payments,
+		invoices:     invoices,
+		notifier:     notifier,
+		matching:     matching,
+		baseCurrency: baseCurrency,
 	}
 }
@@ -0,0 +1,228 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"booking-service/internal/entities"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+// nopDriver открывает транзакции, которые ничего не делают: данные хранит fakeDS
+type nopDriver struct{}
+
+type nopConn struct{}
+
+type nopTx struct{}
+
+func (nopDriver) Open(string) (driver.Conn, error) { return nopConn{}, nil }
+
+func (nopConn) Prepare(string) (driver.Stmt, error) { return nil, errNoStatements }
+func (nopConn) Close() error                        { return nil }
+func (nopConn) Begin() (driver.Tx, error)           { return nopTx{}, nil }
+
+func (nopConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) { return nopTx{}, nil }
+
+func (nopTx) Commit() error   { return nil }
+func (nopTx) Rollback() error { return nil }
+
+var (
+	errNoStatements   = errors.New("nop driver has no statements")
+	registerNopDriver sync.Once
+)
+
+// fakeDS хранилище в памяти. Методы, которые тесту не нужны, не реализованы:
+// вызов такого метода паникует на встроенном nil-интерфейсе
+type fakeDS struct {
+	ds
+
+	calls       []string
+	hotel       entities.Hotel
+	room        entities.Room
+	plans       []entities.RatePlan
+	depositRule *entities.DepositRule
+	bookings    map[uint64]entities.Booking
+	payments    []entities.Payment
+}
+
+func newFakeDS() *fakeDS {
+	return &fakeDS{
+		hotel: entities.Hotel{ID: 1, Currency: "RUB"},
+		room:  entities.Room{ID: 1, HotelID: 1, Type: "standard"},
+		plans: []entities.RatePlan{{
+			ID:           1,
+			HotelID:      1,
+			RoomType:     "standard",
+			NightlyPrice: entities.Money{Amount: 10000, Currency: "RUB"},
+			ValidFrom:    time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		}},
+		bookings: map[uint64]entities.Booking{},
+	}
+}
+
+func (f *fakeDS) call(name string) { f.calls = append(f.calls, name) }
+
+func (f *fakeDS) LockBooking(_ context.Context, _ *sql.Tx, _ uint64) error {
+	f.call("LockBooking")
+	return nil
+}
+
+func (f *fakeDS) FindBookingById(_ context.Context, _ *sql.Tx, id uint64) (entities.Booking, error) {
+	f.call("FindBookingById")
+	b, ok := f.bookings[id]
+	if !ok {
+		return entities.Booking{}, entities.ErrNotFound
+	}
+	return b, nil
+}
+
+func (f *fakeDS) SaveGuest(_ context.Context, _ *sql.Tx, g entities.Guest) (entities.Guest, error) {
+	g.ID = 1
+	return g, nil
+}
+
+func (f *fakeDS) SaveBooking(_ context.Context, _ *sql.Tx, b entities.Booking) (entities.Booking, error) {
+	f.call("SaveBooking")
+	if b.ID == 0 {
+		b.ID = uint64(len(f.bookings) + 1)
+	}
+	f.bookings[b.ID] = b
+	return b, nil
+}
+
+func (f *fakeDS) AttachGuestsToBooking(context.Context, *sql.Tx, uint64, []uint64) error {
+	f.call("AttachGuestsToBooking")
+	return nil
+}
+
+func (f *fakeDS) FindHotelIDByBookingID(context.Context, *sql.Tx, uint64) (uint64, error) {
+	return f.hotel.ID, nil
+}
+
+func (f *fakeDS) IsRoomAvailableForBooking(context.Context, *sql.Tx, uint64, uint64, time.Time, time.Time) (bool, error) {
+	return true, nil
+}
+
+func (f *fakeDS) FindRoomById(context.Context, *sql.Tx, int64) (entities.Room, error) {
+	return f.room, nil
+}
+
+func (f *fakeDS) FindHotelByID(context.Context, *sql.Tx, uint64) (entities.Hotel, error) {
+	return f.hotel, nil
+}
+
+func (f *fakeDS) FindRatePlansForStay(
+	context.Context, *sql.Tx, uint64, string, time.Time, time.Time,
+) ([]entities.RatePlan, error) {
+	return f.plans, nil
+}
+
+func (f *fakeDS) FindRateOverrides(
+	context.Context, *sql.Tx, uint64, string, time.Time, time.Time,
+) ([]entities.RateOverride, error) {
+	return nil, nil
+}
+
+func (f *fakeDS) FindPricingRule(context.Context, *sql.Tx, uint64, string) (entities.PricingRule, error) {
+	return entities.PricingRule{}, entities.ErrNotFound
+}
+
+func (f *fakeDS) FindTaxRulesForStay(context.Context, *sql.Tx, uint64, time.Time, time.Time) ([]entities.TaxRule, error) {
+	return nil, nil
+}
+
+func (f *fakeDS) FindDepositRule(context.Context, *sql.Tx, uint64) (entities.DepositRule, error) {
+	if f.depositRule == nil {
+		return entities.DepositRule{}, entities.ErrNotFound
+	}
+	return *f.depositRule, nil
+}
+
+func (f *fakeDS) SavePayment(_ context.Context, _ *sql.Tx, p entities.Payment) (entities.Payment, error) {
+	p.ID = uint64(len(f.payments) + 1)
+	f.payments = append(f.payments, p)
+	return p, nil
+}
+
+func (f *fakeDS) LockGuests(context.Context, *sql.Tx, []uint64) error {
+	return nil
+}
+
+func (f *fakeDS) FindGuestByID(_ context.Context, _ *sql.Tx, id uint64) (entities.Guest, error) {
+	return entities.Guest{ID: id, Name: "Guest"}, nil
+}
+
+func (f *fakeDS) HasActiveGuestBookings(context.Context, *sql.Tx, uint64) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeDS) EraseGuest(_ context.Context, _ *sql.Tx, id uint64) (entities.Guest, error) {
+	f.call("EraseGuest")
+	return entities.Guest{ID: id, Name: entities.ErasedGuestName}, nil
+}
+
+func (f *fakeDS) EraseGuestReviews(context.Context, *sql.Tx, uint64) error {
+	f.call("EraseGuestReviews")
+	return nil
+}
+
+func (f *fakeDS) FindMergedGuestIDs(context.Context, *sql.Tx, uint64) ([]uint64, error) {
+	return nil, nil
+}
+
+func (f *fakeDS) RedactAuditEvents(context.Context, *sql.Tx, entities.AuditEntityType, []uint64) error {
+	f.call("RedactAuditEvents")
+	return nil
+}
+
+func (f *fakeDS) SaveAuditEvent(context.Context, *sql.Tx, entities.AuditEvent) error {
+	return nil
+}
+
+// fakePayments платежный сервис: списания успешны, если не задан chargeErr
+type fakePayments struct {
+	chargeErr error
+	charges   []entities.Money
+	cancels   []uint64
+}
+
+func (p *fakePayments) Charge(_ context.Context, _ uint64, amount entities.Money) error {
+	if p.chargeErr != nil {
+		return p.chargeErr
+	}
+	p.charges = append(p.charges, amount)
+	return nil
+}
+
+func (p *fakePayments) Cancel(_ context.Context, bookingID uint64) error {
+	p.cancels = append(p.cancels, bookingID)
+	return nil
+}
+
+func (p *fakePayments) Payments(context.Context, uint64, string) ([]entities.Payment, error) {
+	return nil, nil
+}
+
+func newTestController(t *testing.T, store *fakeDS, payments *fakePayments) *Controller {
+	t.Helper()
+
+	registerNopDriver.Do(func() { sql.Register("controllers-nop", nopDriver{}) })
+	db, err := sqlx.Open("controllers-nop", "")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return New(db, store, payments, nil, nil, entities.GuestMatching{}, "RUB")
+}
+
+// day полночь UTC через days дней от сегодняшнего
+func day(days int) time.Time {
+	now := time.Now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day()+days, 0, 0, 0, 0, time.UTC)
+}
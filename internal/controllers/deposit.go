@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/pricing"
+	"booking-service/internal/storage"
+)
+
+// SetDepositRule создает или заменяет правило предоплаты отеля. Правило
+// действует для новых бронирований; у существующих график оплаты не меняется
+func (c *Controller) SetDepositRule(ctx context.Context, rule entities.DepositRule) (entities.DepositRule, error) {
+	if err := pricing.ValidateDepositRule(rule); err != nil {
+		return entities.DepositRule{}, err
+	}
+
+	var res entities.DepositRule
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		if _, errTx := c.ds.FindHotelByID(ctx, tx, rule.HotelID); errTx != nil {
+			return errTx
+		}
+
+		var before any
+		current, errTx := c.ds.FindDepositRule(ctx, tx, rule.HotelID)
+		switch {
+		case errTx == nil:
+			before = current
+		case !errors.Is(errTx, entities.ErrNotFound):
+			return errTx
+		}
+
+		if res, errTx = c.ds.SaveDepositRule(ctx, tx, rule); errTx != nil {
+			return errTx
+		}
+		action := entities.AuditActionUpdate
+		if before == nil {
+			action = entities.AuditActionCreate
+		}
+		return c.audit(ctx, tx, entities.AuditEntityDepositRule, res.HotelID, res.HotelID, action, before, res)
+	})
+	if err != nil {
+		return entities.DepositRule{}, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) GetDepositRule(ctx context.Context, hotelID uint64) (entities.DepositRule, error) {
+	var rule entities.DepositRule
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		rule, errTx = c.ds.FindDepositRule(ctx, tx, hotelID)
+		return errTx
+	})
+	if err != nil {
+		return entities.DepositRule{}, err
+	}
+
+	return rule, nil
+}
+
+// DeleteDepositRule удаляет правило: новые бронирования отеля снова не требуют предоплаты
+func (c *Controller) DeleteDepositRule(ctx context.Context, hotelID uint64) error {
+	return storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		before, errTx := c.ds.FindDepositRule(ctx, tx, hotelID)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = c.ds.DeleteDepositRule(ctx, tx, hotelID); errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityDepositRule, hotelID, hotelID,
+			entities.AuditActionDelete, before, nil)
+	})
+}
+
+// takeDeposit списывает депозит нового бронирования по правилу отеля и
+// планирует списание остатка. Если срок остатка уже наступил, сразу
+// списывается полная стоимость. Без правила бронирование не меняется.
+// Второй результат сообщает, что деньги списаны, даже при ошибке: если бронирование
+// не зафиксируется, депозит нужно вернуть через refundDeposit
+func (c *Controller) takeDeposit(
+	ctx context.Context, tx *sql.Tx, booking entities.Booking, hotelID uint64, now time.Time,
+) (entities.Booking, bool, error) {
+	rule, err := c.ds.FindDepositRule(ctx, tx, hotelID)
+	switch {
+	case errors.Is(err, entities.ErrNotFound):
+		return booking, false, nil
+	case err != nil:
+		return booking, false, err
+	}
+	if booking.Price.Total.Amount <= 0 {
+		return booking, false, nil
+	}
+
+	amount := pricing.Deposit(rule, booking.Price)
+	dueAt := pricing.BalanceDueAt(rule, booking.StartDate)
+	if !now.Before(dueAt) {
+		amount = booking.Price.Total
+	}
+	booking.AmountPaid = entities.Money{Currency: booking.Price.Total.Currency}
+	if amount.Amount > 0 {
+		if err = c.payments.Charge(ctx, booking.ID, amount); err != nil {
+			// после отказа и без отправки запроса деньги не списаны, в остальных
+			// случаях исход неизвестен: например, истек таймаут или оборвалось соединение
+			charged := !errors.Is(err, entities.ErrPaymentDeclined) && !errors.Is(err, entities.ErrPaymentNotAttempted)
+			return booking, charged, err
+		}
+		if booking, err = c.applyPayment(ctx, tx, booking, hotelID, amount); err != nil {
+			return booking, true, err
+		}
+	}
+	if !booking.IsPaid {
+		booking.BalanceDueAt = dueAt
+	}
+	return booking, amount.Amount > 0, nil
+}
+
+// refundDeposit отменяет депозит бронирования, которое не зафиксировалось после
+// списания. Сверка такое списание не найдет: бронирования в базе нет. Других
+// платежей у бронирования нет, а номер из последовательности повторно не
+// выдается, поэтому отменяются все платежи по нему. Отмена выполняется и после
+// отмены запроса, чтобы деньги не остались списанными
+func (c *Controller) refundDeposit(ctx context.Context, bookingID uint64, err error) error {
+	if cancelErr := c.payments.Cancel(context.WithoutCancel(ctx), bookingID); cancelErr != nil {
+		return errors.Join(err, fmt.Errorf("refund deposit of booking %d: %w", bookingID, cancelErr))
+	}
+	return err
+}
@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"booking-service/internal/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateBookingRefundsDepositOnlyIfChargeMayHavePassed(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		chargeErr error
+		refunded  bool
+	}{
+		{"declined", fmt.Errorf("%w: insufficient funds", entities.ErrPaymentDeclined), false},
+		{"breaker open", fmt.Errorf("%w: %w: circuit breaker is open",
+			entities.ErrPaymentUnavailable, entities.ErrPaymentNotAttempted), false},
+		{"timeout", status.Error(codes.DeadlineExceeded, "context deadline exceeded"), true},
+		{"transport", fmt.Errorf("%w: %w", entities.ErrPaymentUnavailable,
+			status.Error(codes.Unavailable, "connection reset")), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store, payments := newFakeDS(), &fakePayments{chargeErr: tc.chargeErr}
+			store.depositRule = &entities.DepositRule{
+				HotelID: 1, Kind: entities.DepositKindPercent, PercentBasisPoints: 3000, BalanceDaysBefore: 7,
+			}
+			c := newTestController(t, store, payments)
+
+			_, _, err := c.CreateBooking(context.Background(), entities.CreateBookingDTO{
+				RoomID:    1,
+				StartDate: day(30),
+				EndDate:   day(32),
+				Guests:    []entities.GuestDTO{{Name: "Guest"}},
+			})
+			require.ErrorIs(t, err, tc.chargeErr)
+			if tc.refunded {
+				assert.Equal(t, []uint64{1}, payments.cancels)
+			} else {
+				assert.Empty(t, payments.cancels)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/storage"
+)
+
+func (c *Controller) CreateEmployee(ctx context.Context, input entities.EmployeeDTO) (entities.Employee, error) {
+	if err := validateEmployee(input); err != nil {
+		return entities.Employee{}, err
+	}
+
+	var employee entities.Employee
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		employee, errTx = c.ds.SaveEmployee(ctx, tx, entities.Employee{
+			Name:    input.Name,
+			Role:    input.Role,
+			HotelID: input.HotelID,
+			Subject: input.Subject,
+		})
+		if errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityEmployee, employee.ID, employee.HotelID,
+			entities.AuditActionCreate, nil, employee)
+	})
+	if err != nil {
+		return entities.Employee{}, err
+	}
+
+	return employee, nil
+}
+
+func (c *Controller) GetEmployee(ctx context.Context, employeeID uint64) (entities.Employee, error) {
+	var employee entities.Employee
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		employee, errTx = c.ds.FindEmployeeByID(ctx, tx, employeeID)
+		return errTx
+	})
+	if err != nil {
+		return entities.Employee{}, err
+	}
+
+	return employee, nil
+}
+
+// FindEmployeeBySubject ищет сотрудника по subject из JWT
+func (c *Controller) FindEmployeeBySubject(ctx context.Context, subject string) (entities.Employee, error) {
+	var employee entities.Employee
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		employee, errTx = c.ds.FindEmployeeBySubject(ctx, tx, subject)
+		return errTx
+	})
+	if err != nil {
+		return entities.Employee{}, err
+	}
+
+	return employee, nil
+}
+
+func (c *Controller) ListEmployees(ctx context.Context, hotelID uint64) ([]entities.Employee, error) {
+	var employees []entities.Employee
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		employees, errTx = c.ds.FindEmployeesByHotelID(ctx, tx, hotelID)
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return employees, nil
+}
+
+func (c *Controller) UpdateEmployee(ctx context.Context, employeeID uint64, input entities.EmployeeDTO) (entities.Employee, error) {
+	if err := validateEmployee(input); err != nil {
+		return entities.Employee{}, err
+	}
+
+	var employee entities.Employee
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		before, errTx := c.ds.FindEmployeeByID(ctx, tx, employeeID)
+		if errTx != nil {
+			return errTx
+		}
+		employee, errTx = c.ds.UpdateEmployee(ctx, tx, entities.Employee{
+			ID:      employeeID,
+			Name:    input.Name,
+			Role:    input.Role,
+			HotelID: input.HotelID,
+			Subject: input.Subject,
+		})
+		if errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityEmployee, employee.ID, employee.HotelID,
+			entities.AuditActionUpdate, before, employee)
+	})
+	if err != nil {
+		return entities.Employee{}, err
+	}
+
+	return employee, nil
+}
+
+func (c *Controller) DeleteEmployee(ctx context.Context, employeeID uint64) error {
+	return storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		before, errTx := c.ds.FindEmployeeByID(ctx, tx, employeeID)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = c.ds.DeleteEmployee(ctx, tx, employeeID); errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityEmployee, before.ID, before.HotelID,
+			entities.AuditActionDelete, before, nil)
+	})
+}
+
+func validateEmployee(input entities.EmployeeDTO) error {
+	if input.Name == "" {
+		return entities.ErrNameIsRequired
+	}
+	if len([]rune(input.Name)) > 255 {
+		return entities.ErrNameIsTooLong
+	}
+	if !input.Role.IsValid() {
+		return entities.ErrInvalidRole
+	}
+	if input.Role != entities.EmployeeRoleAdmin && input.HotelID == 0 {
+		return entities.ErrHotelIsRequired
+	}
+	if input.Subject == "" {
+		return entities.ErrSubjectIsRequired
+	}
+	return nil
+}
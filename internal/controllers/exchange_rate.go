@@ -0,0 +1,88 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/pricing"
+	"booking-service/internal/storage"
+)
+
+// SetExchangeRates загружает курсы валют. При replace курсы, которых нет в
+// загрузке, удаляются — так CSV заменяет всю таблицу. Повтор пары в загрузке
+// перекрывает предыдущий
+func (c *Controller) SetExchangeRates(
+	ctx context.Context, rates []entities.ExchangeRate, replace bool,
+) ([]entities.ExchangeRate, error) {
+	if len(rates) == 0 {
+		return nil, entities.ErrEmptyExchangeRates
+	}
+	unique := make([]entities.ExchangeRate, 0, len(rates))
+	index := make(map[[2]string]int, len(rates))
+	for _, r := range rates {
+		if err := pricing.ValidateExchangeRate(r); err != nil {
+			return nil, err
+		}
+		pair := [2]string{r.Base, r.Quote}
+		if i, ok := index[pair]; ok {
+			unique[i] = r
+			continue
+		}
+		index[pair] = len(unique)
+		unique = append(unique, r)
+	}
+
+	var res []entities.ExchangeRate
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		before, errTx := c.ds.FindExchangeRates(ctx, tx)
+		if errTx != nil {
+			return errTx
+		}
+		if replace {
+			if errTx = c.ds.DeleteExchangeRates(ctx, tx); errTx != nil {
+				return errTx
+			}
+		}
+		if errTx = c.ds.SaveExchangeRates(ctx, tx, unique); errTx != nil {
+			return errTx
+		}
+		if res, errTx = c.ds.FindExchangeRates(ctx, tx); errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityExchangeRate, 0, 0, entities.AuditActionUpdate, before, res)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) ListExchangeRates(ctx context.Context) ([]entities.ExchangeRate, error) {
+	var rates []entities.ExchangeRate
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		rates, errTx = c.ds.FindExchangeRates(ctx, tx)
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+// convertPrice пересчитывает цену в валюту гостя по загруженному курсу
+func (c *Controller) convertPrice(
+	ctx context.Context, tx *sql.Tx, price entities.PriceSnapshot, currency string,
+) (*entities.ConvertedPrice, error) {
+	rates, err := c.ds.FindExchangeRatesForPair(ctx, tx, price.Total.Currency, currency)
+	if err != nil {
+		return nil, err
+	}
+	converted, err := pricing.ConvertPrice(price, currency, rates)
+	if err != nil {
+		return nil, err
+	}
+	return &converted, nil
+}
@@ -0,0 +1,288 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"booking-service/internal/audit"
+	"booking-service/internal/entities"
+	"booking-service/internal/pricing"
+	"booking-service/internal/storage"
+)
+
+// GetFolio возвращает счет гостя с итогами. Если начислений еще не было,
+// возвращается пустой открытый счет без идентификатора
+func (c *Controller) GetFolio(ctx context.Context, bookingID uint64) (entities.Folio, error) {
+	var folio entities.Folio
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		found, errTx := c.ds.FindFolioByBookingID(ctx, tx, bookingID)
+		switch {
+		case errors.Is(errTx, entities.ErrNotFound):
+			hotel, errTx := c.bookingHotel(ctx, tx, bookingID)
+			if errTx != nil {
+				return errTx
+			}
+			folio = pricing.FolioTotals(entities.Folio{
+				BookingID: bookingID,
+				Currency:  hotel.Currency,
+				Status:    entities.FolioStatusOpen,
+				Charges:   []entities.FolioCharge{},
+				Payments:  []entities.Payment{},
+			})
+			return nil
+		case errTx != nil:
+			return errTx
+		}
+		folio, errTx = c.loadFolio(ctx, tx, found)
+		return errTx
+	})
+	if err != nil {
+		return entities.Folio{}, err
+	}
+
+	return folio, nil
+}
+
+// PostFolioCharge добавляет начисление в счет. Цена указывается в валюте отеля
+func (c *Controller) PostFolioCharge(
+	ctx context.Context, input entities.FolioChargeDTO,
+) (entities.FolioCharge, entities.Folio, error) {
+	charge, err := pricing.PriceFolioCharge(entities.FolioCharge{
+		Category:       input.Category,
+		Description:    input.Description,
+		Quantity:       input.Quantity,
+		UnitPrice:      input.UnitPrice,
+		TaxBasisPoints: input.TaxBasisPoints,
+		PostedBy:       audit.Actor(ctx),
+	})
+	if err != nil {
+		return entities.FolioCharge{}, entities.Folio{}, err
+	}
+
+	var folio entities.Folio
+	err = storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		booking, hotel, found, errTx := c.lockFolio(ctx, tx, input.BookingID)
+		if errTx != nil {
+			return errTx
+		}
+		if booking.Status == entities.BookingStatusCancelled {
+			return entities.ErrBookingCancelled
+		}
+		if errTx = checkHotelCurrency(hotel, charge.UnitPrice); errTx != nil {
+			return errTx
+		}
+
+		charge.FolioID = found.ID
+		if charge, errTx = c.ds.SaveFolioCharge(ctx, tx, charge); errTx != nil {
+			return errTx
+		}
+		if errTx = c.audit(ctx, tx, entities.AuditEntityFolioCharge, charge.ID, hotel.ID,
+			entities.AuditActionCreate, nil, charge); errTx != nil {
+			return errTx
+		}
+		folio, errTx = c.loadFolio(ctx, tx, found)
+		return errTx
+	})
+	if err != nil {
+		return entities.FolioCharge{}, entities.Folio{}, err
+	}
+
+	return charge, folio, nil
+}
+
+// VoidFolioCharge сторнирует начисление. Оплаченное начисление сторнировать
+// нельзя: возврата по счету нет, и гость остался бы с переплатой
+func (c *Controller) VoidFolioCharge(
+	ctx context.Context, bookingID, chargeID uint64, reason string,
+) (entities.Folio, error) {
+	if reason == "" {
+		return entities.Folio{}, entities.ErrVoidReasonRequired
+	}
+
+	var folio entities.Folio
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		_, hotel, found, errTx := c.lockFolio(ctx, tx, bookingID)
+		if errTx != nil {
+			return errTx
+		}
+		before, errTx := c.ds.FindFolioChargeByID(ctx, tx, chargeID)
+		if errTx != nil {
+			return errTx
+		}
+		if before.FolioID != found.ID {
+			return entities.ErrNotFound
+		}
+		if !before.VoidedAt.IsZero() {
+			return entities.ErrChargeAlreadyVoided
+		}
+		if folio, errTx = c.loadFolio(ctx, tx, found); errTx != nil {
+			return errTx
+		}
+		if folio.Balance.Amount < before.Total.Amount {
+			return entities.ErrChargeSettled
+		}
+
+		charge, errTx := c.ds.VoidFolioCharge(ctx, tx, chargeID, reason)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = c.audit(ctx, tx, entities.AuditEntityFolioCharge, charge.ID, hotel.ID,
+			entities.AuditActionUpdate, before, charge); errTx != nil {
+			return errTx
+		}
+		folio, errTx = c.loadFolio(ctx, tx, folio)
+		return errTx
+	})
+	if err != nil {
+		return entities.Folio{}, err
+	}
+
+	return folio, nil
+}
+
+// SettleFolio списывает через платежный сервис amount или, если сумма не
+// задана, весь остаток по счету. Бронирование заблокировано на время
+// списания, как в PayBooking
+func (c *Controller) SettleFolio(ctx context.Context, bookingID uint64, amount entities.Money) (entities.Folio, error) {
+	var folio entities.Folio
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		_, hotel, found, errTx := c.lockFolio(ctx, tx, bookingID)
+		if errTx != nil {
+			return errTx
+		}
+		if folio, errTx = c.loadFolio(ctx, tx, found); errTx != nil {
+			return errTx
+		}
+		if folio.Balance.Amount <= 0 {
+			return entities.ErrNothingToPay
+		}
+		if amount.Amount == 0 {
+			amount = folio.Balance
+		}
+		if amount.Amount < 0 || amount.Amount > folio.Balance.Amount || amount.Currency != folio.Currency {
+			return entities.ErrInvalidSettlementAmount
+		}
+
+		// как и в PayBooking, несохраненное списание покажет сверка с платежным сервисом
+		if errTx = c.payments.Charge(ctx, bookingID, amount); errTx != nil {
+			return errTx
+		}
+		payment, errTx := c.ds.SavePayment(ctx, tx, entities.Payment{
+			BookingID:   bookingID,
+			FolioID:     folio.ID,
+			Amount:      amount,
+			PaymentDate: time.Now().UTC(),
+			Status:      entities.PaymentStatusSuccess,
+		})
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = c.audit(ctx, tx, entities.AuditEntityPayment, payment.ID, hotel.ID,
+			entities.AuditActionCreate, nil, payment); errTx != nil {
+			return errTx
+		}
+		folio, errTx = c.loadFolio(ctx, tx, folio)
+		return errTx
+	})
+	if err != nil {
+		return entities.Folio{}, err
+	}
+
+	return folio, nil
+}
+
+// CloseFolio закрывает счет при выезде гостя. Счет должен быть оплачен
+// полностью, а проживание — через PayBooking, если бронирование не отменено
+func (c *Controller) CloseFolio(ctx context.Context, bookingID uint64) (entities.Folio, error) {
+	var folio entities.Folio
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		booking, hotel, found, errTx := c.lockFolio(ctx, tx, bookingID)
+		if errTx != nil {
+			return errTx
+		}
+		if booking.Status != entities.BookingStatusCancelled && !booking.IsPaid && booking.Price.Total.Amount > 0 {
+			return entities.ErrBookingNotPaid
+		}
+		if folio, errTx = c.loadFolio(ctx, tx, found); errTx != nil {
+			return errTx
+		}
+		if folio.Balance.Amount != 0 {
+			return entities.ErrFolioNotSettled
+		}
+
+		before := folio
+		folio.Status, folio.ClosedAt = entities.FolioStatusClosed, time.Now().UTC()
+		if folio, errTx = c.ds.UpdateFolio(ctx, tx, folio); errTx != nil {
+			return errTx
+		}
+		if errTx = c.audit(ctx, tx, entities.AuditEntityFolio, folio.ID, hotel.ID,
+			entities.AuditActionUpdate, before, folio); errTx != nil {
+			return errTx
+		}
+		folio, errTx = c.loadFolio(ctx, tx, folio)
+		return errTx
+	})
+	if err != nil {
+		return entities.Folio{}, err
+	}
+
+	return folio, nil
+}
+
+// lockFolio блокирует бронирование и находит его открытый счет, создавая его
+// при первом обращении. Все изменения счета идут под блокировкой бронирования,
+// поэтому выполняются по очереди и с оплатой проживания
+func (c *Controller) lockFolio(
+	ctx context.Context, tx *sql.Tx, bookingID uint64,
+) (entities.Booking, entities.Hotel, entities.Folio, error) {
+	if err := c.ds.LockBooking(ctx, tx, bookingID); err != nil {
+		return entities.Booking{}, entities.Hotel{}, entities.Folio{}, err
+	}
+	booking, err := c.ds.FindBookingById(ctx, tx, bookingID)
+	if err != nil {
+		return entities.Booking{}, entities.Hotel{}, entities.Folio{}, err
+	}
+	hotel, err := c.bookingHotel(ctx, tx, bookingID)
+	if err != nil {
+		return entities.Booking{}, entities.Hotel{}, entities.Folio{}, err
+	}
+	folio, err := c.ds.FindOrCreateFolio(ctx, tx, bookingID, hotel.Currency)
+	if err != nil {
+		return entities.Booking{}, entities.Hotel{}, entities.Folio{}, err
+	}
+	if folio.Status == entities.FolioStatusClosed {
+		return entities.Booking{}, entities.Hotel{}, entities.Folio{}, entities.ErrFolioClosed
+	}
+	return booking, hotel, folio, nil
+}
+
+// loadFolio дополняет счет начислениями, оплатами и итогами
+func (c *Controller) loadFolio(ctx context.Context, tx *sql.Tx, folio entities.Folio) (entities.Folio, error) {
+	charges, err := c.ds.FindFolioCharges(ctx, tx, folio.ID)
+	if err != nil {
+		return entities.Folio{}, err
+	}
+	payments, err := c.ds.FindPaymentsByBookingID(ctx, tx, folio.BookingID)
+	if err != nil {
+		return entities.Folio{}, err
+	}
+
+	folio.Charges = charges
+	folio.Payments = make([]entities.Payment, 0, len(payments))
+	for _, p := range payments {
+		if p.FolioID == folio.ID {
+			folio.Payments = append(folio.Payments, p)
+		}
+	}
+	return pricing.FolioTotals(folio), nil
+}
+
+func (c *Controller) bookingHotel(ctx context.Context, tx *sql.Tx, bookingID uint64) (entities.Hotel, error) {
+	hotelID, err := c.ds.FindHotelIDByBookingID(ctx, tx, bookingID)
+	if err != nil {
+		return entities.Hotel{}, err
+	}
+	return c.ds.FindHotelByID(ctx, tx, hotelID)
+}
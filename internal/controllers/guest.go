@@ -3,29 +3,90 @@ package controllers
 import (
 	"context"
 	"database/sql"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"booking-service/internal/entities"
 	"booking-service/internal/storage"
+
+	"github.com/lithammer/fuzzysearch/fuzzy"
+)
+
+const (
+	maxGuestNameLength      = 40
+	maxDocumentNumberLength = 32
+
+	defaultGuestPageSize = 50
+	maxGuestPageSize     = 200
+
+	// maxGuestMatchCandidates профилей с одним email, телефоном или документом,
+	// среди которых ищется тот же гость
+	maxGuestMatchCandidates = 20
+)
+
+var (
+	e164        = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+	countryCode = regexp.MustCompile(`^[A-Z]{2}$`)
+	// номер документа после нормализации: латинские буквы и цифры
+	documentNumber = regexp.MustCompile(`^[A-Z0-9]+$`)
 )
 
+// CreateGuest создает гостя или возвращает существующий профиль того же гостя,
+// найденный по стратегии сопоставления
 func (c *Controller) CreateGuest(ctx context.Context, input entities.GuestDTO) (entities.Guest, error) {
-	if input.Name == "" {
-		return entities.Guest{}, entities.ErrNameIsRequired
+	guest, err := newGuest(input, time.Now().UTC())
+	if err != nil {
+		return entities.Guest{}, err
 	}
-	if len([]rune(input.Name)) > 40 {
-		return entities.Guest{}, entities.ErrNameIsTooLong
+
+	err = storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		guest, _, errTx = c.findOrCreateGuest(ctx, tx, guest)
+		return errTx
+	})
+	if err != nil {
+		return entities.Guest{}, err
 	}
-	guest := entities.Guest{
-		Name: input.Name,
+
+	return guest, nil
+}
+
+func (c *Controller) GetGuest(ctx context.Context, guestID uint64) (entities.Guest, error) {
+	var guest entities.Guest
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		guest, errTx = c.ds.FindGuestByID(ctx, tx, guestID)
+		return errTx
+	})
+	if err != nil {
+		return entities.Guest{}, err
 	}
 
-	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
-		var errTx error
-		guest, errTx = c.ds.SaveGuestAndReturnIt(ctx, tx, guest)
+	return guest, nil
+}
+
+// UpdateGuest заменяет профиль гостя целиком: незаданные поля очищаются
+func (c *Controller) UpdateGuest(ctx context.Context, input entities.Guest) (entities.Guest, error) {
+	input, err := normalizeGuest(input, time.Now().UTC())
+	if err != nil {
+		return entities.Guest{}, err
+	}
+
+	var guest entities.Guest
+	err = storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		before, errTx := c.ds.FindGuestByID(ctx, tx, input.ID)
 		if errTx != nil {
 			return errTx
 		}
-		return nil
+		if !before.ErasedAt.IsZero() {
+			return entities.ErrGuestErased
+		}
+		if guest, errTx = c.ds.UpdateGuest(ctx, tx, input); errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityGuest, guest.ID, 0, entities.AuditActionUpdate, before, guest)
 	})
 	if err != nil {
 		return entities.Guest{}, err
@@ -33,3 +94,204 @@ func (c *Controller) CreateGuest(ctx context.Context, input entities.GuestDTO) (
 
 	return guest, nil
 }
+
+// SearchGuests возвращает страницу найденных гостей и курсор следующей страницы (0 — страница последняя)
+func (c *Controller) SearchGuests(ctx context.Context, filter entities.GuestFilter) ([]entities.Guest, uint64, error) {
+	filter.Name = strings.TrimSpace(filter.Name)
+	filter.Email = normalizeEmail(filter.Email)
+	filter.Phone = normalizePhone(filter.Phone)
+	filter.DocumentNumber = normalizeDocumentNumber(filter.DocumentNumber)
+	if filter.Name == "" && filter.Email == "" && filter.Phone == "" && filter.DocumentNumber == "" {
+		return nil, 0, entities.ErrEmptyGuestSearch
+	}
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultGuestPageSize
+	case filter.Limit > maxGuestPageSize:
+		filter.Limit = maxGuestPageSize
+	}
+
+	limit := filter.Limit
+	// лишняя запись показывает, что есть следующая страница
+	filter.Limit++
+
+	var guests []entities.Guest
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		guests, errTx = c.ds.FindGuests(ctx, tx, filter)
+		return errTx
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var next uint64
+	if len(guests) > limit {
+		guests = guests[:limit]
+		next = guests[limit-1].ID
+	}
+	return guests, next, nil
+}
+
+// findOrCreateGuest возвращает существующий профиль того же гостя или создает новый;
+// created сообщает, что профиль создан этим вызовом.
+// Параллельные запросы с одними данными могут создать двух гостей: такие пары
+// попадают в отчет о дубликатах
+func (c *Controller) findOrCreateGuest(ctx context.Context, tx *sql.Tx, input entities.Guest) (
+	guest entities.Guest, created bool, err error,
+) {
+	guest, found, err := c.matchGuest(ctx, tx, input)
+	if err != nil || found {
+		return guest, false, err
+	}
+
+	if guest, err = c.ds.SaveGuest(ctx, tx, input); err != nil {
+		return entities.Guest{}, false, err
+	}
+	if err = c.audit(ctx, tx, entities.AuditEntityGuest, guest.ID, 0, entities.AuditActionCreate, nil, guest); err != nil {
+		return entities.Guest{}, false, err
+	}
+	return guest, true, nil
+}
+
+// matchGuest ищет профиль того же гостя по полям c.matching.Keys в порядке
+// приоритета. По каждому полю берется кандидат с самым похожим именем, если
+// сходство не ниже порога. Одно совпадение имени гостя не находит
+func (c *Controller) matchGuest(ctx context.Context, tx *sql.Tx, input entities.Guest) (entities.Guest, bool, error) {
+	for _, key := range c.matching.Keys {
+		filter := entities.GuestFilter{Limit: maxGuestMatchCandidates}
+		switch key {
+		case entities.GuestMatchEmail:
+			filter.Email = input.Email
+		case entities.GuestMatchPhone:
+			filter.Phone = input.Phone
+		case entities.GuestMatchDocument:
+			filter.DocumentNumber = input.DocumentNumber
+		}
+		if filter.Email == "" && filter.Phone == "" && filter.DocumentNumber == "" {
+			continue
+		}
+
+		candidates, err := c.ds.FindGuests(ctx, tx, filter)
+		if err != nil {
+			return entities.Guest{}, false, err
+		}
+		var (
+			best      entities.Guest
+			bestScore = -1.0
+		)
+		for _, candidate := range candidates {
+			if score := nameSimilarity(input.Name, candidate.Name); score >= c.matching.NameSimilarity && score > bestScore {
+				best, bestScore = candidate, score
+			}
+		}
+		if bestScore >= 0 {
+			return best, true, nil
+		}
+	}
+	return entities.Guest{}, false, nil
+}
+
+// nameSimilarity сходство имен от 0 до 1 по расстоянию Левенштейна. Регистр,
+// лишние пробелы и порядок слов не учитываются
+func nameSimilarity(a, b string) float64 {
+	a, b = nameKey(a), nameKey(b)
+	n := max(utf8.RuneCountInString(a), utf8.RuneCountInString(b))
+	if n == 0 {
+		return 1
+	}
+	return 1 - float64(fuzzy.LevenshteinDistance(a, b))/float64(n)
+}
+
+func nameKey(name string) string {
+	words := strings.Fields(strings.ToLower(name))
+	slices.Sort(words)
+	return strings.Join(words, " ")
+}
+
+// newGuest профиль нового гостя из данных запроса
+func newGuest(input entities.GuestDTO, now time.Time) (entities.Guest, error) {
+	return normalizeGuest(entities.Guest{
+		Name:              input.Name,
+		Email:             input.Email,
+		Phone:             input.Phone,
+		DocumentType:      input.DocumentType,
+		DocumentNumber:    input.DocumentNumber,
+		DocumentCountry:   input.DocumentCountry,
+		DocumentExpiresAt: input.DocumentExpiresAt,
+	}, now)
+}
+
+func validateGuestName(name string) error {
+	if name == "" {
+		return entities.ErrNameIsRequired
+	}
+	if len([]rune(name)) > maxGuestNameLength {
+		return entities.ErrNameIsTooLong
+	}
+	return nil
+}
+
+// normalizeGuest приводит контакты и документ к виду, в котором они хранятся
+// и ищутся, и проверяет их
+func normalizeGuest(g entities.Guest, now time.Time) (entities.Guest, error) {
+	g.Name = strings.TrimSpace(g.Name)
+	if err := validateGuestName(g.Name); err != nil {
+		return g, err
+	}
+
+	if g.Email = normalizeEmail(g.Email); g.Email != "" {
+		addr, err := mail.ParseAddress(g.Email)
+		if err != nil || addr.Address != g.Email || len(g.Email) > 254 {
+			return g, entities.ErrInvalidEmail
+		}
+	}
+	if g.Phone = normalizePhone(g.Phone); g.Phone != "" && !e164.MatchString(g.Phone) {
+		return g, entities.ErrInvalidPhone
+	}
+	if !g.DateOfBirth.IsZero() {
+		y, m, d := g.DateOfBirth.UTC().Date()
+		g.DateOfBirth = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		if g.DateOfBirth.After(now) || y < 1900 {
+			return g, entities.ErrInvalidDateOfBirth
+		}
+	}
+
+	g.Nationality = strings.ToUpper(strings.TrimSpace(g.Nationality))
+	g.Address.Country = strings.ToUpper(strings.TrimSpace(g.Address.Country))
+	g.DocumentCountry = strings.ToUpper(strings.TrimSpace(g.DocumentCountry))
+	for _, code := range []string{g.Nationality, g.Address.Country, g.DocumentCountry} {
+		if code != "" && !countryCode.MatchString(code) {
+			return g, entities.ErrInvalidCountryCode
+		}
+	}
+
+	g.DocumentNumber = normalizeDocumentNumber(g.DocumentNumber)
+	hasDocument := g.DocumentType != "" || g.DocumentNumber != "" || g.DocumentCountry != "" ||
+		!g.DocumentExpiresAt.IsZero()
+	if hasDocument {
+		switch g.DocumentType {
+		case entities.DocumentTypePassport, entities.DocumentTypeNationalID, entities.DocumentTypeDrivingLicence:
+		default:
+			return g, entities.ErrInvalidDocument
+		}
+		if !documentNumber.MatchString(g.DocumentNumber) || len(g.DocumentNumber) > maxDocumentNumberLength {
+			return g, entities.ErrInvalidDocument
+		}
+	}
+	return g, nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// normalizePhone убирает разделители, которыми обычно записывают номер
+func normalizePhone(phone string) string {
+	return strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "").Replace(strings.TrimSpace(phone))
+}
+
+// normalizeDocumentNumber номер документа без пробелов и дефисов в верхнем
+// регистре: так один документ, записанный по-разному, находится поиском
+func normalizeDocumentNumber(number string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(number)))
+}
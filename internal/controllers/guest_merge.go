@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/storage"
+)
+
+const (
+	defaultDuplicateGuestsPageSize = 50
+	maxDuplicateGuestsPageSize     = 500
+)
+
+// MergeGuests объединяет дубликат sourceID с гостем targetID в одной транзакции:
+// бронирования и отзывы переходят к targetID, пустые поля профиля targetID
+// заполняются из sourceID, sourceID удаляется
+func (c *Controller) MergeGuests(ctx context.Context, targetID, sourceID uint64) (entities.GuestMerge, error) {
+	if targetID == sourceID {
+		return entities.GuestMerge{}, entities.ErrMergeSameGuest
+	}
+
+	var res entities.GuestMerge
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		errTx := c.ds.LockGuests(ctx, tx, []uint64{targetID, sourceID})
+		if errTx != nil {
+			return errTx
+		}
+		target, errTx := c.ds.FindGuestByID(ctx, tx, targetID)
+		if errTx != nil {
+			return errTx
+		}
+		source, errTx := c.ds.FindGuestByID(ctx, tx, sourceID)
+		if errTx != nil {
+			return errTx
+		}
+		if !target.ErasedAt.IsZero() || !source.ErasedAt.IsZero() {
+			return entities.ErrGuestErased
+		}
+
+		if res.BookingsMoved, errTx = c.ds.MoveGuestBookings(ctx, tx, sourceID, targetID); errTx != nil {
+			return errTx
+		}
+		if res.ReviewsMoved, errTx = c.ds.MoveGuestReviews(ctx, tx, sourceID, targetID); errTx != nil {
+			return errTx
+		}
+		// связь нужна, чтобы при удалении данных гостя вычистить и журнал sourceID
+		if errTx = c.ds.SaveGuestMerge(ctx, tx, sourceID, targetID); errTx != nil {
+			return errTx
+		}
+		if errTx = c.ds.DeleteGuest(ctx, tx, sourceID); errTx != nil {
+			return errTx
+		}
+		if errTx = c.audit(ctx, tx, entities.AuditEntityGuest, sourceID, 0,
+			entities.AuditActionDelete, source, nil); errTx != nil {
+			return errTx
+		}
+
+		res.Guest = target
+		merged, changed := mergeGuestProfiles(target, source)
+		if !changed {
+			return nil
+		}
+		if res.Guest, errTx = c.ds.UpdateGuest(ctx, tx, merged); errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityGuest, targetID, 0, entities.AuditActionUpdate, target, res.Guest)
+	})
+	if err != nil {
+		return entities.GuestMerge{}, err
+	}
+
+	return res, nil
+}
+
+// mergeGuestProfiles заполняет пустые поля target из source. Документ и адрес
+// переносятся целиком, чтобы не смешивать данные двух документов
+func mergeGuestProfiles(target, source entities.Guest) (entities.Guest, bool) {
+	merged, changed := target, false
+	fill := func(dst *string, src string) {
+		if *dst == "" && src != "" {
+			*dst, changed = src, true
+		}
+	}
+	fill(&merged.Email, source.Email)
+	fill(&merged.Phone, source.Phone)
+	fill(&merged.Nationality, source.Nationality)
+	if merged.DateOfBirth.IsZero() && !source.DateOfBirth.IsZero() {
+		merged.DateOfBirth, changed = source.DateOfBirth, true
+	}
+	if merged.Address.IsZero() && !source.Address.IsZero() {
+		merged.Address, changed = source.Address, true
+	}
+	if merged.DocumentType == "" && source.DocumentType != "" {
+		merged.DocumentType = source.DocumentType
+		merged.DocumentNumber = source.DocumentNumber
+		merged.DocumentCountry = source.DocumentCountry
+		merged.DocumentExpiresAt = source.DocumentExpiresAt
+		changed = true
+	}
+	return merged, changed
+}
+
+// ListDuplicateGuests возвращает отчет о возможных дубликатах со сходством имен
+func (c *Controller) ListDuplicateGuests(ctx context.Context, limit int) ([]entities.DuplicateGuests, error) {
+	switch {
+	case limit <= 0:
+		limit = defaultDuplicateGuestsPageSize
+	case limit > maxDuplicateGuestsPageSize:
+		limit = maxDuplicateGuestsPageSize
+	}
+
+	var duplicates []entities.DuplicateGuests
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		duplicates, errTx = c.ds.FindDuplicateGuests(ctx, tx, limit)
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range duplicates {
+		duplicates[i].NameSimilarity = nameSimilarity(duplicates[i].Guest.Name, duplicates[i].Duplicate.Name)
+	}
+	return duplicates, nil
+}
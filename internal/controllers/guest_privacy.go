@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/storage"
+)
+
+// ExportGuestData собирает персональные данные гостя для ответа на запрос
+// субъекта данных: профиль, бронирования и отзывы
+func (c *Controller) ExportGuestData(ctx context.Context, guestID uint64) (entities.GuestDataExport, error) {
+	res := entities.GuestDataExport{ExportedAt: time.Now().UTC()}
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		if res.Guest, errTx = c.ds.FindGuestByID(ctx, tx, guestID); errTx != nil {
+			return errTx
+		}
+		if res.Bookings, errTx = c.ds.FindBookingsByGuestIDs(ctx, tx, []uint64{guestID}); errTx != nil {
+			return errTx
+		}
+		res.Reviews, errTx = c.ds.FindReviewsByGuestID(ctx, tx, guestID)
+		return errTx
+	})
+	if err != nil {
+		return entities.GuestDataExport{}, err
+	}
+	// попутчики — чужие персональные данные, в выгрузку они не попадают
+	for i := range res.Bookings {
+		res.Bookings[i].Guests = slices.DeleteFunc(res.Bookings[i].Guests, func(g entities.Guest) bool {
+			return g.ID != guestID
+		})
+	}
+
+	return res, nil
+}
+
+// EraseGuest обезличивает гостя по запросу на удаление. Бронирования, платежи
+// и счета остаются: их нужно хранить для бухгалтерии, а гость в них теперь
+// entities.ErasedGuestName. У отзывов остаются только оценки. Пока у гостя есть
+// текущие или предстоящие бронирования, данные нужны для их исполнения, и
+// удаление отклоняется. Повторное удаление ничего не меняет
+func (c *Controller) EraseGuest(ctx context.Context, guestID uint64) (entities.Guest, error) {
+	var guest entities.Guest
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		errTx := c.ds.LockGuests(ctx, tx, []uint64{guestID})
+		if errTx != nil {
+			return errTx
+		}
+		if guest, errTx = c.ds.FindGuestByID(ctx, tx, guestID); errTx != nil {
+			return errTx
+		}
+		if !guest.ErasedAt.IsZero() {
+			return nil
+		}
+		active, errTx := c.ds.HasActiveGuestBookings(ctx, tx, guestID)
+		if errTx != nil {
+			return errTx
+		}
+		if active {
+			return entities.ErrGuestHasActiveBookings
+		}
+		guest, errTx = c.eraseGuest(ctx, tx, guestID)
+		return errTx
+	})
+	if err != nil {
+		return entities.Guest{}, err
+	}
+
+	return guest, nil
+}
+
+// EraseInactiveGuests обезличивает гостей, неактивных дольше opts.InactiveAfter,
+// по одному гостю в транзакции. Реплики не мешают друг другу: занятые гости
+// пропускаются
+func (c *Controller) EraseInactiveGuests(ctx context.Context, opts entities.GuestRetentionOptions) (int, error) {
+	erased := 0
+	for range opts.BatchSize {
+		if ctx.Err() != nil {
+			return erased, ctx.Err()
+		}
+		var done bool
+		err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+			guestID, errTx := c.ds.LockNextInactiveGuest(ctx, tx, opts.InactiveAfter)
+			if errTx != nil {
+				if errors.Is(errTx, entities.ErrNotFound) {
+					done = true
+					return nil
+				}
+				return errTx
+			}
+			if _, errTx = c.eraseGuest(ctx, tx, guestID); errTx != nil {
+				return fmt.Errorf("guest %d: %w", guestID, errTx)
+			}
+			return nil
+		})
+		if err != nil {
+			return erased, err
+		}
+		if done {
+			break
+		}
+		erased++
+	}
+	return erased, nil
+}
+
+// eraseGuest очищает профиль заблокированного гостя и текст его отзывов и
+// вычищает его данные из журнала аудита, включая события объединенных с ним
+// профилей. Само удаление журналируется без значений
+func (c *Controller) eraseGuest(ctx context.Context, tx *sql.Tx, guestID uint64) (entities.Guest, error) {
+	guest, err := c.ds.EraseGuest(ctx, tx, guestID)
+	if err != nil {
+		return entities.Guest{}, err
+	}
+	// отзывы объединенных профилей уже перенесены на guestID
+	if err = c.ds.EraseGuestReviews(ctx, tx, guestID); err != nil {
+		return entities.Guest{}, err
+	}
+	merged, err := c.ds.FindMergedGuestIDs(ctx, tx, guestID)
+	if err != nil {
+		return entities.Guest{}, err
+	}
+	if err = c.ds.RedactAuditEvents(ctx, tx, entities.AuditEntityGuest, append(merged, guestID)); err != nil {
+		return entities.Guest{}, err
+	}
+	if err = c.audit(ctx, tx, entities.AuditEntityGuest, guestID, 0, entities.AuditActionErase, nil, nil); err != nil {
+		return entities.Guest{}, err
+	}
+	return guest, nil
+}
@@ -0,0 +1,21 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"booking-service/internal/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEraseGuestErasesReviewText(t *testing.T) {
+	store := newFakeDS()
+	c := newTestController(t, store, &fakePayments{})
+
+	guest, err := c.EraseGuest(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, entities.ErasedGuestName, guest.Name)
+	assert.Equal(t, []string{"EraseGuest", "EraseGuestReviews", "RedactAuditEvents"}, store.calls)
+}
@@ -8,17 +8,52 @@ import (
 	"booking-service/internal/storage"
 )
 
-func (c *Controller) CreateHotel(ctx context.Context, hotelName string) (res entities.Hotel, err error) {
+// политика отмены по умолчанию: бесплатно за сутки до заезда, позже — первая ночь
+const (
+	defaultFreeCancellationHours     = 24
+	defaultCancellationPenaltyNights = 1
+)
+
+// CreateHotel создает отель; без валюты отель получает базовую валюту сервиса
+func (c *Controller) CreateHotel(ctx context.Context, input entities.CreateHotelDTO) (res entities.Hotel, err error) {
+	if input.Currency == "" {
+		input.Currency = c.baseCurrency
+	}
+	if !entities.IsValidCurrency(input.Currency) {
+		return res, entities.ErrInvalidCurrency
+	}
+	hotel := entities.Hotel{
+		Name:                      input.Name,
+		Currency:                  input.Currency,
+		FreeCancellationHours:     defaultFreeCancellationHours,
+		CancellationPenaltyNights: defaultCancellationPenaltyNights,
+	}
+	if p := input.CancellationPolicy; p != nil {
+		if p.FreeCancellationHours < 0 || p.CancellationPenaltyNights < 0 {
+			return res, entities.ErrInvalidCancellation
+		}
+		hotel.FreeCancellationHours, hotel.CancellationPenaltyNights = p.FreeCancellationHours, p.CancellationPenaltyNights
+	}
+
 	if err = storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
-		if res, errTx = c.ds.SaveHotel(ctx, tx, entities.Hotel{
-			Name: hotelName,
-		}); errTx != nil {
+		if res, errTx = c.ds.SaveHotel(ctx, tx, hotel); errTx != nil {
 			return errTx
 		}
-		return nil
+		return c.audit(ctx, tx, entities.AuditEntityHotel, res.ID, res.ID, entities.AuditActionCreate, nil, res)
 	}); err != nil {
 		return res, err
 	}
 
 	return res, nil
 }
+
+// checkHotelCurrency проверяет, что цены заданы в валюте отеля; нулевая сумма
+// означает, что цена не задана
+func checkHotelCurrency(hotel entities.Hotel, prices ...entities.Money) error {
+	for _, p := range prices {
+		if p.Amount != 0 && p.Currency != hotel.Currency {
+			return entities.ErrHotelCurrencyMismatch
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+
+	"booking-service/internal/storage"
+)
+
+// HotelIDByRoomID возвращает отель номера; используется проверкой прав доступа
+func (c *Controller) HotelIDByRoomID(ctx context.Context, roomID uint64) (uint64, error) {
+	var hotelID uint64
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		room, errTx := c.ds.FindRoomById(ctx, tx, int64(roomID))
+		if errTx != nil {
+			return errTx
+		}
+		hotelID = room.HotelID
+		return nil
+	})
+	return hotelID, err
+}
+
+func (c *Controller) HotelIDByBookingID(ctx context.Context, bookingID uint64) (uint64, error) {
+	var hotelID uint64
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		hotelID, errTx = c.ds.FindHotelIDByBookingID(ctx, tx, bookingID)
+		return errTx
+	})
+	return hotelID, err
+}
+
+func (c *Controller) HotelIDByEmployeeID(ctx context.Context, employeeID uint64) (uint64, error) {
+	employee, err := c.GetEmployee(ctx, employeeID)
+	if err != nil {
+		return 0, err
+	}
+	return employee.HotelID, nil
+}
@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/invoice"
+	"booking-service/internal/storage"
+)
+
+// IssueInvoice выставляет счет по бронированию после выезда гостя. На
+// бронирование выставляется один счет; номер выдается в той же транзакции,
+// поэтому неудачная попытка не оставляет пропуска в нумерации
+func (c *Controller) IssueInvoice(ctx context.Context, input entities.IssueInvoiceDTO) (entities.Invoice, error) {
+	var inv entities.Invoice
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		errTx := c.ds.LockBooking(ctx, tx, input.BookingID)
+		if errTx != nil {
+			return errTx
+		}
+		booking, errTx := c.ds.FindBookingById(ctx, tx, input.BookingID)
+		if errTx != nil {
+			return errTx
+		}
+		if booking.Status == entities.BookingStatusCancelled {
+			return entities.ErrBookingCancelled
+		}
+		folio, errTx := c.ds.FindFolioByBookingID(ctx, tx, booking.ID)
+		switch {
+		case errors.Is(errTx, entities.ErrNotFound):
+			return entities.ErrFolioNotClosed
+		case errTx != nil:
+			return errTx
+		case folio.Status != entities.FolioStatusClosed:
+			return entities.ErrFolioNotClosed
+		}
+		if folio, errTx = c.loadFolio(ctx, tx, folio); errTx != nil {
+			return errTx
+		}
+		payments, errTx := c.ds.FindPaymentsByBookingID(ctx, tx, booking.ID)
+		if errTx != nil {
+			return errTx
+		}
+		hotel, errTx := c.bookingHotel(ctx, tx, booking.ID)
+		if errTx != nil {
+			return errTx
+		}
+
+		inv = invoice.Build(hotel, booking, folio, payments, input.Buyer)
+		inv.IssuedAt = time.Now().UTC().Truncate(time.Second)
+		inv.FiscalYear = invoice.FiscalYear(inv.IssuedAt)
+		if inv.SequenceNumber, errTx = c.ds.NextInvoiceNumber(ctx, tx, hotel.ID, inv.FiscalYear); errTx != nil {
+			return errTx
+		}
+		inv.Number = invoice.Number(hotel.ID, inv.FiscalYear, inv.SequenceNumber)
+
+		pdf, errTx := c.invoices.PDF(inv)
+		if errTx != nil {
+			return errTx
+		}
+		if inv, errTx = c.ds.SaveInvoice(ctx, tx, inv, pdf); errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityInvoice, inv.ID, hotel.ID, entities.AuditActionCreate, nil, inv)
+	})
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+
+	return inv, nil
+}
+
+func (c *Controller) GetInvoice(ctx context.Context, invoiceID uint64) (entities.Invoice, error) {
+	var inv entities.Invoice
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		inv, errTx = c.ds.FindInvoiceByID(ctx, tx, invoiceID)
+		return errTx
+	})
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+
+	return inv, nil
+}
+
+// DownloadInvoice возвращает счет в том виде, в котором он был выставлен
+func (c *Controller) DownloadInvoice(
+	ctx context.Context, invoiceID uint64, format entities.InvoiceFormat,
+) (entities.InvoiceFile, error) {
+	var file entities.InvoiceFile
+	switch format {
+	case entities.InvoiceFormatPDF:
+		file.ContentType = "application/pdf"
+	case entities.InvoiceFormatJSON:
+		file.ContentType = "application/json"
+	default:
+		return entities.InvoiceFile{}, entities.ErrInvalidInvoiceFormat
+	}
+
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		number, data, errTx := c.ds.FindInvoiceFile(ctx, tx, invoiceID, format)
+		if errTx != nil {
+			return errTx
+		}
+		file.FileName, file.Data = number+"."+string(format), data
+		return nil
+	})
+	if err != nil {
+		return entities.InvoiceFile{}, err
+	}
+
+	return file, nil
+}
+
+// HotelIDByInvoiceID используется проверкой прав доступа
+func (c *Controller) HotelIDByInvoiceID(ctx context.Context, invoiceID uint64) (uint64, error) {
+	inv, err := c.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return 0, err
+	}
+	return inv.HotelID, nil
+}
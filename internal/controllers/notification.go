@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"booking-service/internal/storage"
+)
+
+// DispatchNotifications отправляет до limit уведомлений из outbox в сервис
+// уведомлений. Неотправленное уведомление остается в outbox и уходит при
+// следующем проходе; порядок уведомлений сохраняется только в пределах прохода
+func (c *Controller) DispatchNotifications(ctx context.Context, limit int) (int, error) {
+	if c.notifier == nil {
+		return 0, nil
+	}
+
+	var (
+		sent int
+		errs []error
+	)
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		pending, errTx := c.ds.LockPendingNotifications(ctx, tx, limit)
+		if errTx != nil {
+			return errTx
+		}
+		for _, n := range pending {
+			if errPub := c.notifier.Publish(ctx, n); errPub != nil {
+				errs = append(errs, fmt.Errorf("notification %d: %w", n.ID, errPub))
+				if errTx = c.ds.MarkNotificationFailed(ctx, tx, n.ID, errPub.Error()); errTx != nil {
+					return errTx
+				}
+				continue
+			}
+			if errTx = c.ds.MarkNotificationSent(ctx, tx, n.ID); errTx != nil {
+				return errTx
+			}
+			sent++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return sent, errors.Join(errs...)
+}
@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/pricing"
+	"booking-service/internal/storage"
+)
+
+// SetPricingRule создает или заменяет правило ценообразования типа номера
+func (c *Controller) SetPricingRule(ctx context.Context, rule entities.PricingRule) (entities.PricingRule, error) {
+	if err := pricing.ValidateRule(rule); err != nil {
+		return entities.PricingRule{}, err
+	}
+
+	var res entities.PricingRule
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		hotel, errTx := c.ds.FindHotelByID(ctx, tx, rule.HotelID)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = checkHotelCurrency(hotel, rule.MinPrice, rule.MaxPrice); errTx != nil {
+			return errTx
+		}
+
+		var before any
+		current, errTx := c.ds.FindPricingRule(ctx, tx, rule.HotelID, rule.RoomType)
+		switch {
+		case errTx == nil:
+			before = current
+		case !errors.Is(errTx, entities.ErrNotFound):
+			return errTx
+		}
+
+		if res, errTx = c.ds.SavePricingRule(ctx, tx, rule); errTx != nil {
+			return errTx
+		}
+		action := entities.AuditActionUpdate
+		if before == nil {
+			action = entities.AuditActionCreate
+		}
+		return c.audit(ctx, tx, entities.AuditEntityPricingRule, res.HotelID, res.HotelID, action, before, res)
+	})
+	if err != nil {
+		return entities.PricingRule{}, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) ListPricingRules(ctx context.Context, hotelID uint64) ([]entities.PricingRule, error) {
+	var rules []entities.PricingRule
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		rules, errTx = c.ds.FindPricingRulesByHotelID(ctx, tx, hotelID)
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// DeletePricingRule удаляет правило: цены типа номера снова равны базовым
+func (c *Controller) DeletePricingRule(ctx context.Context, hotelID uint64, roomType string) error {
+	if roomType == "" {
+		return entities.ErrInvalidRoomType
+	}
+	return storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		before, errTx := c.ds.FindPricingRule(ctx, tx, hotelID, roomType)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = c.ds.DeletePricingRule(ctx, tx, hotelID, roomType); errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityPricingRule, hotelID, hotelID,
+			entities.AuditActionDelete, before, nil)
+	})
+}
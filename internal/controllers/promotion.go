@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/pricing"
+	"booking-service/internal/storage"
+)
+
+// CreatePromotion создает промокод; без отеля промокод действует во всех отелях
+func (c *Controller) CreatePromotion(ctx context.Context, input entities.PromotionDTO) (entities.Promotion, error) {
+	promotion := promotionFromDTO(input)
+	if err := pricing.ValidatePromotion(promotion); err != nil {
+		return entities.Promotion{}, err
+	}
+
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		if errTx = c.checkPromotionHotel(ctx, tx, promotion); errTx != nil {
+			return errTx
+		}
+		if promotion, errTx = c.ds.SavePromotion(ctx, tx, promotion); errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityPromotion, promotion.ID, promotion.HotelID,
+			entities.AuditActionCreate, nil, promotion)
+	})
+	if err != nil {
+		return entities.Promotion{}, err
+	}
+
+	return promotion, nil
+}
+
+// ListPromotions возвращает промокоды отеля и промокоды всех отелей
+func (c *Controller) ListPromotions(ctx context.Context, hotelID uint64) ([]entities.Promotion, error) {
+	var promotions []entities.Promotion
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		promotions, errTx = c.ds.FindPromotions(ctx, tx, hotelID)
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return promotions, nil
+}
+
+// UpdatePromotion меняет условия промокода; отель и счетчик использований не
+// меняются, уже созданные бронирования сохраняют свою скидку
+func (c *Controller) UpdatePromotion(
+	ctx context.Context, promotionID uint64, input entities.PromotionDTO,
+) (entities.Promotion, error) {
+	var promotion entities.Promotion
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		before, errTx := c.ds.FindPromotionByID(ctx, tx, promotionID)
+		if errTx != nil {
+			return errTx
+		}
+		input.HotelID = before.HotelID
+		promotion = promotionFromDTO(input)
+		promotion.ID, promotion.Redemptions = before.ID, before.Redemptions
+		if errTx = pricing.ValidatePromotion(promotion); errTx != nil {
+			return errTx
+		}
+		if errTx = c.checkPromotionHotel(ctx, tx, promotion); errTx != nil {
+			return errTx
+		}
+
+		if promotion, errTx = c.ds.UpdatePromotion(ctx, tx, promotion); errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityPromotion, promotion.ID, promotion.HotelID,
+			entities.AuditActionUpdate, before, promotion)
+	})
+	if err != nil {
+		return entities.Promotion{}, err
+	}
+
+	return promotion, nil
+}
+
+func (c *Controller) DeletePromotion(ctx context.Context, promotionID uint64) error {
+	return storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		before, errTx := c.ds.FindPromotionByID(ctx, tx, promotionID)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = c.ds.DeletePromotion(ctx, tx, promotionID); errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityPromotion, before.ID, before.HotelID,
+			entities.AuditActionDelete, before, nil)
+	})
+}
+
+// HotelIDByPromotionID используется проверкой прав доступа; для промокода
+// всех отелей возвращает 0
+func (c *Controller) HotelIDByPromotionID(ctx context.Context, promotionID uint64) (uint64, error) {
+	var hotelID uint64
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		promotion, errTx := c.ds.FindPromotionByID(ctx, tx, promotionID)
+		if errTx != nil {
+			return errTx
+		}
+		hotelID = promotion.HotelID
+		return nil
+	})
+	return hotelID, err
+}
+
+// checkPromotionHotel проверяет отель промокода и валюту фиксированной скидки.
+// У промокода всех отелей валюта не проверяется: в отелях с другой валютой он
+// не применится
+func (c *Controller) checkPromotionHotel(ctx context.Context, tx *sql.Tx, promotion entities.Promotion) error {
+	if promotion.HotelID == 0 {
+		return nil
+	}
+	hotel, err := c.ds.FindHotelByID(ctx, tx, promotion.HotelID)
+	if err != nil {
+		return err
+	}
+	return checkHotelCurrency(hotel, promotion.AmountOff)
+}
+
+// findPromotion находит промокод и проверяет, что он действует для проживания
+// и у него остались использования
+func (c *Controller) findPromotion(
+	ctx context.Context, tx *sql.Tx, code string, s stay, bookedAt time.Time,
+) (*entities.Promotion, error) {
+	promotion, err := c.ds.FindPromotionByCode(ctx, tx, pricing.NormalizePromoCode(code))
+	if err != nil {
+		if errors.Is(err, entities.ErrNotFound) {
+			return nil, entities.ErrPromoCodeNotFound
+		}
+		return nil, err
+	}
+	if err = pricing.CheckPromotion(promotion, s.hotelID, s.roomType, s.start, s.end, bookedAt); err != nil {
+		return nil, err
+	}
+	if promotion.MaxRedemptions > 0 && promotion.Redemptions >= promotion.MaxRedemptions {
+		return nil, entities.ErrPromoCodeExhausted
+	}
+	return &promotion, nil
+}
+
+func promotionFromDTO(input entities.PromotionDTO) entities.Promotion {
+	p := entities.Promotion{
+		Code:           pricing.NormalizePromoCode(input.Code),
+		HotelID:        input.HotelID,
+		RoomTypes:      input.RoomTypes,
+		DiscountType:   input.DiscountType,
+		StayFrom:       input.StayFrom,
+		StayTo:         input.StayTo,
+		MinNights:      input.MinNights,
+		BookFrom:       input.BookFrom,
+		BookTo:         input.BookTo,
+		MaxRedemptions: input.MaxRedemptions,
+	}
+	if p.RoomTypes == nil {
+		p.RoomTypes = []string{}
+	}
+	if !p.StayFrom.IsZero() {
+		p.StayFrom = pricing.Date(p.StayFrom)
+	}
+	if !p.StayTo.IsZero() {
+		p.StayTo = pricing.Date(p.StayTo)
+	}
+	// хранится только параметр своего типа скидки
+	if p.DiscountType == entities.DiscountTypeFixed {
+		p.AmountOff = input.AmountOff
+	} else {
+		p.PercentOff = input.PercentOff
+	}
+	return p
+}
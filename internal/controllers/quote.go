@@ -0,0 +1,111 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/pricing"
+	"booking-service/internal/storage"
+)
+
+// QuoteStay считает стоимость проживания в номере заданного типа. Предложение
+// не резервирует номер: при бронировании доступность проверяется заново
+func (c *Controller) QuoteStay(ctx context.Context, input entities.QuoteDTO) (entities.Quote, error) {
+	if input.RoomType == "" {
+		return entities.Quote{}, entities.ErrInvalidRoomType
+	}
+	if input.Guests < 1 {
+		return entities.Quote{}, entities.ErrInvalidGuestCount
+	}
+	if input.TaxExemptGuests < 0 || input.TaxExemptGuests > input.Guests {
+		return entities.Quote{}, entities.ErrInvalidTaxExemptGuests
+	}
+	if err := validateStay(input.StartDate, input.EndDate, time.Time{}); err != nil {
+		return entities.Quote{}, err
+	}
+	start, end := pricing.Date(input.StartDate), pricing.Date(input.EndDate)
+	if input.DisplayCurrency != "" && !entities.IsValidCurrency(input.DisplayCurrency) {
+		return entities.Quote{}, entities.ErrInvalidCurrency
+	}
+	var quote entities.Quote
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		hotel, errTx := c.ds.FindHotelByID(ctx, tx, input.HotelID)
+		if errTx != nil {
+			return errTx
+		}
+
+		available, errTx := c.ds.HasAvailableRoom(ctx, tx, hotel.ID, input.RoomType, start, end)
+		if errTx != nil {
+			return errTx
+		}
+		if !available {
+			return entities.ErrRoomNotAvailable
+		}
+
+		s := stay{
+			hotelID:   hotel.ID,
+			roomType:  input.RoomType,
+			start:     start,
+			end:       end,
+			guests:    input.Guests,
+			taxExempt: input.TaxExemptGuests,
+		}
+		if input.PromoCode != "" {
+			if s.promotion, errTx = c.findPromotion(ctx, tx, input.PromoCode, s, time.Now()); errTx != nil {
+				return errTx
+			}
+		}
+		price, errTx := c.priceStay(ctx, tx, s)
+		if errTx != nil {
+			return errTx
+		}
+
+		quote = entities.Quote{
+			HotelID:         hotel.ID,
+			RoomType:        input.RoomType,
+			StartDate:       start,
+			EndDate:         end,
+			Guests:          input.Guests,
+			TaxExemptGuests: input.TaxExemptGuests,
+			PromoCode:       pricing.NormalizePromoCode(input.PromoCode),
+			Price:           price,
+			Cancellation:    pricing.Cancellation(hotel, price, start),
+		}
+		// цена в валюте гостя только для показа, бронирование оплачивается в валюте отеля
+		if input.DisplayCurrency != "" && input.DisplayCurrency != price.Total.Currency {
+			if quote.Display, errTx = c.convertPrice(ctx, tx, price, input.DisplayCurrency); errTx != nil {
+				return errTx
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return entities.Quote{}, err
+	}
+
+	return quote, nil
+}
+
+// checkQuote проверяет, что предложение выдано на этот номер, даты, состав
+// гостей и промокод; без промокода в запросе действует промокод предложения
+func checkQuote(quote entities.Quote, room entities.Room, input entities.CreateBookingDTO) error {
+	if quote.HotelID != room.HotelID || quote.RoomType != room.Type ||
+		!quote.StartDate.Equal(pricing.Date(input.StartDate)) || !quote.EndDate.Equal(pricing.Date(input.EndDate)) ||
+		quote.Guests != len(input.Guests) || quote.TaxExemptGuests != taxExemptGuests(input.Guests) ||
+		input.PromoCode != "" && pricing.NormalizePromoCode(input.PromoCode) != quote.PromoCode {
+		return entities.ErrQuoteMismatch
+	}
+	return nil
+}
+
+func taxExemptGuests(guests []entities.GuestDTO) int {
+	n := 0
+	for _, g := range guests {
+		if g.TaxExempt {
+			n++
+		}
+	}
+	return n
+}
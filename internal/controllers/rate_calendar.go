@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/pricing"
+	"booking-service/internal/storage"
+)
+
+// maxRateRangeNights ограничивает диапазон одного запроса: два года
+const maxRateRangeNights = 731
+
+// SetRates применяет диапазоны по порядку: более поздний диапазон перекрывает ранний
+func (c *Controller) SetRates(ctx context.Context, input entities.SetRatesDTO) error {
+	if len(input.Ranges) == 0 {
+		return entities.ErrEmptyRates
+	}
+	for _, r := range input.Ranges {
+		if err := validateRateRange(r); err != nil {
+			return err
+		}
+	}
+
+	return storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		hotel, errTx := c.ds.FindHotelByID(ctx, tx, input.HotelID)
+		if errTx != nil {
+			return errTx
+		}
+		for _, r := range input.Ranges {
+			if errTx = checkHotelCurrency(hotel, r.Price); errTx != nil {
+				return errTx
+			}
+		}
+		for _, r := range input.Ranges {
+			dates := pricing.ExpandRange(r)
+			if len(dates) == 0 {
+				continue
+			}
+
+			if r.Price.Amount == 0 {
+				errTx = c.ds.DeleteRateOverrides(ctx, tx, input.HotelID, r.RoomType, dates)
+			} else {
+				errTx = c.ds.SaveRateOverrides(ctx, tx, input.HotelID, r.RoomType, dates, r.Price)
+			}
+			if errTx != nil {
+				return errTx
+			}
+
+			r.StartDate, r.EndDate = pricing.Date(r.StartDate), pricing.Date(r.EndDate)
+			errTx = c.audit(ctx, tx, entities.AuditEntityRateCalendar, input.HotelID, input.HotelID,
+				entities.AuditActionUpdate, nil, r)
+			if errTx != nil {
+				return errTx
+			}
+		}
+		return nil
+	})
+}
+
+// GetRateCalendar возвращает действующую цену каждой ночи [start, end)
+func (c *Controller) GetRateCalendar(
+	ctx context.Context, hotelID uint64, roomType string, start, end time.Time,
+) ([]entities.CalendarNight, error) {
+	if roomType == "" {
+		return nil, entities.ErrInvalidRoomType
+	}
+	if !pricing.Date(start).Before(pricing.Date(end)) {
+		return nil, entities.ErrStartDateIsAfterEndDate
+	}
+	if len(pricing.Nights(start, end)) > maxRateRangeNights {
+		return nil, entities.ErrRateRangeTooLong
+	}
+
+	var nights []entities.CalendarNight
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		rates, errTx := c.findRates(ctx, tx, hotelID, roomType, start, end)
+		if errTx != nil {
+			return errTx
+		}
+		nights, errTx = pricing.Calendar(rates, start, end)
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return nights, nil
+}
+
+// findRates загружает тарифы, календарь и правило ценообразования типа номера;
+// загрузка считается только при наличии правила
+func (c *Controller) findRates(
+	ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string, start, end time.Time,
+) (pricing.Rates, error) {
+	start, end = pricing.Date(start), pricing.Date(end)
+
+	var (
+		rates pricing.Rates
+		err   error
+	)
+	if rates.Plans, err = c.ds.FindRatePlansForStay(ctx, tx, hotelID, roomType, start, end); err != nil {
+		return pricing.Rates{}, err
+	}
+	if rates.Overrides, err = c.ds.FindRateOverrides(ctx, tx, hotelID, roomType, start, end); err != nil {
+		return pricing.Rates{}, err
+	}
+
+	rule, err := c.ds.FindPricingRule(ctx, tx, hotelID, roomType)
+	switch {
+	case errors.Is(err, entities.ErrNotFound):
+		return rates, nil
+	case err != nil:
+		return pricing.Rates{}, err
+	}
+	rates.Rule = &rule
+	if rates.Occupancy, err = c.ds.FindOccupancy(ctx, tx, hotelID, roomType, start, end); err != nil {
+		return pricing.Rates{}, err
+	}
+	return rates, nil
+}
+
+func validateRateRange(r entities.RateRange) error {
+	if r.RoomType == "" {
+		return entities.ErrInvalidRoomType
+	}
+	if pricing.Date(r.StartDate).After(pricing.Date(r.EndDate)) {
+		return entities.ErrStartDateIsAfterEndDate
+	}
+	// EndDate входит в диапазон, поэтому ночей на одну больше, чем в Nights
+	if len(pricing.Nights(r.StartDate, r.EndDate))+1 > maxRateRangeNights {
+		return entities.ErrRateRangeTooLong
+	}
+	for _, w := range r.Weekdays {
+		if w < time.Sunday || w > time.Saturday {
+			return entities.ErrInvalidWeekday
+		}
+	}
+	if r.Price.Amount < 0 {
+		return entities.ErrInvalidPrice
+	}
+	if r.Price.Amount > 0 && !entities.IsValidCurrency(r.Price.Currency) {
+		return entities.ErrInvalidCurrency
+	}
+	return nil
+}
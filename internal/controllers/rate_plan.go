@@ -0,0 +1,217 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/pricing"
+	"booking-service/internal/storage"
+)
+
+func (c *Controller) CreateRatePlan(ctx context.Context, input entities.RatePlanDTO) (entities.RatePlan, error) {
+	if err := validateRatePlan(input); err != nil {
+		return entities.RatePlan{}, err
+	}
+
+	var plan entities.RatePlan
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		hotel, errTx := c.ds.FindHotelByID(ctx, tx, input.HotelID)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = checkHotelCurrency(hotel, input.NightlyPrice); errTx != nil {
+			return errTx
+		}
+		plan, errTx = c.ds.SaveRatePlan(ctx, tx, ratePlanFromDTO(input))
+		if errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityRatePlan, plan.ID, plan.HotelID, entities.AuditActionCreate, nil, plan)
+	})
+	if err != nil {
+		return entities.RatePlan{}, err
+	}
+
+	return plan, nil
+}
+
+func (c *Controller) ListRatePlans(ctx context.Context, hotelID uint64) ([]entities.RatePlan, error) {
+	var plans []entities.RatePlan
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		plans, errTx = c.ds.FindRatePlansByHotelID(ctx, tx, hotelID)
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+// UpdateRatePlan меняет тариф; отель тарифа не меняется, уже созданные
+// бронирования сохраняют свою цену
+func (c *Controller) UpdateRatePlan(ctx context.Context, ratePlanID uint64, input entities.RatePlanDTO) (entities.RatePlan, error) {
+	var plan entities.RatePlan
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		before, errTx := c.ds.FindRatePlanByID(ctx, tx, ratePlanID)
+		if errTx != nil {
+			return errTx
+		}
+		input.HotelID = before.HotelID
+		if errTx = validateRatePlan(input); errTx != nil {
+			return errTx
+		}
+		hotel, errTx := c.ds.FindHotelByID(ctx, tx, before.HotelID)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = checkHotelCurrency(hotel, input.NightlyPrice); errTx != nil {
+			return errTx
+		}
+
+		plan = ratePlanFromDTO(input)
+		plan.ID = ratePlanID
+		plan, errTx = c.ds.UpdateRatePlan(ctx, tx, plan)
+		if errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityRatePlan, plan.ID, plan.HotelID, entities.AuditActionUpdate, before, plan)
+	})
+	if err != nil {
+		return entities.RatePlan{}, err
+	}
+
+	return plan, nil
+}
+
+func (c *Controller) DeleteRatePlan(ctx context.Context, ratePlanID uint64) error {
+	return storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		before, errTx := c.ds.FindRatePlanByID(ctx, tx, ratePlanID)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = c.ds.DeleteRatePlan(ctx, tx, ratePlanID); errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityRatePlan, before.ID, before.HotelID, entities.AuditActionDelete, before, nil)
+	})
+}
+
+// HotelIDByRatePlanID используется проверкой прав доступа
+func (c *Controller) HotelIDByRatePlanID(ctx context.Context, ratePlanID uint64) (uint64, error) {
+	var hotelID uint64
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		plan, errTx := c.ds.FindRatePlanByID(ctx, tx, ratePlanID)
+		if errTx != nil {
+			return errTx
+		}
+		hotelID = plan.HotelID
+		return nil
+	})
+	return hotelID, err
+}
+
+// stay параметры расчета цены проживания
+type stay struct {
+	hotelID           uint64
+	roomType          string
+	start, end        time.Time
+	guests, taxExempt int
+	// promotion проверенный промокод; nil — без скидки
+	promotion *entities.Promotion
+}
+
+// validateStay проверяет даты проживания до расчета цены. Цена считается по
+// ночам, поэтому длина проживания ограничена. fixedStart — дата заезда
+// изменяемого бронирования: начавшееся проживание можно продлить, не перенося
+// заезд; у нового бронирования она нулевая
+func validateStay(start, end, fixedStart time.Time) error {
+	start, end = pricing.Date(start), pricing.Date(end)
+	if start.After(end) {
+		return entities.ErrStartDateIsAfterEndDate
+	}
+	if start.Before(pricing.Date(time.Now())) && !start.Equal(pricing.Date(fixedStart)) {
+		return entities.ErrStartDateInPast
+	}
+	if len(pricing.Nights(start, end)) > maxRateRangeNights {
+		return entities.ErrRateRangeTooLong
+	}
+	return nil
+}
+
+// priceStayWithTerms считает цену проживания с налогами и условия отмены по
+// политике отеля
+func (c *Controller) priceStayWithTerms(
+	ctx context.Context, tx *sql.Tx, s stay,
+) (entities.PriceSnapshot, entities.CancellationTerms, error) {
+	hotel, err := c.ds.FindHotelByID(ctx, tx, s.hotelID)
+	if err != nil {
+		return entities.PriceSnapshot{}, entities.CancellationTerms{}, err
+	}
+	price, err := c.priceStay(ctx, tx, s)
+	if err != nil {
+		return entities.PriceSnapshot{}, entities.CancellationTerms{}, err
+	}
+	return price, pricing.Cancellation(hotel, price, s.start), nil
+}
+
+// priceStay считает цену ночей по календарю цен, применяет промокод и
+// начисляет налоги и сборы отеля
+func (c *Controller) priceStay(ctx context.Context, tx *sql.Tx, s stay) (entities.PriceSnapshot, error) {
+	rates, err := c.findRates(ctx, tx, s.hotelID, s.roomType, s.start, s.end)
+	if err != nil {
+		return entities.PriceSnapshot{}, err
+	}
+	price, err := pricing.PriceStay(rates, s.start, s.end)
+	if err != nil {
+		return entities.PriceSnapshot{}, err
+	}
+	if s.promotion != nil {
+		if price, err = pricing.ApplyPromotion(price, *s.promotion); err != nil {
+			return entities.PriceSnapshot{}, err
+		}
+	}
+	taxes, err := c.ds.FindTaxRulesForStay(ctx, tx, s.hotelID, pricing.Date(s.start), pricing.Date(s.end))
+	if err != nil {
+		return entities.PriceSnapshot{}, err
+	}
+	return pricing.ApplyTaxes(price, taxes, s.guests, s.taxExempt)
+}
+
+func ratePlanFromDTO(input entities.RatePlanDTO) entities.RatePlan {
+	plan := entities.RatePlan{
+		HotelID:      input.HotelID,
+		RoomType:     input.RoomType,
+		Name:         input.Name,
+		NightlyPrice: input.NightlyPrice,
+		ValidFrom:    pricing.Date(input.ValidFrom),
+	}
+	if !input.ValidTo.IsZero() {
+		plan.ValidTo = pricing.Date(input.ValidTo)
+	}
+	return plan
+}
+
+func validateRatePlan(input entities.RatePlanDTO) error {
+	if input.Name == "" {
+		return entities.ErrNameIsRequired
+	}
+	if len([]rune(input.Name)) > 255 {
+		return entities.ErrNameIsTooLong
+	}
+	if input.RoomType == "" {
+		return entities.ErrInvalidRoomType
+	}
+	if input.NightlyPrice.Amount <= 0 {
+		return entities.ErrInvalidPrice
+	}
+	if !entities.IsValidCurrency(input.NightlyPrice.Currency) {
+		return entities.ErrInvalidCurrency
+	}
+	if !input.ValidTo.IsZero() && pricing.Date(input.ValidFrom).After(pricing.Date(input.ValidTo)) {
+		return entities.ErrInvalidValidityPeriod
+	}
+	return nil
+}
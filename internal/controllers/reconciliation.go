@@ -0,0 +1,223 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/invoice"
+	"booking-service/internal/storage"
+)
+
+const (
+	reconciliationJob = "payment_reconciliation"
+
+	defaultReconciliationPageSize = 100
+	maxReconciliationPageSize     = 1000
+)
+
+// ReconcilePayments выполняет проход сверки бронирований с платежным сервисом.
+// Проход выполняет реплика, взявшая аренду задачи на leaseTTL; остальные сразу
+// возвращают 0. Ошибки отдельных бронирований не прерывают проход и
+// возвращаются вместе с числом сверенных бронирований
+func (c *Controller) ReconcilePayments(
+	ctx context.Context, holder string, leaseTTL time.Duration, opts entities.ReconciliationOptions,
+) (int, error) {
+	var ids []uint64
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		acquired, errTx := c.ds.AcquireJobLease(ctx, tx, reconciliationJob, holder, leaseTTL)
+		if errTx != nil || !acquired {
+			return errTx
+		}
+		ids, errTx = c.ds.FindBookingsToReconcile(ctx, tx, opts)
+		return errTx
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		reconciled int
+		errs       []error
+	)
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+		if err = c.reconcileBooking(ctx, id, opts); err != nil {
+			errs = append(errs, fmt.Errorf("booking %d: %w", id, err))
+			continue
+		}
+		reconciled++
+	}
+	return reconciled, errors.Join(errs...)
+}
+
+// reconcileBooking сверяет одно бронирование. Строка бронирования заблокирована,
+// как в PayBooking, поэтому оплата не пройдет между запросом к платежному
+// сервису и записью состояния
+func (c *Controller) reconcileBooking(ctx context.Context, bookingID uint64, opts entities.ReconciliationOptions) error {
+	return storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		errTx := c.ds.LockBooking(ctx, tx, bookingID)
+		if errTx != nil {
+			return errTx
+		}
+		booking, errTx := c.ds.FindBookingById(ctx, tx, bookingID)
+		if errTx != nil {
+			return errTx
+		}
+		hotelID, errTx := c.ds.FindHotelIDByBookingID(ctx, tx, bookingID)
+		if errTx != nil {
+			return errTx
+		}
+		external, errTx := c.payments.Payments(ctx, bookingID, booking.Price.Total.Currency)
+		if errTx != nil {
+			return errTx
+		}
+		local, errTx := c.ds.FindPaymentsByBookingID(ctx, tx, bookingID)
+		if errTx != nil {
+			return errTx
+		}
+
+		rec := reconcile(booking, external, local)
+		if booking.PaymentStatus != rec.status || booking.AmountPaid != rec.stayPaid {
+			before := booking
+			booking.PaymentStatus = rec.status
+			booking.AmountPaid = rec.stayPaid
+			booking.IsPaid = rec.status == entities.BookingPaymentStatusPaid
+			if booking, errTx = c.ds.SaveBooking(ctx, tx, booking); errTx != nil {
+				return errTx
+			}
+			if errTx = c.audit(ctx, tx, entities.AuditEntityBooking, booking.ID, hotelID,
+				entities.AuditActionUpdate, before, booking); errTx != nil {
+				return errTx
+			}
+		}
+
+		issues := rec.issues(booking, time.Now().UTC(), opts.PaymentDueBefore)
+		if errTx = c.ds.SyncReconciliationIssues(ctx, tx, booking.ID, hotelID, issues); errTx != nil {
+			return errTx
+		}
+		return c.ds.MarkBookingReconciled(ctx, tx, booking.ID)
+	})
+}
+
+// reconciliation итоги платежей бронирования: суммы в валюте бронирования
+type reconciliation struct {
+	status entities.BookingPaymentStatus
+	// stayPaid списано за проживание: списания платежного сервиса без оплат счета за услуги
+	stayPaid entities.Money
+	// charged списано платежным сервисом, recorded — сохранено в payments
+	charged, recorded entities.Money
+	// foreign платежи в другой валюте, которые не удалось сопоставить
+	foreign int
+}
+
+func reconcile(booking entities.Booking, external, local []entities.Payment) reconciliation {
+	currency := booking.Price.Total.Currency
+	rec := reconciliation{
+		charged:  entities.Money{Currency: currency},
+		recorded: entities.Money{Currency: currency},
+	}
+
+	refunded := false
+	for _, p := range external {
+		switch {
+		case p.Amount.Currency != currency:
+			rec.foreign++
+		case p.Status == entities.PaymentStatusSuccess:
+			rec.charged.Amount += p.Amount.Amount
+		case p.Status == entities.PaymentStatusCanceled:
+			refunded = true
+		}
+	}
+	folioPaid := int64(0)
+	for _, p := range local {
+		if p.Status != entities.PaymentStatusSuccess || p.Amount.Currency != currency {
+			continue
+		}
+		rec.recorded.Amount += p.Amount.Amount
+		if p.FolioID != 0 {
+			folioPaid += p.Amount.Amount
+		}
+	}
+
+	rec.stayPaid = entities.Money{Amount: max(rec.charged.Amount-folioPaid, 0), Currency: currency}
+	switch {
+	case rec.stayPaid.Amount > 0 && rec.stayPaid.Amount >= booking.Price.Total.Amount:
+		rec.status = entities.BookingPaymentStatusPaid
+	case rec.stayPaid.Amount > 0:
+		rec.status = entities.BookingPaymentStatusPartiallyPaid
+	case refunded:
+		rec.status = entities.BookingPaymentStatusRefunded
+	default:
+		rec.status = entities.BookingPaymentStatusUnpaid
+	}
+	return rec
+}
+
+// issues расхождения бронирования после сверки. Оплата должна поступить за
+// dueBefore до заезда, а при предоплате — к сроку списания остатка;
+// бронирования с нулевой стоимостью оплаты не ждут
+func (r reconciliation) issues(
+	booking entities.Booking, now time.Time, dueBefore time.Duration,
+) []entities.ReconciliationIssue {
+	var issues []entities.ReconciliationIssue
+	add := func(kind entities.ReconciliationIssueKind, format string, args ...any) {
+		issues = append(issues, entities.ReconciliationIssue{Kind: kind, Details: fmt.Sprintf(format, args...)})
+	}
+
+	cancelled := booking.Status == entities.BookingStatusCancelled
+	if cancelled && r.stayPaid.Amount > 0 {
+		add(entities.ReconciliationIssueCancelledButPaid,
+			"booking is cancelled but %s is still charged", formatMoney(r.stayPaid))
+	}
+	deadline := booking.StartDate.Add(-dueBefore)
+	if !booking.BalanceDueAt.IsZero() {
+		deadline = booking.BalanceDueAt
+	}
+	if !cancelled && r.status != entities.BookingPaymentStatusPaid && booking.Price.Total.Amount > 0 &&
+		!now.Before(deadline) {
+		add(entities.ReconciliationIssueUnpaidPastDeadline, "%s of %s paid, payment was due %s",
+			formatMoney(r.stayPaid), formatMoney(booking.Price.Total), deadline.Format(time.RFC3339))
+	}
+	switch {
+	case r.foreign > 0:
+		add(entities.ReconciliationIssueAmountMismatch,
+			"payment service has %d payment(s) not in booking currency %s", r.foreign, booking.Price.Total.Currency)
+	case r.charged != r.recorded:
+		add(entities.ReconciliationIssueAmountMismatch, "payment service charged %s, recorded payments %s",
+			formatMoney(r.charged), formatMoney(r.recorded))
+	}
+	return issues
+}
+
+func formatMoney(m entities.Money) string {
+	return invoice.FormatAmount(m) + " " + m.Currency
+}
+
+// ListReconciliationIssues возвращает отчет сверки: расхождения, новые первыми
+func (c *Controller) ListReconciliationIssues(
+	ctx context.Context, filter entities.ReconciliationFilter,
+) ([]entities.ReconciliationIssue, error) {
+	switch {
+	case filter.Limit <= 0:
+		filter.Limit = defaultReconciliationPageSize
+	case filter.Limit > maxReconciliationPageSize:
+		filter.Limit = maxReconciliationPageSize
+	}
+
+	var issues []entities.ReconciliationIssue
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		issues, errTx = c.ds.FindReconciliationIssues(ctx, tx, filter)
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
@@ -17,8 +17,17 @@ func (c *Controller) SubmitReview(ctx context.Context, reviewDTO entities.Review
 			return errTx
 		}
 
+		attached, errTx := c.ds.IsGuestInBooking(ctx, tx, booking.ID, []uint64{reviewDTO.GuestID})
+		if errTx != nil {
+			return errTx
+		}
+		if !attached {
+			return entities.ErrGuestNotInBooking
+		}
+
 		review := entities.Review{
 			BookingID: booking.ID,
+			GuestID:   reviewDTO.GuestID,
 			Rating:    reviewDTO.Rating,
 			Comment:   reviewDTO.Comment,
 			CreatedAt: time.Now().UTC(),
@@ -29,7 +38,13 @@ func (c *Controller) SubmitReview(ctx context.Context, reviewDTO entities.Review
 		if errTx != nil {
 			return errTx
 		}
-		return nil
+
+		hotelID, errTx := c.ds.FindHotelIDByBookingID(ctx, tx, booking.ID)
+		if errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityReview, reviewRes.ID, hotelID,
+			entities.AuditActionCreate, nil, reviewRes)
 	})
 	if err != nil {
 		return entities.Review{}, err
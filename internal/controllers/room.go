@@ -22,9 +22,14 @@ func (c *Controller) CreateRooms(ctx context.Context, rooms []entities.RoomDTO)
 	}
 
 	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
-		txErr := c.ds.SaveAllRooms(ctx, tx, baseRooms)
-		if txErr != nil {
-			return txErr
+		for i := range baseRooms {
+			if txErr := c.ds.SaveRoom(ctx, tx, &baseRooms[i]); txErr != nil {
+				return txErr
+			}
+			if txErr := c.audit(ctx, tx, entities.AuditEntityRoom, baseRooms[i].ID, baseRooms[i].HotelID,
+				entities.AuditActionCreate, nil, baseRooms[i]); txErr != nil {
+				return txErr
+			}
 		}
 
 		return nil
@@ -35,3 +40,29 @@ func (c *Controller) CreateRooms(ctx context.Context, rooms []entities.RoomDTO)
 
 	return nil
 }
+
+func (c *Controller) UpdateRoomStatus(ctx context.Context, roomID uint64, status entities.RoomStatus) (entities.Room, error) {
+	switch status {
+	case entities.RoomStatusClean, entities.RoomStatusDirty, entities.RoomStatusInspected, entities.RoomStatusOutOfService:
+	default:
+		return entities.Room{}, entities.ErrInvalidRoomStatus
+	}
+
+	var room entities.Room
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		before, errTx := c.ds.FindRoomById(ctx, tx, int64(roomID))
+		if errTx != nil {
+			return errTx
+		}
+		room, errTx = c.ds.UpdateRoomStatus(ctx, tx, roomID, status)
+		if errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityRoom, room.ID, room.HotelID, entities.AuditActionUpdate, before, room)
+	})
+	if err != nil {
+		return entities.Room{}, err
+	}
+
+	return room, nil
+}
@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"context"
+	"database/sql"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/pricing"
+	"booking-service/internal/storage"
+)
+
+// CreateTaxRule добавляет налог или сбор. Правила не редактируются: чтобы
+// изменить ставку, старое правило закрывают датой и создают новое
+func (c *Controller) CreateTaxRule(ctx context.Context, rule entities.TaxRule) (entities.TaxRule, error) {
+	rule.ValidFrom = pricing.Date(rule.ValidFrom)
+	if !rule.ValidTo.IsZero() {
+		rule.ValidTo = pricing.Date(rule.ValidTo)
+	}
+	// у НДС нет суммы, валюта не хранится
+	if rule.Kind == entities.TaxKindVAT {
+		rule.Amount = entities.Money{}
+	} else {
+		rule.PercentBasisPoints = 0
+	}
+	if err := pricing.ValidateTaxRule(rule); err != nil {
+		return entities.TaxRule{}, err
+	}
+
+	var res entities.TaxRule
+	err := storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		hotel, errTx := c.ds.FindHotelByID(ctx, tx, rule.HotelID)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = checkHotelCurrency(hotel, rule.Amount); errTx != nil {
+			return errTx
+		}
+		if res, errTx = c.ds.SaveTaxRule(ctx, tx, rule); errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityTaxRule, res.ID, res.HotelID, entities.AuditActionCreate, nil, res)
+	})
+	if err != nil {
+		return entities.TaxRule{}, err
+	}
+
+	return res, nil
+}
+
+func (c *Controller) ListTaxRules(ctx context.Context, hotelID uint64) ([]entities.TaxRule, error) {
+	var rules []entities.TaxRule
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) (errTx error) {
+		rules, errTx = c.ds.FindTaxRulesByHotelID(ctx, tx, hotelID)
+		return errTx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// DeleteTaxRule удаляет правило; уже созданные бронирования сохраняют начисленные налоги
+func (c *Controller) DeleteTaxRule(ctx context.Context, taxRuleID uint64) error {
+	return storage.WithWriteTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		before, errTx := c.ds.FindTaxRuleByID(ctx, tx, taxRuleID)
+		if errTx != nil {
+			return errTx
+		}
+		if errTx = c.ds.DeleteTaxRule(ctx, tx, taxRuleID); errTx != nil {
+			return errTx
+		}
+		return c.audit(ctx, tx, entities.AuditEntityTaxRule, before.ID, before.HotelID, entities.AuditActionDelete, before, nil)
+	})
+}
+
+// HotelIDByTaxRuleID используется проверкой прав доступа
+func (c *Controller) HotelIDByTaxRuleID(ctx context.Context, taxRuleID uint64) (uint64, error) {
+	var hotelID uint64
+	err := storage.WithNoTransaction(ctx, c.sql, func(ctx context.Context, tx *sql.Tx) error {
+		rule, errTx := c.ds.FindTaxRuleByID(ctx, tx, taxRuleID)
+		if errTx != nil {
+			return errTx
+		}
+		hotelID = rule.HotelID
+		return nil
+	})
+	return hotelID, err
+}
@@ -0,0 +1,166 @@
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"google.golang.org/grpc/resolver"
+)
+
+// Scheme схема target для gRPC-клиентов: consul:///payment_service
+const Scheme = "consul"
+
+// GRPCPortMetaKey ключ метаданных сервиса в Consul с портом gRPC. Если его нет,
+// используется порт, с которым сервис зарегистрирован.
+const GRPCPortMetaKey = "grpc_port"
+
+const (
+	defaultWaitTime = 5 * time.Minute
+	minRetryDelay   = time.Second
+	maxRetryDelay   = 30 * time.Second
+)
+
+// ResolverBuilder резолвит имена сервисов в адреса здоровых инстансов через
+// health API Consul и следит за изменениями блокирующими запросами.
+// Клиент Consul передается снаружи, поэтому в тестах достаточно поднять
+// httptest-сервер, отвечающий на /v1/health/service/<name>.
+type ResolverBuilder struct {
+	client        *api.Client
+	logger        *slog.Logger
+	waitTime      time.Duration
+	minRetryDelay time.Duration
+	maxRetryDelay time.Duration
+}
+
+func NewResolverBuilder(client *api.Client, logger *slog.Logger) *ResolverBuilder {
+	return &ResolverBuilder{
+		client:        client,
+		logger:        logger,
+		waitTime:      defaultWaitTime,
+		minRetryDelay: minRetryDelay,
+		maxRetryDelay: maxRetryDelay,
+	}
+}
+
+// Target формирует target для grpc.NewClient
+func Target(serviceName string) string {
+	return Scheme + ":///" + serviceName
+}
+
+func (b *ResolverBuilder) Scheme() string {
+	return Scheme
+}
+
+func (b *ResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	service := strings.TrimPrefix(target.Endpoint(), "/")
+	if service == "" {
+		return nil, errors.New("[discovery.Build]: empty service name in target")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &consulResolver{
+		builder: b,
+		service: service,
+		cc:      cc,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go r.watch(ctx)
+	return r, nil
+}
+
+type consulResolver struct {
+	builder *ResolverBuilder
+	service string
+	cc      resolver.ClientConn
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// ResolveNow ничего не делает: изменения приходят через блокирующие запросы
+func (r *consulResolver) ResolveNow(resolver.ResolveNowOptions) {}
+
+func (r *consulResolver) Close() {
+	r.cancel()
+	<-r.done
+}
+
+func (r *consulResolver) watch(ctx context.Context) {
+	defer close(r.done)
+
+	var (
+		lastIndex uint64
+		delay     = r.builder.minRetryDelay
+	)
+	for {
+		opts := (&api.QueryOptions{
+			WaitIndex: lastIndex,
+			WaitTime:  r.builder.waitTime,
+		}).WithContext(ctx)
+
+		entries, meta, err := r.builder.client.Health().Service(r.service, "", true, opts)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			r.builder.logger.Warn("consul resolve failed", "service", r.service, "error", err)
+			r.cc.ReportError(fmt.Errorf("consul resolve %s: %w", r.service, err))
+			if !sleep(ctx, delay) {
+				return
+			}
+			delay = min(delay*2, r.builder.maxRetryDelay)
+			continue
+		}
+		delay = r.builder.minRetryDelay
+
+		// индекс может сброситься (например, при рестарте агента) — начинаем заново
+		if meta.LastIndex < lastIndex {
+			lastIndex = 0
+		} else {
+			lastIndex = meta.LastIndex
+		}
+
+		addrs := addresses(entries)
+		if len(addrs) == 0 {
+			r.cc.ReportError(fmt.Errorf("consul: no healthy instances of %s", r.service))
+			continue
+		}
+		if err := r.cc.UpdateState(resolver.State{Addresses: addrs}); err != nil {
+			r.builder.logger.Warn("consul resolver state rejected", "service", r.service, "error", err)
+		}
+	}
+}
+
+func addresses(entries []*api.ServiceEntry) []resolver.Address {
+	addrs := make([]resolver.Address, 0, len(entries))
+	for _, e := range entries {
+		host := e.Service.Address
+		if host == "" {
+			host = e.Node.Address
+		}
+		port := e.Service.Port
+		if p, err := strconv.Atoi(e.Service.Meta[GRPCPortMetaKey]); err == nil && p > 0 {
+			port = p
+		}
+		addrs = append(addrs, resolver.Address{Addr: net.JoinHostPort(host, strconv.Itoa(port))})
+	}
+	return addrs
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
@@ -0,0 +1,260 @@
+package discovery
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/resolver"
+)
+
+const testService = "payment_service"
+
+// fakeConsul отвечает на /v1/health/service/<name> как Consul: запрос с index,
+// равным текущему, блокируется до изменения или до истечения wait
+type fakeConsul struct {
+	mu       sync.Mutex
+	changed  chan struct{}
+	index    uint64
+	entries  []*api.ServiceEntry
+	failures int
+	requests []consulRequest
+}
+
+type consulRequest struct {
+	index uint64
+	at    time.Time
+}
+
+func newFakeConsul(t *testing.T) (*fakeConsul, *api.Client) {
+	t.Helper()
+
+	c := &fakeConsul{changed: make(chan struct{}), index: 1}
+	srv := httptest.NewServer(http.HandlerFunc(c.serveHealth))
+	t.Cleanup(srv.Close)
+
+	client, err := api.NewClient(&api.Config{Address: srv.URL})
+	require.NoError(t, err)
+	return c, client
+}
+
+// set публикует новый список инстансов с индексом index
+func (c *fakeConsul) set(index uint64, entries ...*api.ServiceEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.index, c.entries = index, entries
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// fail отвечает ошибкой на следующие n запросов
+func (c *fakeConsul) fail(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures = n
+}
+
+func (c *fakeConsul) history() []consulRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]consulRequest(nil), c.requests...)
+}
+
+func (c *fakeConsul) serveHealth(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/v1/health/service/"+testService {
+		http.NotFound(w, r)
+		return
+	}
+	index, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64)
+	wait, _ := time.ParseDuration(r.URL.Query().Get("wait"))
+
+	c.mu.Lock()
+	c.requests = append(c.requests, consulRequest{index: index, at: time.Now()})
+	if c.failures > 0 {
+		c.failures--
+		c.mu.Unlock()
+		http.Error(w, "agent unavailable", http.StatusInternalServerError)
+		return
+	}
+	if index != 0 && index == c.index {
+		changed := c.changed
+		c.mu.Unlock()
+		select {
+		case <-changed:
+		case <-time.After(wait):
+		case <-r.Context().Done():
+			return
+		}
+		c.mu.Lock()
+	}
+	current, entries := c.index, c.entries
+	c.mu.Unlock()
+
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(current, 10))
+	w.Header().Set("Content-Type", "application/json")
+	if entries == nil {
+		entries = []*api.ServiceEntry{}
+	}
+	_ = json.NewEncoder(w).Encode(entries)
+}
+
+func entry(nodeAddr, serviceAddr string, port int, meta map[string]string) *api.ServiceEntry {
+	return &api.ServiceEntry{
+		Node:    &api.Node{Node: "node", Address: nodeAddr},
+		Service: &api.AgentService{ID: testService, Service: testService, Address: serviceAddr, Port: port, Meta: meta},
+	}
+}
+
+// testClientConn собирает то, что резолвер сообщает gRPC. Сверх буфера
+// сообщения отбрасываются, чтобы резолвер не завис на Close
+type testClientConn struct {
+	resolver.ClientConn
+
+	states chan resolver.State
+	errors chan error
+}
+
+func (cc *testClientConn) UpdateState(s resolver.State) error {
+	select {
+	case cc.states <- s:
+	default:
+	}
+	return nil
+}
+
+func (cc *testClientConn) ReportError(err error) {
+	select {
+	case cc.errors <- err:
+	default:
+	}
+}
+
+func buildResolver(t *testing.T, client *api.Client) *testClientConn {
+	t.Helper()
+
+	b := NewResolverBuilder(client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	b.waitTime = time.Second
+	b.minRetryDelay = 50 * time.Millisecond
+	b.maxRetryDelay = 100 * time.Millisecond
+
+	cc := &testClientConn{states: make(chan resolver.State, 16), errors: make(chan error, 16)}
+	target := resolver.Target{URL: url.URL{Scheme: Scheme, Path: "/" + testService}}
+	r, err := b.Build(target, cc, resolver.BuildOptions{})
+	require.NoError(t, err)
+	t.Cleanup(r.Close)
+	return cc
+}
+
+func nextState(t *testing.T, cc *testClientConn) []string {
+	t.Helper()
+	select {
+	case s := <-cc.states:
+		addrs := make([]string, 0, len(s.Addresses))
+		for _, a := range s.Addresses {
+			addrs = append(addrs, a.Addr)
+		}
+		return addrs
+	case err := <-cc.errors:
+		t.Fatalf("unexpected resolver error: %v", err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("no resolver update")
+	}
+	return nil
+}
+
+func nextError(t *testing.T, cc *testClientConn) error {
+	t.Helper()
+	select {
+	case err := <-cc.errors:
+		return err
+	case s := <-cc.states:
+		t.Fatalf("unexpected resolver update: %v", s)
+	case <-time.After(2 * time.Second):
+		t.Fatal("no resolver error")
+	}
+	return nil
+}
+
+func TestResolverUpdatesAddresses(t *testing.T) {
+	consul, client := newFakeConsul(t)
+	consul.set(10, entry("10.0.0.1", "10.0.1.1", 8080, map[string]string{GRPCPortMetaKey: "9090"}))
+
+	cc := buildResolver(t, client)
+	assert.Equal(t, []string{"10.0.1.1:9090"}, nextState(t, cc))
+
+	// адрес сервиса не задан — берется адрес узла и порт регистрации
+	consul.set(11,
+		entry("10.0.0.2", "", 8081, nil),
+		entry("10.0.0.3", "10.0.1.3", 8082, map[string]string{GRPCPortMetaKey: "9092"}),
+	)
+	assert.Equal(t, []string{"10.0.0.2:8081", "10.0.1.3:9092"}, nextState(t, cc))
+
+	// после первого ответа запросы блокирующие, с индексом предыдущего ответа
+	history := consul.history()
+	require.GreaterOrEqual(t, len(history), 2)
+	assert.Equal(t, uint64(0), history[0].index)
+	assert.Equal(t, uint64(10), history[1].index)
+}
+
+func TestResolverReportsNoHealthyInstances(t *testing.T) {
+	consul, client := newFakeConsul(t)
+	consul.set(5)
+
+	cc := buildResolver(t, client)
+	assert.ErrorContains(t, nextError(t, cc), "no healthy instances of "+testService)
+
+	consul.set(6, entry("10.0.0.1", "", 8080, nil))
+	assert.Equal(t, []string{"10.0.0.1:8080"}, nextState(t, cc))
+}
+
+func TestResolverResetsIndex(t *testing.T) {
+	consul, client := newFakeConsul(t)
+	consul.set(100, entry("10.0.0.1", "", 8080, nil))
+
+	cc := buildResolver(t, client)
+	assert.Equal(t, []string{"10.0.0.1:8080"}, nextState(t, cc))
+
+	// агент перезапустился, индекс начался заново
+	consul.set(5, entry("10.0.0.2", "", 8080, nil))
+	assert.Equal(t, []string{"10.0.0.2:8080"}, nextState(t, cc))
+	assert.Equal(t, []string{"10.0.0.2:8080"}, nextState(t, cc))
+
+	require.Eventually(t, func() bool { return len(consul.history()) >= 4 }, 2*time.Second, 10*time.Millisecond)
+	indexes := make([]uint64, 0, 4)
+	for _, r := range consul.history()[:4] {
+		indexes = append(indexes, r.index)
+	}
+	// индекс меньше прошлого сбрасывается в 0, затем запросы снова блокирующие
+	assert.Equal(t, []uint64{0, 100, 0, 5}, indexes)
+}
+
+func TestResolverBacksOffOnErrors(t *testing.T) {
+	consul, client := newFakeConsul(t)
+	consul.set(7, entry("10.0.0.1", "", 8080, nil))
+	consul.fail(3)
+
+	cc := buildResolver(t, client)
+	for range 3 {
+		assert.ErrorContains(t, nextError(t, cc), "consul resolve "+testService)
+	}
+	assert.Equal(t, []string{"10.0.0.1:8080"}, nextState(t, cc))
+
+	history := consul.history()
+	require.GreaterOrEqual(t, len(history), 4)
+	// задержка растет вдвое с 50ms и упирается в 100ms
+	for i, want := range []time.Duration{50 * time.Millisecond, 100 * time.Millisecond, 100 * time.Millisecond} {
+		gap := history[i+1].at.Sub(history[i].at)
+		assert.GreaterOrEqual(t, gap, want, "delay before attempt %d", i+2)
+		assert.Less(t, gap, want+time.Second, "delay before attempt %d", i+2)
+	}
+}
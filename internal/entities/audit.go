@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditEntityType string
+
+const (
+	AuditEntityHotel    AuditEntityType = "hotel"
+	AuditEntityRoom     AuditEntityType = "room"
+	AuditEntityBooking  AuditEntityType = "booking"
+	AuditEntityGuest    AuditEntityType = "guest"
+	AuditEntityReview   AuditEntityType = "review"
+	AuditEntityEmployee AuditEntityType = "employee"
+	AuditEntityRatePlan AuditEntityType = "rate_plan"
+	// для календаря цен entity_id — идентификатор отеля
+	AuditEntityRateCalendar AuditEntityType = "rate_calendar"
+	// для правил ценообразования entity_id — идентификатор отеля
+	AuditEntityPricingRule AuditEntityType = "pricing_rule"
+	AuditEntityTaxRule     AuditEntityType = "tax_rule"
+	AuditEntityPromotion   AuditEntityType = "promotion"
+	// для курсов валют entity_id не заполняется
+	AuditEntityExchangeRate AuditEntityType = "exchange_rate"
+	AuditEntityFolio        AuditEntityType = "folio"
+	AuditEntityFolioCharge  AuditEntityType = "folio_charge"
+	AuditEntityPayment      AuditEntityType = "payment"
+	AuditEntityInvoice      AuditEntityType = "invoice"
+	// для правила предоплаты entity_id — идентификатор отеля
+	AuditEntityDepositRule AuditEntityType = "deposit_rule"
+)
+
+type AuditAction string
+
+const (
+	AuditActionCreate AuditAction = "create"
+	AuditActionUpdate AuditAction = "update"
+	AuditActionDelete AuditAction = "delete"
+	// AuditActionErase удаление персональных данных; значения в журнал не пишутся
+	AuditActionErase AuditAction = "erase"
+)
+
+// AuditEvent запись журнала аудита; таблица только для добавления
+type AuditEvent struct {
+	ID         uint64          `db:"id"`
+	OccurredAt time.Time       `db:"occurred_at"`
+	ActorKind  string          `db:"actor_kind"`
+	Actor      string          `db:"actor"`
+	EmployeeID uint64          `db:"employee_id"`
+	RPC        string          `db:"rpc"`
+	EntityType AuditEntityType `db:"entity_type"`
+	EntityID   uint64          `db:"entity_id"`
+	// HotelID отель сущности; пусто для гостей, они не принадлежат отелю
+	HotelID   uint64          `db:"hotel_id"`
+	Action    AuditAction     `db:"action"`
+	Diff      json.RawMessage `db:"diff"`
+	RequestID string          `db:"request_id"`
+}
+
+type AuditFilter struct {
+	EntityType AuditEntityType
+	EntityID   uint64
+	HotelID    uint64
+	From       time.Time
+	To         time.Time
+	// BeforeID курсор страницы: события с меньшим идентификатором
+	BeforeID uint64
+	Limit    int
+}
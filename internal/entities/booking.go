@@ -13,6 +13,16 @@ const (
 	BookingStatusConfirmed BookingStatus = 3
 )
 
+// BookingPaymentStatus состояние оплаты проживания
+type BookingPaymentStatus string
+
+const (
+	BookingPaymentStatusUnpaid        BookingPaymentStatus = "unpaid"
+	BookingPaymentStatusPartiallyPaid BookingPaymentStatus = "partially_paid"
+	BookingPaymentStatusPaid          BookingPaymentStatus = "paid"
+	BookingPaymentStatusRefunded      BookingPaymentStatus = "refunded"
+)
+
 type Booking struct {
 	ID        uint64        `db:"id"`
 	CreatedAt time.Time     `db:"created_at"`
@@ -23,6 +33,16 @@ type Booking struct {
 	Comment   string        `db:"comment"`
 	Status    BookingStatus `db:"status"`
 	IsPaid    bool          `db:"is_paid"`
+	// PaymentStatus и AmountPaid — оплата проживания по данным платежного
+	// сервиса без оплат счета за услуги; обновляются при оплате и сверке
+	PaymentStatus BookingPaymentStatus `db:"payment_status"`
+	AmountPaid    Money                `db:"amount_paid"`
+	// BalanceDueAt когда списывается остаток после депозита; пусто — остаток не запланирован
+	BalanceDueAt time.Time     `db:"balance_due_at"`
+	Price        PriceSnapshot `db:"price"`
+	// Cancellation условия отмены на момент бронирования
+	Cancellation CancellationTerms `db:"cancellation"`
+	Guests       []Guest           `db:"-"`
 }
 
 type CreateBookingDTO struct {
@@ -31,4 +51,13 @@ type CreateBookingDTO struct {
 	EndDate   time.Time
 	Comment   string
 	Guests    []GuestDTO
+	// Quote проверенное предложение из QuoteStay; nil — цена считается заново
+	Quote     *Quote
+	PromoCode string
+}
+
+type ModifyBookingDTO struct {
+	BookingID uint64
+	StartDate time.Time
+	EndDate   time.Time
 }
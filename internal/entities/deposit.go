@@ -0,0 +1,33 @@
+package entities
+
+import "time"
+
+type DepositKind string
+
+const (
+	// DepositKindPercent депозит — процент полной стоимости
+	DepositKindPercent DepositKind = "percent"
+	// DepositKindFirstNight депозит — цена первой ночи
+	DepositKindFirstNight DepositKind = "first_night"
+)
+
+// DepositRule правило предоплаты отеля: депозит списывается при бронировании,
+// остаток — за BalanceDaysBefore дней до заезда. Если к бронированию этот срок
+// уже наступил, сразу списывается полная стоимость
+type DepositRule struct {
+	HotelID uint64      `db:"hotel_id"`
+	Kind    DepositKind `db:"kind"`
+	// PercentBasisPoints для DepositKindPercent: 3000 — 30%
+	PercentBasisPoints int       `db:"percent_basis_points"`
+	BalanceDaysBefore  int       `db:"balance_days_before"`
+	UpdatedAt          time.Time `db:"updated_at"`
+}
+
+// BalanceChargeOptions параметры прохода списания остатков
+type BalanceChargeOptions struct {
+	BatchSize int
+	// MaxAttempts отклоненных списаний, после которых бронирование отменяется
+	MaxAttempts int
+	// RetryInterval пауза между попытками
+	RetryInterval time.Duration
+}
@@ -2,17 +2,39 @@ package entities
 
 import "time"
 
+type EmployeeRole string
+
+const (
+	// EmployeeRoleAdmin администратор сети, не привязан к отелю
+	EmployeeRoleAdmin        EmployeeRole = "admin"
+	EmployeeRoleManager      EmployeeRole = "manager"
+	EmployeeRoleReceptionist EmployeeRole = "receptionist"
+	EmployeeRoleHousekeeping EmployeeRole = "housekeeping"
+)
+
+func (r EmployeeRole) IsValid() bool {
+	switch r {
+	case EmployeeRoleAdmin, EmployeeRoleManager, EmployeeRoleReceptionist, EmployeeRoleHousekeeping:
+		return true
+	}
+	return false
+}
+
 type Employee struct {
-	ID        uint64    `db:"id"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
-	Name      string    `db:"name"`
-	Role      string    `db:"role"`
+	ID        uint64       `db:"id"`
+	CreatedAt time.Time    `db:"created_at"`
+	UpdatedAt time.Time    `db:"updated_at"`
+	Name      string       `db:"name"`
+	Role      EmployeeRole `db:"role"`
+	HotelID   uint64       `db:"hotel_id"`
+	Subject   string       `db:"subject"`
 }
 
 type EmployeeDTO struct {
-	Name string
-	Role string
+	Name    string
+	Role    EmployeeRole
+	HotelID uint64
+	Subject string
 }
 
 func (e *Employee) GetID() uint64 {
@@ -9,4 +9,75 @@ var (
 	ErrStartDateIsAfterEndDate = errors.New("start date is after end date")
 	ErrNameIsRequired          = errors.New("name is required")
 	ErrNameIsTooLong           = errors.New("name is too long")
+	ErrInvalidRole             = errors.New("invalid employee role")
+	ErrHotelIsRequired         = errors.New("hotel is required for this role")
+	ErrSubjectIsRequired       = errors.New("subject is required")
+	ErrAlreadyExists           = errors.New("entity already exists")
+	ErrInvalidRoomStatus       = errors.New("invalid room status")
+	ErrGuestNotInBooking       = errors.New("guest is not attached to booking")
+	ErrBookingCancelled        = errors.New("booking is cancelled")
+	ErrInvalidRoomType         = errors.New("invalid room type")
+	ErrInvalidCurrency         = errors.New("invalid currency code")
+	ErrInvalidPrice            = errors.New("price must be positive")
+	ErrInvalidValidityPeriod   = errors.New("valid_from is after valid_to")
+	ErrEmptyStay               = errors.New("stay must be at least one night")
+	ErrNoRateForNight          = errors.New("no rate plan covers the night")
+	ErrCurrencyMismatch        = errors.New("rate plans for the stay use different currencies")
+	ErrEmptyRates              = errors.New("at least one rate range is required")
+	ErrRateRangeTooLong        = errors.New("date range is too long")
+	ErrInvalidWeekday          = errors.New("invalid weekday")
+	ErrInvalidGuestCount       = errors.New("at least one guest is required")
+	ErrStartDateInPast         = errors.New("start date is in the past")
+	ErrPromoCodeNotFound       = errors.New("promo code not found")
+	ErrInvalidQuote            = errors.New("invalid quote token")
+	ErrQuoteExpired            = errors.New("quote has expired")
+	ErrQuoteMismatch           = errors.New("quote does not match the booking")
+	ErrInvalidCancellation     = errors.New("cancellation policy values must not be negative")
+	ErrInvalidStrategy         = errors.New("unknown pricing strategy")
+	ErrInvalidOccupancyTier    = errors.New("occupancy tiers must be within 0-100% and adjust the price by more than -100%")
+	ErrInvalidPriceGuard       = errors.New("min price must not exceed max price and both must use the same currency")
+	ErrInvalidTaxKind          = errors.New("invalid tax kind")
+	ErrInvalidTaxPercent       = errors.New("tax percent must be within 0-100%")
+	ErrTaxCurrencyMismatch     = errors.New("tax rule currency differs from the stay currency")
+	ErrInvalidTaxExemptGuests  = errors.New("tax exempt guests must not exceed guests")
+	ErrBookingAlreadyPaid      = errors.New("booking is already paid")
+	ErrNothingToPay            = errors.New("booking has no amount to pay")
+	ErrPaymentDeclined         = errors.New("payment declined")
+	ErrPaymentUnavailable      = errors.New("payment service is unavailable")
+	ErrPaymentNotAttempted     = errors.New("payment request was not sent")
+	ErrInvalidPromoCode        = errors.New("promo code must be 3-64 latin letters, digits, '-' or '_'")
+	ErrInvalidDiscount         = errors.New("invalid discount")
+	ErrInvalidMinNights        = errors.New("min nights must not be negative")
+	ErrInvalidMaxRedemptions   = errors.New("max redemptions must not be negative or below the redemptions made")
+	ErrPromoCodeNotApplicable  = errors.New("promo code is not applicable")
+	ErrPromoCodeExhausted      = errors.New("promo code has no redemptions left")
+	ErrHotelCurrencyMismatch   = errors.New("price currency differs from the hotel currency")
+	ErrInvalidExchangeRate     = errors.New("exchange rate must be a positive decimal between two different currencies")
+	ErrEmptyExchangeRates      = errors.New("at least one exchange rate is required")
+	ErrNoExchangeRate          = errors.New("no exchange rate for the currency pair")
+	ErrInvalidChargeCategory   = errors.New("invalid charge category")
+	ErrInvalidQuantity         = errors.New("quantity must be positive")
+	ErrFolioClosed             = errors.New("folio is closed")
+	ErrChargeAlreadyVoided     = errors.New("charge is already voided")
+	ErrChargeSettled           = errors.New("voiding the charge would leave the folio overpaid")
+	ErrInvalidSettlementAmount = errors.New("settlement amount must be positive and not exceed the balance")
+	ErrFolioNotSettled         = errors.New("folio balance is not settled")
+	ErrBookingNotPaid          = errors.New("booking is not paid")
+	ErrDescriptionTooLong      = errors.New("description is too long")
+	ErrVoidReasonRequired      = errors.New("void reason is required")
+	ErrFolioNotClosed          = errors.New("folio must be closed at check-out before invoicing")
+	ErrInvalidInvoiceFormat    = errors.New("invalid invoice format")
+	ErrInvalidDepositKind      = errors.New("invalid deposit kind")
+	ErrInvalidDepositPercent   = errors.New("deposit percent must be within 0-100%")
+	ErrInvalidBalanceDays      = errors.New("balance days before arrival must not be negative")
+	ErrInvalidEmail            = errors.New("invalid email")
+	ErrInvalidPhone            = errors.New("phone must be in E.164 format, e.g. +14155552671")
+	ErrInvalidDateOfBirth      = errors.New("invalid date of birth")
+	ErrInvalidCountryCode      = errors.New("country must be an ISO 3166-1 alpha-2 code")
+	ErrInvalidDocument         = errors.New("identity document requires a valid type and number")
+	ErrEmptyGuestSearch        = errors.New("at least one search criterion is required")
+	ErrMergeSameGuest          = errors.New("cannot merge a guest into itself")
+	ErrGuestErased             = errors.New("guest personal data has been erased")
+	ErrGuestHasActiveBookings  = errors.New("guest has current or upcoming bookings")
+	ErrPriceBelowAmountPaid    = errors.New("new price is below the amount already paid")
 )
@@ -0,0 +1,23 @@
+package entities
+
+import "time"
+
+// ExchangeRate курс обмена: за единицу Base дают Rate единиц Quote (в основных
+// единицах валют). Rate — десятичная строка, чтобы не терять точность
+type ExchangeRate struct {
+	Base      string    `db:"base_currency"`
+	Quote     string    `db:"quote_currency"`
+	Rate      string    `db:"rate"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// ConvertedPrice цена, пересчитанная в валюту гостя только для показа:
+// списание идет в валюте отеля
+type ConvertedPrice struct {
+	Total    Money
+	Subtotal Money
+	Taxes    Money
+	Fees     Money
+	// Rate курс из валюты отеля в валюту гостя
+	Rate ExchangeRate
+}
@@ -0,0 +1,80 @@
+package entities
+
+import "time"
+
+type FolioStatus string
+
+const (
+	FolioStatusOpen   FolioStatus = "open"
+	FolioStatusClosed FolioStatus = "closed"
+)
+
+type ChargeCategory string
+
+const (
+	ChargeCategoryRoomService ChargeCategory = "room_service"
+	ChargeCategoryMinibar     ChargeCategory = "minibar"
+	ChargeCategoryRestaurant  ChargeCategory = "restaurant"
+	ChargeCategoryLaundry     ChargeCategory = "laundry"
+	ChargeCategoryParking     ChargeCategory = "parking"
+	ChargeCategorySpa         ChargeCategory = "spa"
+	ChargeCategoryOther       ChargeCategory = "other"
+)
+
+func (c ChargeCategory) IsValid() bool {
+	switch c {
+	case ChargeCategoryRoomService, ChargeCategoryMinibar, ChargeCategoryRestaurant, ChargeCategoryLaundry,
+		ChargeCategoryParking, ChargeCategorySpa, ChargeCategoryOther:
+		return true
+	}
+	return false
+}
+
+// Folio счет гостя за услуги сверх проживания. Создается при первом
+// обращении, ведется в валюте отеля и закрывается при выезде
+type Folio struct {
+	ID        uint64      `db:"id"`
+	CreatedAt time.Time   `db:"created_at"`
+	UpdatedAt time.Time   `db:"updated_at"`
+	BookingID uint64      `db:"booking_id"`
+	Currency  string      `db:"currency"`
+	Status    FolioStatus `db:"status"`
+	ClosedAt  time.Time   `db:"closed_at"`
+
+	Charges  []FolioCharge `db:"-"`
+	Payments []Payment     `db:"-"`
+	// Charged — сумма несторнированных начислений, Paid — успешных оплат,
+	// Balance = Charged - Paid
+	Charged Money `db:"-"`
+	Paid    Money `db:"-"`
+	Balance Money `db:"-"`
+}
+
+// FolioCharge начисление в счете: Quantity единиц по UnitPrice, налог
+// TaxBasisPoints начисляется на Net
+type FolioCharge struct {
+	ID             uint64         `db:"id"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+	FolioID        uint64         `db:"folio_id"`
+	Category       ChargeCategory `db:"category"`
+	Description    string         `db:"description"`
+	Quantity       int            `db:"quantity"`
+	UnitPrice      Money          `db:"unit_price"`
+	TaxBasisPoints int            `db:"tax_basis_points"`
+	Net            Money          `db:"net"`
+	Tax            Money          `db:"tax"`
+	Total          Money          `db:"total"`
+	PostedBy       string         `db:"posted_by"`
+	VoidedAt       time.Time      `db:"voided_at"`
+	VoidReason     string         `db:"void_reason"`
+}
+
+type FolioChargeDTO struct {
+	BookingID      uint64
+	Category       ChargeCategory
+	Description    string
+	Quantity       int
+	UnitPrice      Money
+	TaxBasisPoints int
+}
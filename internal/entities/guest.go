@@ -2,13 +2,128 @@ package entities
 
 import "time"
 
+type DocumentType string
+
+const (
+	DocumentTypePassport       DocumentType = "passport"
+	DocumentTypeNationalID     DocumentType = "national_id"
+	DocumentTypeDrivingLicence DocumentType = "driving_licence"
+)
+
 type Guest struct {
 	ID        uint64    `db:"id"`
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
 	Name      string    `db:"name"`
+	// Email в нижнем регистре, Phone в формате E.164
+	Email       string    `db:"email"`
+	Phone       string    `db:"phone"`
+	DateOfBirth time.Time `db:"date_of_birth"`
+	// Nationality код страны ISO 3166-1 alpha-2
+	Nationality string  `db:"nationality"`
+	Address     Address `db:"address"`
+	// документ, удостоверяющий личность. Номер хранится зашифрованным и в
+	// журнал аудита не попадает
+	DocumentType      DocumentType `db:"document_type"`
+	DocumentNumber    string       `db:"document_number" audit:"redact"`
+	DocumentCountry   string       `db:"document_country"`
+	DocumentExpiresAt time.Time    `db:"document_expires_at"`
+	// ErasedAt когда персональные данные гостя удалены; пусто — не удалены
+	ErasedAt time.Time `db:"erased_at"`
+}
+
+// ErasedGuestName имя обезличенного гостя: оно остается в бронированиях и счетах
+const ErasedGuestName = "Erased guest"
+
+type Address struct {
+	Line1      string `json:"line1,omitempty"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city,omitempty"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	// Country код страны ISO 3166-1 alpha-2
+	Country string `json:"country,omitempty"`
+}
+
+func (a Address) IsZero() bool {
+	return a == Address{}
 }
 
 type GuestDTO struct {
 	Name string
+	// контакты и документ, по которым гость сопоставляется с существующим профилем
+	Email             string
+	Phone             string
+	DocumentType      DocumentType
+	DocumentNumber    string
+	DocumentCountry   string
+	DocumentExpiresAt time.Time
+	// TaxExempt гость освобожден от налогов в этом бронировании
+	TaxExempt bool
+}
+
+// GuestMatchKey поле, совпадение которого указывает на одного и того же гостя
+type GuestMatchKey string
+
+const (
+	GuestMatchEmail    GuestMatchKey = "email"
+	GuestMatchPhone    GuestMatchKey = "phone"
+	GuestMatchDocument GuestMatchKey = "document"
+	// GuestMatchName совпадение только имени; в сопоставлении не участвует,
+	// только в отчете о дубликатах
+	GuestMatchName GuestMatchKey = "name"
+)
+
+// GuestMatching стратегия сопоставления нового гостя с существующими профилями.
+// Кандидаты ищутся по Keys в порядке приоритета и принимаются, если сходство
+// имен не ниже NameSimilarity. Гость без заданных Keys всегда создается заново
+type GuestMatching struct {
+	Keys []GuestMatchKey
+	// NameSimilarity от 0 до 1; 0 — имя не сверяется
+	NameSimilarity float64
+}
+
+// GuestMerge итог объединения дубликатов
+type GuestMerge struct {
+	Guest         Guest
+	BookingsMoved int
+	ReviewsMoved  int
+}
+
+// GuestDataExport персональные данные гостя для ответа на запрос субъекта данных
+type GuestDataExport struct {
+	ExportedAt time.Time
+	Guest      Guest
+	Bookings   []Booking
+	Reviews    []Review
+}
+
+// GuestRetentionOptions параметры прохода обезличивания неактивных гостей
+type GuestRetentionOptions struct {
+	// InactiveAfter гость обезличивается, если столько времени не менял профиль
+	// и не проживал; гости с предстоящими бронированиями не трогаются
+	InactiveAfter time.Duration
+	BatchSize     int
+}
+
+// DuplicateGuests пара возможных дубликатов; Guest создан раньше Duplicate
+type DuplicateGuests struct {
+	Guest          Guest
+	Duplicate      Guest
+	MatchedBy      []GuestMatchKey
+	NameSimilarity float64
+}
+
+// GuestFilter условия поиска гостей; заданные условия объединяются через И.
+// Name ищется по подстроке без учета регистра, остальные — по точному совпадению
+type GuestFilter struct {
+	Name           string
+	Email          string
+	Phone          string
+	DocumentNumber string
+	// HotelID не 0 — только гости с бронированиями в отеле
+	HotelID uint64
+	// AfterID курсор: гости с ID больше заданного
+	AfterID uint64
+	Limit   int
 }
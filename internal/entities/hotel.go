@@ -7,4 +7,22 @@ type Hotel struct {
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
 	Name      string    `db:"name"`
+	// Currency валюта цен и налогов отеля
+	Currency string `db:"currency"`
+	// FreeCancellationHours — за сколько часов до заезда отмена бесплатна;
+	// после отмена стоит CancellationPenaltyNights первых ночей
+	FreeCancellationHours     int `db:"free_cancellation_hours"`
+	CancellationPenaltyNights int `db:"cancellation_penalty_nights"`
+}
+
+type CancellationPolicy struct {
+	FreeCancellationHours     int
+	CancellationPenaltyNights int
+}
+
+type CreateHotelDTO struct {
+	Name     string
+	Currency string
+	// nil — политика по умолчанию
+	CancellationPolicy *CancellationPolicy
 }
@@ -0,0 +1,64 @@
+package entities
+
+import "time"
+
+// InvoiceParty продавец или покупатель в счете
+type InvoiceParty struct {
+	Name    string `json:"name"`
+	TaxID   string `json:"tax_id,omitempty"`
+	Address string `json:"address,omitempty"`
+	Email   string `json:"email,omitempty"`
+}
+
+// InvoiceLine строка счета: total = net + tax
+type InvoiceLine struct {
+	Description string `json:"description"`
+	Quantity    int    `json:"quantity"`
+	UnitPrice   Money  `json:"unit_price"`
+	Net         Money  `json:"net"`
+	Tax         Money  `json:"tax"`
+	Total       Money  `json:"total"`
+}
+
+// Invoice счет за проживание и услуги. Номер сквозной в пределах отеля и
+// финансового года (календарного); выставленный счет не меняется
+type Invoice struct {
+	ID             uint64        `json:"id"`
+	Number         string        `json:"number"`
+	HotelID        uint64        `json:"hotel_id"`
+	BookingID      uint64        `json:"booking_id"`
+	FiscalYear     int           `json:"fiscal_year"`
+	SequenceNumber int64         `json:"sequence_number"`
+	IssuedAt       time.Time     `json:"issued_at"`
+	Seller         InvoiceParty  `json:"seller"`
+	Buyer          InvoiceParty  `json:"buyer"`
+	StayStart      time.Time     `json:"stay_start"`
+	StayEnd        time.Time     `json:"stay_end"`
+	Currency       string        `json:"currency"`
+	Lines          []InvoiceLine `json:"lines"`
+	Net            Money         `json:"net"`
+	Tax            Money         `json:"tax"`
+	Total          Money         `json:"total"`
+	Paid           Money         `json:"paid"`
+	Due            Money         `json:"due"`
+}
+
+// InvoiceFile выставленный счет в сохраненном виде
+type InvoiceFile struct {
+	ContentType string
+	FileName    string
+	Data        []byte
+}
+
+type InvoiceFormat string
+
+const (
+	InvoiceFormatPDF  InvoiceFormat = "pdf"
+	InvoiceFormatJSON InvoiceFormat = "json"
+)
+
+type IssueInvoiceDTO struct {
+	BookingID uint64
+	// Buyer реквизиты покупателя; без имени покупателем указывается первый гость
+	Buyer InvoiceParty
+}
@@ -0,0 +1,45 @@
+package entities
+
+// Money сумма в минимальных единицах валюты (копейки, центы) и код ISO 4217
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// minorUnitExponents валюты ISO 4217, у которых не две цифры после запятой
+var minorUnitExponents = map[string]int{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+}
+
+// MinorUnitExponent число знаков после запятой в валюте
+func MinorUnitExponent(currency string) int {
+	if e, ok := minorUnitExponents[currency]; ok {
+		return e
+	}
+	return 2
+}
+
+// Major возвращает сумму в основных единицах валюты (рублях, евро) — для API,
+// которые принимают дробные суммы
+func (m Money) Major() float64 {
+	v := float64(m.Amount)
+	for i := 0; i < MinorUnitExponent(m.Currency); i++ {
+		v /= 10
+	}
+	return v
+}
+
+// IsValidCurrency проверяет формат кода валюты ISO 4217: три заглавные латинские буквы
+func IsValidCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,33 @@
+package entities
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type NotificationKind string
+
+const (
+	// NotificationBookingAutoCancelled бронирование отменено, потому что не удалось списать остаток
+	NotificationBookingAutoCancelled NotificationKind = "booking.auto_cancelled"
+)
+
+// Notification уведомление из outbox; Payload — JSON, который получает сервис уведомлений
+type Notification struct {
+	ID        uint64
+	Kind      NotificationKind
+	BookingID uint64
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+// BookingAutoCancelled содержимое уведомления NotificationBookingAutoCancelled
+type BookingAutoCancelled struct {
+	BookingID uint64    `json:"booking_id"`
+	HotelID   uint64    `json:"hotel_id"`
+	GuestIDs  []uint64  `json:"guest_ids"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	AmountDue Money     `json:"amount_due"`
+	Reason    string    `json:"reason"`
+}
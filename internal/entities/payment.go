@@ -9,14 +9,19 @@ var (
 	PaymentStatusSuccess  PaymentStatus = 1
 	PaymentStatusFailed   PaymentStatus = 2
 	PaymentStatusCanceled PaymentStatus = 3
+	// PaymentStatusAuthorized сумма заблокирована, но еще не списана
+	PaymentStatusAuthorized PaymentStatus = 4
 )
 
+// Payment списание через платежный сервис. FolioID не заполнен у оплаты
+// проживания через PayBooking
 type Payment struct {
 	ID          uint64        `db:"id"`
 	CreatedAt   time.Time     `db:"created_at"`
 	UpdatedAt   time.Time     `db:"updated_at"`
 	BookingID   uint64        `db:"booking_id"`
-	Amount      float64       `db:"amount"`
+	FolioID     uint64        `db:"folio_id"`
+	Amount      Money         `db:"amount"`
 	PaymentDate time.Time     `db:"payment_date"`
 	Status      PaymentStatus `db:"status"`
 }
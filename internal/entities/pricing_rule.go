@@ -0,0 +1,44 @@
+package entities
+
+import "time"
+
+type PricingStrategy string
+
+const (
+	// PricingStrategyFixed цена ночи равна базовой
+	PricingStrategyFixed PricingStrategy = "fixed"
+	// PricingStrategyOccupancy цена меняется в зависимости от загрузки отеля в эту ночь
+	PricingStrategyOccupancy PricingStrategy = "occupancy"
+)
+
+// PricingRule правило динамического ценообразования для типа номера в отеле:
+// стратегия корректирует базовую цену ночи, затем цена ограничивается MinPrice/MaxPrice
+type PricingRule struct {
+	HotelID        uint64          `db:"hotel_id"`
+	RoomType       string          `db:"room_type"`
+	Strategy       PricingStrategy `db:"strategy"`
+	OccupancyTiers []OccupancyTier `db:"occupancy_tiers"`
+	// нулевая сумма — ограничения нет
+	MinPrice  Money     `db:"min_price"`
+	MaxPrice  Money     `db:"max_price"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// OccupancyTier: при загрузке от MinOccupancyPercent цена меняется на AdjustmentPercent
+type OccupancyTier struct {
+	MinOccupancyPercent int `json:"min_occupancy_percent"`
+	AdjustmentPercent   int `json:"adjustment_percent"`
+}
+
+// Occupancy загрузка номеров типа: сколько номеров всего и сколько занято в каждую ночь
+type Occupancy struct {
+	Rooms  int
+	Booked map[time.Time]int
+}
+
+// PriceAdjustment изменение цены ночи с объяснением причины
+type PriceAdjustment struct {
+	Rule        string `json:"rule"`
+	Description string `json:"description"`
+	Amount      Money  `json:"amount"`
+}
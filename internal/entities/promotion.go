@@ -0,0 +1,58 @@
+package entities
+
+import "time"
+
+type DiscountType string
+
+const (
+	// DiscountTypePercent скидка PercentOff процентов с цены каждой ночи
+	DiscountTypePercent DiscountType = "percent"
+	// DiscountTypeFixed скидка AmountOff на проживание, распределяется по ночам
+	DiscountTypeFixed DiscountType = "fixed"
+)
+
+// Promotion промокод со скидкой и ограничениями. Нулевые ограничения не
+// действуют: HotelID — любой отель, пустой RoomTypes — любой тип номера,
+// MaxRedemptions — без лимита. Отмена бронирования не возвращает использование
+type Promotion struct {
+	ID           uint64       `db:"id"`
+	CreatedAt    time.Time    `db:"created_at"`
+	UpdatedAt    time.Time    `db:"updated_at"`
+	Code         string       `db:"code"`
+	HotelID      uint64       `db:"hotel_id"`
+	RoomTypes    []string     `db:"room_types"`
+	DiscountType DiscountType `db:"discount_type"`
+	PercentOff   int          `db:"percent_off"`
+	AmountOff    Money        `db:"amount_off"`
+	// StayFrom и StayTo — все ночи проживания должны попадать в период
+	StayFrom  time.Time `db:"stay_from"`
+	StayTo    time.Time `db:"stay_to"`
+	MinNights int       `db:"min_nights"`
+	// BookFrom и BookTo — когда можно бронировать с промокодом
+	BookFrom       time.Time `db:"book_from"`
+	BookTo         time.Time `db:"book_to"`
+	MaxRedemptions int       `db:"max_redemptions"`
+	Redemptions    int       `db:"redemptions"`
+}
+
+type PromotionDTO struct {
+	Code           string
+	HotelID        uint64
+	RoomTypes      []string
+	DiscountType   DiscountType
+	PercentOff     int
+	AmountOff      Money
+	StayFrom       time.Time
+	StayTo         time.Time
+	MinNights      int
+	BookFrom       time.Time
+	BookTo         time.Time
+	MaxRedemptions int
+}
+
+// Discount скидка по промокоду, примененная к цене
+type Discount struct {
+	PromotionID uint64 `json:"promotion_id"`
+	Code        string `json:"code"`
+	Amount      Money  `json:"amount"`
+}
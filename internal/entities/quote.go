@@ -0,0 +1,39 @@
+package entities
+
+import "time"
+
+// Quote расчет стоимости проживания до бронирования. Подписанный токен
+// предложения передается в CreateBooking, и бронирование получает ровно эту цену
+type Quote struct {
+	HotelID         uint64            `json:"hotel_id"`
+	RoomType        string            `json:"room_type"`
+	StartDate       time.Time         `json:"start_date"`
+	EndDate         time.Time         `json:"end_date"`
+	Guests          int               `json:"guests"`
+	TaxExemptGuests int               `json:"tax_exempt_guests,omitempty"`
+	PromoCode       string            `json:"promo_code,omitempty"`
+	Price           PriceSnapshot     `json:"price"`
+	Cancellation    CancellationTerms `json:"cancellation"`
+	ExpiresAt       time.Time         `json:"-"`
+	// Display цена в валюте гостя; в токен не попадает
+	Display *ConvertedPrice `json:"-"`
+}
+
+type QuoteDTO struct {
+	HotelID   uint64
+	RoomType  string
+	StartDate time.Time
+	EndDate   time.Time
+	Guests    int
+	// TaxExemptGuests сколько из гостей освобождены от налогов
+	TaxExemptGuests int
+	PromoCode       string
+	// DisplayCurrency валюта гостя; пусто — только валюта отеля
+	DisplayCurrency string
+}
+
+// CancellationTerms условия отмены: до FreeUntil бесплатно, после — Penalty
+type CancellationTerms struct {
+	FreeUntil time.Time `json:"free_until"`
+	Penalty   Money     `json:"penalty"`
+}
@@ -0,0 +1,40 @@
+package entities
+
+import "time"
+
+// RateOverride цена ночи, заданная в календаре; перекрывает цену тарифа
+type RateOverride struct {
+	HotelID   uint64    `db:"hotel_id"`
+	RoomType  string    `db:"room_type"`
+	Date      time.Time `db:"date"`
+	Price     Money     `db:"price"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// RateRange задаёт цену на ночи с StartDate по EndDate включительно. Если
+// Weekdays не пусты, цена ставится только на эти дни недели. Нулевая цена
+// снимает переопределение — ночи снова считаются по тарифу
+type RateRange struct {
+	RoomType  string         `db:"room_type"`
+	StartDate time.Time      `db:"start_date"`
+	EndDate   time.Time      `db:"end_date"`
+	Weekdays  []time.Weekday `db:"weekdays"`
+	Price     Money          `db:"price"`
+}
+
+type SetRatesDTO struct {
+	HotelID uint64
+	Ranges  []RateRange
+}
+
+// CalendarNight действующая цена ночи; Price.Amount == 0 — на ночь нет цены
+type CalendarNight struct {
+	Date        time.Time
+	Price       Money
+	RatePlanID  uint64
+	Override    bool
+	BasePrice   Money
+	Adjustments []PriceAdjustment
+	// OccupancyPercent загрузка номеров типа в эту ночь
+	OccupancyPercent int
+}
@@ -0,0 +1,56 @@
+package entities
+
+import "time"
+
+// RatePlan тариф отеля для типа номера: цена за ночь в период действия
+type RatePlan struct {
+	ID        uint64    `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+	HotelID   uint64    `db:"hotel_id"`
+	RoomType  string    `db:"room_type"`
+	Name      string    `db:"name"`
+	// NightlyPrice базовая цена за ночь
+	NightlyPrice Money `db:"nightly_price"`
+	// ValidFrom и ValidTo — первая и последняя ночь действия тарифа;
+	// нулевой ValidTo — бессрочно
+	ValidFrom time.Time `db:"valid_from"`
+	ValidTo   time.Time `db:"valid_to"`
+}
+
+type RatePlanDTO struct {
+	HotelID      uint64
+	RoomType     string
+	Name         string
+	NightlyPrice Money
+	ValidFrom    time.Time
+	ValidTo      time.Time
+}
+
+// PriceSnapshot цена проживания, зафиксированная в бронировании:
+// Total = Subtotal + Taxes + Fees, Subtotal — сумма цен ночей, Items —
+// расшифровка налогов и сборов. Скидка по промокоду уже учтена в ценах ночей
+type PriceSnapshot struct {
+	Total    Money        `json:"total"`
+	Subtotal Money        `json:"subtotal"`
+	Taxes    Money        `json:"taxes"`
+	Fees     Money        `json:"fees"`
+	Nights   []NightPrice `json:"nights"`
+	Items    []PriceItem  `json:"items,omitempty"`
+	// Guests и TaxExemptGuests — по ним начислены налоги
+	Guests          int `json:"guests,omitempty"`
+	TaxExemptGuests int `json:"tax_exempt_guests,omitempty"`
+	// Discount nil, если промокод не применялся
+	Discount *Discount `json:"discount,omitempty"`
+}
+
+type NightPrice struct {
+	Date       time.Time `json:"date"`
+	Price      Money     `json:"price"`
+	RatePlanID uint64    `json:"rate_plan_id"`
+	// Override — цена взята из календаря, а не из тарифа
+	Override bool `json:"override,omitempty"`
+	// BasePrice цена до корректировок правилом ценообразования
+	BasePrice   Money             `json:"base_price"`
+	Adjustments []PriceAdjustment `json:"adjustments,omitempty"`
+}
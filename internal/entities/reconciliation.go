@@ -0,0 +1,45 @@
+package entities
+
+import "time"
+
+// ReconciliationIssueKind вид расхождения между бронированием и платежным сервисом
+type ReconciliationIssueKind string
+
+const (
+	// ReconciliationIssueCancelledButPaid бронирование отменено, а деньги не возвращены
+	ReconciliationIssueCancelledButPaid ReconciliationIssueKind = "cancelled_but_paid"
+	// ReconciliationIssueUnpaidPastDeadline бронирование действует, но не оплачено к сроку
+	ReconciliationIssueUnpaidPastDeadline ReconciliationIssueKind = "unpaid_past_deadline"
+	// ReconciliationIssueAmountMismatch списания в платежном сервисе не совпадают с сохраненными платежами
+	ReconciliationIssueAmountMismatch ReconciliationIssueKind = "amount_mismatch"
+)
+
+type ReconciliationIssue struct {
+	ID         uint64
+	BookingID  uint64
+	HotelID    uint64
+	Kind       ReconciliationIssueKind
+	Details    string
+	DetectedAt time.Time
+	// ResolvedAt пустое, пока расхождение не устранено
+	ResolvedAt time.Time
+}
+
+// ReconciliationFilter отбор расхождений для отчета; HotelID 0 — все отели
+type ReconciliationFilter struct {
+	HotelID         uint64
+	IncludeResolved bool
+	Limit           int
+}
+
+// ReconciliationOptions параметры прохода сверки
+type ReconciliationOptions struct {
+	// Lookback сверяются бронирования, измененные за этот период
+	Lookback time.Duration
+	// RecheckAfter бронирование сверяется повторно не раньше этого срока
+	RecheckAfter time.Duration
+	// PaymentDueBefore за сколько до заезда бронирование должно быть оплачено
+	PaymentDueBefore time.Duration
+	// BatchSize сколько бронирований сверяется за один проход
+	BatchSize int
+}
@@ -2,13 +2,24 @@ package entities
 
 import "time"
 
+type RoomStatus int8
+
+const (
+	RoomStatusUnknown      RoomStatus = 0
+	RoomStatusClean        RoomStatus = 1
+	RoomStatusDirty        RoomStatus = 2
+	RoomStatusInspected    RoomStatus = 3
+	RoomStatusOutOfService RoomStatus = 4
+)
+
 type Room struct {
-	ID        uint64    `db:"id"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
-	Number    string    `db:"number"`
-	Type      string    `db:"type"`
-	HotelID   uint64    `db:"hotel_id"`
+	ID        uint64     `db:"id"`
+	CreatedAt time.Time  `db:"created_at"`
+	UpdatedAt time.Time  `db:"updated_at"`
+	Number    string     `db:"number"`
+	Type      string     `db:"type"`
+	HotelID   uint64     `db:"hotel_id"`
+	Status    RoomStatus `db:"status"`
 }
 
 type RoomDTO struct {
@@ -0,0 +1,42 @@
+package entities
+
+import "time"
+
+type TaxKind string
+
+const (
+	// TaxKindCityTax туристический налог: Amount за каждого гостя за каждую ночь
+	TaxKindCityTax TaxKind = "city_tax"
+	// TaxKindVAT НДС: PercentBasisPoints от цены ночей и сборов
+	TaxKindVAT TaxKind = "vat"
+	// TaxKindFee фиксированный сервисный сбор за проживание
+	TaxKindFee TaxKind = "fee"
+)
+
+// TaxRule налог или сбор отеля. Правило действует для ночей с ValidFrom по
+// ValidTo включительно (нулевой ValidTo — бессрочно); сбор за проживание —
+// если в период попадает дата заезда
+type TaxRule struct {
+	ID        uint64    `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+	HotelID   uint64    `db:"hotel_id"`
+	Kind      TaxKind   `db:"kind"`
+	Name      string    `db:"name"`
+	// Amount для туристического налога и сбора
+	Amount Money `db:"amount"`
+	// PercentBasisPoints для НДС: 2000 — 20%
+	PercentBasisPoints int       `db:"percent_basis_points"`
+	ValidFrom          time.Time `db:"valid_from"`
+	ValidTo            time.Time `db:"valid_to"`
+}
+
+// PriceItem строка налога или сбора в цене бронирования
+type PriceItem struct {
+	TaxRuleID uint64  `json:"tax_rule_id"`
+	Kind      TaxKind `json:"kind"`
+	Name      string  `json:"name"`
+	// Quantity — за сколько единиц начислено: гостеночей, ночей или проживаний
+	Quantity int   `json:"quantity"`
+	Amount   Money `json:"amount"`
+}
@@ -8,8 +8,10 @@ package generated
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -130,28 +132,8006 @@ func (RoomType) EnumDescriptor() ([]byte, []int) {
 	return file_booking_service_proto_rawDescGZIP(), []int{1}
 }
 
+type RoomStatus int32
+
+const (
+	RoomStatus_ROOM_STATUS_UNKNOWN        RoomStatus = 0
+	RoomStatus_ROOM_STATUS_CLEAN          RoomStatus = 1
+	RoomStatus_ROOM_STATUS_DIRTY          RoomStatus = 2
+	RoomStatus_ROOM_STATUS_INSPECTED      RoomStatus = 3
+	RoomStatus_ROOM_STATUS_OUT_OF_SERVICE RoomStatus = 4
+)
+
+// Enum value maps for RoomStatus.
+var (
+	RoomStatus_name = map[int32]string{
+		0: "ROOM_STATUS_UNKNOWN",
+		1: "ROOM_STATUS_CLEAN",
+		2: "ROOM_STATUS_DIRTY",
+		3: "ROOM_STATUS_INSPECTED",
+		4: "ROOM_STATUS_OUT_OF_SERVICE",
+	}
+	RoomStatus_value = map[string]int32{
+		"ROOM_STATUS_UNKNOWN":        0,
+		"ROOM_STATUS_CLEAN":          1,
+		"ROOM_STATUS_DIRTY":          2,
+		"ROOM_STATUS_INSPECTED":      3,
+		"ROOM_STATUS_OUT_OF_SERVICE": 4,
+	}
+)
+
+func (x RoomStatus) Enum() *RoomStatus {
+	p := new(RoomStatus)
+	*p = x
+	return p
+}
+
+func (x RoomStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[2].Descriptor()
+}
+
+func (RoomStatus) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[2]
+}
+
+func (x RoomStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomStatus.Descriptor instead.
+func (RoomStatus) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{2}
+}
+
+type EmployeeRole int32
+
+const (
+	EmployeeRole_EMPLOYEE_ROLE_UNKNOWN      EmployeeRole = 0
+	EmployeeRole_EMPLOYEE_ROLE_ADMIN        EmployeeRole = 1
+	EmployeeRole_EMPLOYEE_ROLE_MANAGER      EmployeeRole = 2
+	EmployeeRole_EMPLOYEE_ROLE_RECEPTIONIST EmployeeRole = 3
+	EmployeeRole_EMPLOYEE_ROLE_HOUSEKEEPING EmployeeRole = 4
+)
+
+// Enum value maps for EmployeeRole.
+var (
+	EmployeeRole_name = map[int32]string{
+		0: "EMPLOYEE_ROLE_UNKNOWN",
+		1: "EMPLOYEE_ROLE_ADMIN",
+		2: "EMPLOYEE_ROLE_MANAGER",
+		3: "EMPLOYEE_ROLE_RECEPTIONIST",
+		4: "EMPLOYEE_ROLE_HOUSEKEEPING",
+	}
+	EmployeeRole_value = map[string]int32{
+		"EMPLOYEE_ROLE_UNKNOWN":      0,
+		"EMPLOYEE_ROLE_ADMIN":        1,
+		"EMPLOYEE_ROLE_MANAGER":      2,
+		"EMPLOYEE_ROLE_RECEPTIONIST": 3,
+		"EMPLOYEE_ROLE_HOUSEKEEPING": 4,
+	}
+)
+
+func (x EmployeeRole) Enum() *EmployeeRole {
+	p := new(EmployeeRole)
+	*p = x
+	return p
+}
+
+func (x EmployeeRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmployeeRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[3].Descriptor()
+}
+
+func (EmployeeRole) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[3]
+}
+
+func (x EmployeeRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmployeeRole.Descriptor instead.
+func (EmployeeRole) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{3}
+}
+
+type AuditEntityType int32
+
+const (
+	AuditEntityType_AUDIT_ENTITY_TYPE_UNKNOWN       AuditEntityType = 0
+	AuditEntityType_AUDIT_ENTITY_TYPE_HOTEL         AuditEntityType = 1
+	AuditEntityType_AUDIT_ENTITY_TYPE_ROOM          AuditEntityType = 2
+	AuditEntityType_AUDIT_ENTITY_TYPE_BOOKING       AuditEntityType = 3
+	AuditEntityType_AUDIT_ENTITY_TYPE_GUEST         AuditEntityType = 4
+	AuditEntityType_AUDIT_ENTITY_TYPE_REVIEW        AuditEntityType = 5
+	AuditEntityType_AUDIT_ENTITY_TYPE_EMPLOYEE      AuditEntityType = 6
+	AuditEntityType_AUDIT_ENTITY_TYPE_RATE_PLAN     AuditEntityType = 7
+	AuditEntityType_AUDIT_ENTITY_TYPE_RATE_CALENDAR AuditEntityType = 8
+	AuditEntityType_AUDIT_ENTITY_TYPE_PRICING_RULE  AuditEntityType = 9
+	AuditEntityType_AUDIT_ENTITY_TYPE_TAX_RULE      AuditEntityType = 10
+	AuditEntityType_AUDIT_ENTITY_TYPE_PROMOTION     AuditEntityType = 11
+	AuditEntityType_AUDIT_ENTITY_TYPE_EXCHANGE_RATE AuditEntityType = 12
+	AuditEntityType_AUDIT_ENTITY_TYPE_FOLIO         AuditEntityType = 13
+	AuditEntityType_AUDIT_ENTITY_TYPE_FOLIO_CHARGE  AuditEntityType = 14
+	AuditEntityType_AUDIT_ENTITY_TYPE_PAYMENT       AuditEntityType = 15
+	AuditEntityType_AUDIT_ENTITY_TYPE_INVOICE       AuditEntityType = 16
+	AuditEntityType_AUDIT_ENTITY_TYPE_DEPOSIT_RULE  AuditEntityType = 17
+)
+
+// Enum value maps for AuditEntityType.
+var (
+	AuditEntityType_name = map[int32]string{
+		0:  "AUDIT_ENTITY_TYPE_UNKNOWN",
+		1:  "AUDIT_ENTITY_TYPE_HOTEL",
+		2:  "AUDIT_ENTITY_TYPE_ROOM",
+		3:  "AUDIT_ENTITY_TYPE_BOOKING",
+		4:  "AUDIT_ENTITY_TYPE_GUEST",
+		5:  "AUDIT_ENTITY_TYPE_REVIEW",
+		6:  "AUDIT_ENTITY_TYPE_EMPLOYEE",
+		7:  "AUDIT_ENTITY_TYPE_RATE_PLAN",
+		8:  "AUDIT_ENTITY_TYPE_RATE_CALENDAR",
+		9:  "AUDIT_ENTITY_TYPE_PRICING_RULE",
+		10: "AUDIT_ENTITY_TYPE_TAX_RULE",
+		11: "AUDIT_ENTITY_TYPE_PROMOTION",
+		12: "AUDIT_ENTITY_TYPE_EXCHANGE_RATE",
+		13: "AUDIT_ENTITY_TYPE_FOLIO",
+		14: "AUDIT_ENTITY_TYPE_FOLIO_CHARGE",
+		15: "AUDIT_ENTITY_TYPE_PAYMENT",
+		16: "AUDIT_ENTITY_TYPE_INVOICE",
+		17: "AUDIT_ENTITY_TYPE_DEPOSIT_RULE",
+	}
+	AuditEntityType_value = map[string]int32{
+		"AUDIT_ENTITY_TYPE_UNKNOWN":       0,
+		"AUDIT_ENTITY_TYPE_HOTEL":         1,
+		"AUDIT_ENTITY_TYPE_ROOM":          2,
+		"AUDIT_ENTITY_TYPE_BOOKING":       3,
+		"AUDIT_ENTITY_TYPE_GUEST":         4,
+		"AUDIT_ENTITY_TYPE_REVIEW":        5,
+		"AUDIT_ENTITY_TYPE_EMPLOYEE":      6,
+		"AUDIT_ENTITY_TYPE_RATE_PLAN":     7,
+		"AUDIT_ENTITY_TYPE_RATE_CALENDAR": 8,
+		"AUDIT_ENTITY_TYPE_PRICING_RULE":  9,
+		"AUDIT_ENTITY_TYPE_TAX_RULE":      10,
+		"AUDIT_ENTITY_TYPE_PROMOTION":     11,
+		"AUDIT_ENTITY_TYPE_EXCHANGE_RATE": 12,
+		"AUDIT_ENTITY_TYPE_FOLIO":         13,
+		"AUDIT_ENTITY_TYPE_FOLIO_CHARGE":  14,
+		"AUDIT_ENTITY_TYPE_PAYMENT":       15,
+		"AUDIT_ENTITY_TYPE_INVOICE":       16,
+		"AUDIT_ENTITY_TYPE_DEPOSIT_RULE":  17,
+	}
+)
+
+func (x AuditEntityType) Enum() *AuditEntityType {
+	p := new(AuditEntityType)
+	*p = x
+	return p
+}
+
+func (x AuditEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[4].Descriptor()
+}
+
+func (AuditEntityType) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[4]
+}
+
+func (x AuditEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditEntityType.Descriptor instead.
+func (AuditEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{4}
+}
+
+type BookingPaymentStatus int32
+
+const (
+	BookingPaymentStatus_BOOKING_PAYMENT_STATUS_UNKNOWN        BookingPaymentStatus = 0
+	BookingPaymentStatus_BOOKING_PAYMENT_STATUS_UNPAID         BookingPaymentStatus = 1
+	BookingPaymentStatus_BOOKING_PAYMENT_STATUS_PARTIALLY_PAID BookingPaymentStatus = 2
+	BookingPaymentStatus_BOOKING_PAYMENT_STATUS_PAID           BookingPaymentStatus = 3
+	BookingPaymentStatus_BOOKING_PAYMENT_STATUS_REFUNDED       BookingPaymentStatus = 4
+)
+
+// Enum value maps for BookingPaymentStatus.
+var (
+	BookingPaymentStatus_name = map[int32]string{
+		0: "BOOKING_PAYMENT_STATUS_UNKNOWN",
+		1: "BOOKING_PAYMENT_STATUS_UNPAID",
+		2: "BOOKING_PAYMENT_STATUS_PARTIALLY_PAID",
+		3: "BOOKING_PAYMENT_STATUS_PAID",
+		4: "BOOKING_PAYMENT_STATUS_REFUNDED",
+	}
+	BookingPaymentStatus_value = map[string]int32{
+		"BOOKING_PAYMENT_STATUS_UNKNOWN":        0,
+		"BOOKING_PAYMENT_STATUS_UNPAID":         1,
+		"BOOKING_PAYMENT_STATUS_PARTIALLY_PAID": 2,
+		"BOOKING_PAYMENT_STATUS_PAID":           3,
+		"BOOKING_PAYMENT_STATUS_REFUNDED":       4,
+	}
+)
+
+func (x BookingPaymentStatus) Enum() *BookingPaymentStatus {
+	p := new(BookingPaymentStatus)
+	*p = x
+	return p
+}
+
+func (x BookingPaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookingPaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[5].Descriptor()
+}
+
+func (BookingPaymentStatus) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[5]
+}
+
+func (x BookingPaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookingPaymentStatus.Descriptor instead.
+func (BookingPaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{5}
+}
+
+type ReconciliationIssueKind int32
+
+const (
+	ReconciliationIssueKind_RECONCILIATION_ISSUE_KIND_UNKNOWN ReconciliationIssueKind = 0
+	// бронирование отменено, а деньги не возвращены
+	ReconciliationIssueKind_RECONCILIATION_ISSUE_KIND_CANCELLED_BUT_PAID ReconciliationIssueKind = 1
+	// бронирование действует, но не оплачено к сроку
+	ReconciliationIssueKind_RECONCILIATION_ISSUE_KIND_UNPAID_PAST_DEADLINE ReconciliationIssueKind = 2
+	// списания платежного сервиса не совпадают с сохраненными платежами
+	ReconciliationIssueKind_RECONCILIATION_ISSUE_KIND_AMOUNT_MISMATCH ReconciliationIssueKind = 3
+)
+
+// Enum value maps for ReconciliationIssueKind.
+var (
+	ReconciliationIssueKind_name = map[int32]string{
+		0: "RECONCILIATION_ISSUE_KIND_UNKNOWN",
+		1: "RECONCILIATION_ISSUE_KIND_CANCELLED_BUT_PAID",
+		2: "RECONCILIATION_ISSUE_KIND_UNPAID_PAST_DEADLINE",
+		3: "RECONCILIATION_ISSUE_KIND_AMOUNT_MISMATCH",
+	}
+	ReconciliationIssueKind_value = map[string]int32{
+		"RECONCILIATION_ISSUE_KIND_UNKNOWN":              0,
+		"RECONCILIATION_ISSUE_KIND_CANCELLED_BUT_PAID":   1,
+		"RECONCILIATION_ISSUE_KIND_UNPAID_PAST_DEADLINE": 2,
+		"RECONCILIATION_ISSUE_KIND_AMOUNT_MISMATCH":      3,
+	}
+)
+
+func (x ReconciliationIssueKind) Enum() *ReconciliationIssueKind {
+	p := new(ReconciliationIssueKind)
+	*p = x
+	return p
+}
+
+func (x ReconciliationIssueKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationIssueKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[6].Descriptor()
+}
+
+func (ReconciliationIssueKind) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[6]
+}
+
+func (x ReconciliationIssueKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationIssueKind.Descriptor instead.
+func (ReconciliationIssueKind) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{6}
+}
+
+type InvoiceFormat int32
+
+const (
+	InvoiceFormat_INVOICE_FORMAT_UNSPECIFIED InvoiceFormat = 0
+	InvoiceFormat_INVOICE_FORMAT_PDF         InvoiceFormat = 1
+	InvoiceFormat_INVOICE_FORMAT_JSON        InvoiceFormat = 2
+)
+
+// Enum value maps for InvoiceFormat.
+var (
+	InvoiceFormat_name = map[int32]string{
+		0: "INVOICE_FORMAT_UNSPECIFIED",
+		1: "INVOICE_FORMAT_PDF",
+		2: "INVOICE_FORMAT_JSON",
+	}
+	InvoiceFormat_value = map[string]int32{
+		"INVOICE_FORMAT_UNSPECIFIED": 0,
+		"INVOICE_FORMAT_PDF":         1,
+		"INVOICE_FORMAT_JSON":        2,
+	}
+)
+
+func (x InvoiceFormat) Enum() *InvoiceFormat {
+	p := new(InvoiceFormat)
+	*p = x
+	return p
+}
+
+func (x InvoiceFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InvoiceFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[7].Descriptor()
+}
+
+func (InvoiceFormat) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[7]
+}
+
+func (x InvoiceFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InvoiceFormat.Descriptor instead.
+func (InvoiceFormat) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{7}
+}
+
+type FolioStatus int32
+
+const (
+	FolioStatus_FOLIO_STATUS_UNSPECIFIED FolioStatus = 0
+	FolioStatus_FOLIO_STATUS_OPEN        FolioStatus = 1
+	FolioStatus_FOLIO_STATUS_CLOSED      FolioStatus = 2
+)
+
+// Enum value maps for FolioStatus.
+var (
+	FolioStatus_name = map[int32]string{
+		0: "FOLIO_STATUS_UNSPECIFIED",
+		1: "FOLIO_STATUS_OPEN",
+		2: "FOLIO_STATUS_CLOSED",
+	}
+	FolioStatus_value = map[string]int32{
+		"FOLIO_STATUS_UNSPECIFIED": 0,
+		"FOLIO_STATUS_OPEN":        1,
+		"FOLIO_STATUS_CLOSED":      2,
+	}
+)
+
+func (x FolioStatus) Enum() *FolioStatus {
+	p := new(FolioStatus)
+	*p = x
+	return p
+}
+
+func (x FolioStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FolioStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[8].Descriptor()
+}
+
+func (FolioStatus) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[8]
+}
+
+func (x FolioStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FolioStatus.Descriptor instead.
+func (FolioStatus) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{8}
+}
+
+type ChargeCategory int32
+
+const (
+	ChargeCategory_CHARGE_CATEGORY_UNSPECIFIED  ChargeCategory = 0
+	ChargeCategory_CHARGE_CATEGORY_ROOM_SERVICE ChargeCategory = 1
+	ChargeCategory_CHARGE_CATEGORY_MINIBAR      ChargeCategory = 2
+	ChargeCategory_CHARGE_CATEGORY_RESTAURANT   ChargeCategory = 3
+	ChargeCategory_CHARGE_CATEGORY_LAUNDRY      ChargeCategory = 4
+	ChargeCategory_CHARGE_CATEGORY_PARKING      ChargeCategory = 5
+	ChargeCategory_CHARGE_CATEGORY_SPA          ChargeCategory = 6
+	ChargeCategory_CHARGE_CATEGORY_OTHER        ChargeCategory = 7
+)
+
+// Enum value maps for ChargeCategory.
+var (
+	ChargeCategory_name = map[int32]string{
+		0: "CHARGE_CATEGORY_UNSPECIFIED",
+		1: "CHARGE_CATEGORY_ROOM_SERVICE",
+		2: "CHARGE_CATEGORY_MINIBAR",
+		3: "CHARGE_CATEGORY_RESTAURANT",
+		4: "CHARGE_CATEGORY_LAUNDRY",
+		5: "CHARGE_CATEGORY_PARKING",
+		6: "CHARGE_CATEGORY_SPA",
+		7: "CHARGE_CATEGORY_OTHER",
+	}
+	ChargeCategory_value = map[string]int32{
+		"CHARGE_CATEGORY_UNSPECIFIED":  0,
+		"CHARGE_CATEGORY_ROOM_SERVICE": 1,
+		"CHARGE_CATEGORY_MINIBAR":      2,
+		"CHARGE_CATEGORY_RESTAURANT":   3,
+		"CHARGE_CATEGORY_LAUNDRY":      4,
+		"CHARGE_CATEGORY_PARKING":      5,
+		"CHARGE_CATEGORY_SPA":          6,
+		"CHARGE_CATEGORY_OTHER":        7,
+	}
+)
+
+func (x ChargeCategory) Enum() *ChargeCategory {
+	p := new(ChargeCategory)
+	*p = x
+	return p
+}
+
+func (x ChargeCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChargeCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[9].Descriptor()
+}
+
+func (ChargeCategory) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[9]
+}
+
+func (x ChargeCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChargeCategory.Descriptor instead.
+func (ChargeCategory) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{9}
+}
+
+type SettlementStatus int32
+
+const (
+	SettlementStatus_SETTLEMENT_STATUS_UNSPECIFIED SettlementStatus = 0
+	SettlementStatus_SETTLEMENT_STATUS_SUCCESS     SettlementStatus = 1
+	SettlementStatus_SETTLEMENT_STATUS_FAILED      SettlementStatus = 2
+	SettlementStatus_SETTLEMENT_STATUS_CANCELED    SettlementStatus = 3
+)
+
+// Enum value maps for SettlementStatus.
+var (
+	SettlementStatus_name = map[int32]string{
+		0: "SETTLEMENT_STATUS_UNSPECIFIED",
+		1: "SETTLEMENT_STATUS_SUCCESS",
+		2: "SETTLEMENT_STATUS_FAILED",
+		3: "SETTLEMENT_STATUS_CANCELED",
+	}
+	SettlementStatus_value = map[string]int32{
+		"SETTLEMENT_STATUS_UNSPECIFIED": 0,
+		"SETTLEMENT_STATUS_SUCCESS":     1,
+		"SETTLEMENT_STATUS_FAILED":      2,
+		"SETTLEMENT_STATUS_CANCELED":    3,
+	}
+)
+
+func (x SettlementStatus) Enum() *SettlementStatus {
+	p := new(SettlementStatus)
+	*p = x
+	return p
+}
+
+func (x SettlementStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SettlementStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[10].Descriptor()
+}
+
+func (SettlementStatus) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[10]
+}
+
+func (x SettlementStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SettlementStatus.Descriptor instead.
+func (SettlementStatus) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{10}
+}
+
+type DiscountType int32
+
+const (
+	DiscountType_DISCOUNT_TYPE_UNSPECIFIED DiscountType = 0
+	// percent_off процентов с цены каждой ночи
+	DiscountType_DISCOUNT_TYPE_PERCENT DiscountType = 1
+	// amount_off на проживание
+	DiscountType_DISCOUNT_TYPE_FIXED DiscountType = 2
+)
+
+// Enum value maps for DiscountType.
+var (
+	DiscountType_name = map[int32]string{
+		0: "DISCOUNT_TYPE_UNSPECIFIED",
+		1: "DISCOUNT_TYPE_PERCENT",
+		2: "DISCOUNT_TYPE_FIXED",
+	}
+	DiscountType_value = map[string]int32{
+		"DISCOUNT_TYPE_UNSPECIFIED": 0,
+		"DISCOUNT_TYPE_PERCENT":     1,
+		"DISCOUNT_TYPE_FIXED":       2,
+	}
+)
+
+func (x DiscountType) Enum() *DiscountType {
+	p := new(DiscountType)
+	*p = x
+	return p
+}
+
+func (x DiscountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[11].Descriptor()
+}
+
+func (DiscountType) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[11]
+}
+
+func (x DiscountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscountType.Descriptor instead.
+func (DiscountType) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{11}
+}
+
+type TaxKind int32
+
+const (
+	TaxKind_TAX_KIND_UNSPECIFIED TaxKind = 0
+	// за каждого гостя за каждую ночь
+	TaxKind_TAX_KIND_CITY_TAX TaxKind = 1
+	// процент от цены ночей и сборов
+	TaxKind_TAX_KIND_VAT TaxKind = 2
+	// фиксированный сбор за проживание
+	TaxKind_TAX_KIND_FEE TaxKind = 3
+)
+
+// Enum value maps for TaxKind.
+var (
+	TaxKind_name = map[int32]string{
+		0: "TAX_KIND_UNSPECIFIED",
+		1: "TAX_KIND_CITY_TAX",
+		2: "TAX_KIND_VAT",
+		3: "TAX_KIND_FEE",
+	}
+	TaxKind_value = map[string]int32{
+		"TAX_KIND_UNSPECIFIED": 0,
+		"TAX_KIND_CITY_TAX":    1,
+		"TAX_KIND_VAT":         2,
+		"TAX_KIND_FEE":         3,
+	}
+)
+
+func (x TaxKind) Enum() *TaxKind {
+	p := new(TaxKind)
+	*p = x
+	return p
+}
+
+func (x TaxKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaxKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[12].Descriptor()
+}
+
+func (TaxKind) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[12]
+}
+
+func (x TaxKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaxKind.Descriptor instead.
+func (TaxKind) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{12}
+}
+
+type PricingStrategy int32
+
+const (
+	PricingStrategy_PRICING_STRATEGY_UNSPECIFIED PricingStrategy = 0
+	// цена равна базовой, действуют только ограничения min/max
+	PricingStrategy_PRICING_STRATEGY_FIXED PricingStrategy = 1
+	// цена зависит от загрузки номеров типа в эту ночь
+	PricingStrategy_PRICING_STRATEGY_OCCUPANCY PricingStrategy = 2
+)
+
+// Enum value maps for PricingStrategy.
+var (
+	PricingStrategy_name = map[int32]string{
+		0: "PRICING_STRATEGY_UNSPECIFIED",
+		1: "PRICING_STRATEGY_FIXED",
+		2: "PRICING_STRATEGY_OCCUPANCY",
+	}
+	PricingStrategy_value = map[string]int32{
+		"PRICING_STRATEGY_UNSPECIFIED": 0,
+		"PRICING_STRATEGY_FIXED":       1,
+		"PRICING_STRATEGY_OCCUPANCY":   2,
+	}
+)
+
+func (x PricingStrategy) Enum() *PricingStrategy {
+	p := new(PricingStrategy)
+	*p = x
+	return p
+}
+
+func (x PricingStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PricingStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[13].Descriptor()
+}
+
+func (PricingStrategy) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[13]
+}
+
+func (x PricingStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PricingStrategy.Descriptor instead.
+func (PricingStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{13}
+}
+
+type DocumentType int32
+
+const (
+	DocumentType_DOCUMENT_TYPE_UNSPECIFIED     DocumentType = 0
+	DocumentType_DOCUMENT_TYPE_PASSPORT        DocumentType = 1
+	DocumentType_DOCUMENT_TYPE_NATIONAL_ID     DocumentType = 2
+	DocumentType_DOCUMENT_TYPE_DRIVING_LICENCE DocumentType = 3
+)
+
+// Enum value maps for DocumentType.
+var (
+	DocumentType_name = map[int32]string{
+		0: "DOCUMENT_TYPE_UNSPECIFIED",
+		1: "DOCUMENT_TYPE_PASSPORT",
+		2: "DOCUMENT_TYPE_NATIONAL_ID",
+		3: "DOCUMENT_TYPE_DRIVING_LICENCE",
+	}
+	DocumentType_value = map[string]int32{
+		"DOCUMENT_TYPE_UNSPECIFIED":     0,
+		"DOCUMENT_TYPE_PASSPORT":        1,
+		"DOCUMENT_TYPE_NATIONAL_ID":     2,
+		"DOCUMENT_TYPE_DRIVING_LICENCE": 3,
+	}
+)
+
+func (x DocumentType) Enum() *DocumentType {
+	p := new(DocumentType)
+	*p = x
+	return p
+}
+
+func (x DocumentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DocumentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[14].Descriptor()
+}
+
+func (DocumentType) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[14]
+}
+
+func (x DocumentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DocumentType.Descriptor instead.
+func (DocumentType) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{14}
+}
+
+type GuestMatchKey int32
+
+const (
+	GuestMatchKey_GUEST_MATCH_KEY_UNSPECIFIED GuestMatchKey = 0
+	GuestMatchKey_GUEST_MATCH_KEY_EMAIL       GuestMatchKey = 1
+	GuestMatchKey_GUEST_MATCH_KEY_PHONE       GuestMatchKey = 2
+	GuestMatchKey_GUEST_MATCH_KEY_DOCUMENT    GuestMatchKey = 3
+	GuestMatchKey_GUEST_MATCH_KEY_NAME        GuestMatchKey = 4
+)
+
+// Enum value maps for GuestMatchKey.
+var (
+	GuestMatchKey_name = map[int32]string{
+		0: "GUEST_MATCH_KEY_UNSPECIFIED",
+		1: "GUEST_MATCH_KEY_EMAIL",
+		2: "GUEST_MATCH_KEY_PHONE",
+		3: "GUEST_MATCH_KEY_DOCUMENT",
+		4: "GUEST_MATCH_KEY_NAME",
+	}
+	GuestMatchKey_value = map[string]int32{
+		"GUEST_MATCH_KEY_UNSPECIFIED": 0,
+		"GUEST_MATCH_KEY_EMAIL":       1,
+		"GUEST_MATCH_KEY_PHONE":       2,
+		"GUEST_MATCH_KEY_DOCUMENT":    3,
+		"GUEST_MATCH_KEY_NAME":        4,
+	}
+)
+
+func (x GuestMatchKey) Enum() *GuestMatchKey {
+	p := new(GuestMatchKey)
+	*p = x
+	return p
+}
+
+func (x GuestMatchKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GuestMatchKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[15].Descriptor()
+}
+
+func (GuestMatchKey) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[15]
+}
+
+func (x GuestMatchKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GuestMatchKey.Descriptor instead.
+func (GuestMatchKey) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{15}
+}
+
+type DepositKind int32
+
+const (
+	DepositKind_DEPOSIT_KIND_UNSPECIFIED DepositKind = 0
+	// процент полной стоимости
+	DepositKind_DEPOSIT_KIND_PERCENT DepositKind = 1
+	// цена первой ночи
+	DepositKind_DEPOSIT_KIND_FIRST_NIGHT DepositKind = 2
+)
+
+// Enum value maps for DepositKind.
+var (
+	DepositKind_name = map[int32]string{
+		0: "DEPOSIT_KIND_UNSPECIFIED",
+		1: "DEPOSIT_KIND_PERCENT",
+		2: "DEPOSIT_KIND_FIRST_NIGHT",
+	}
+	DepositKind_value = map[string]int32{
+		"DEPOSIT_KIND_UNSPECIFIED": 0,
+		"DEPOSIT_KIND_PERCENT":     1,
+		"DEPOSIT_KIND_FIRST_NIGHT": 2,
+	}
+)
+
+func (x DepositKind) Enum() *DepositKind {
+	p := new(DepositKind)
+	*p = x
+	return p
+}
+
+func (x DepositKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DepositKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[16].Descriptor()
+}
+
+func (DepositKind) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[16]
+}
+
+func (x DepositKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepositKind.Descriptor instead.
+func (DepositKind) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{16}
+}
+
+type Weekday int32
+
+const (
+	Weekday_WEEKDAY_UNSPECIFIED Weekday = 0
+	Weekday_WEEKDAY_MONDAY      Weekday = 1
+	Weekday_WEEKDAY_TUESDAY     Weekday = 2
+	Weekday_WEEKDAY_WEDNESDAY   Weekday = 3
+	Weekday_WEEKDAY_THURSDAY    Weekday = 4
+	Weekday_WEEKDAY_FRIDAY      Weekday = 5
+	Weekday_WEEKDAY_SATURDAY    Weekday = 6
+	Weekday_WEEKDAY_SUNDAY      Weekday = 7
+)
+
+// Enum value maps for Weekday.
+var (
+	Weekday_name = map[int32]string{
+		0: "WEEKDAY_UNSPECIFIED",
+		1: "WEEKDAY_MONDAY",
+		2: "WEEKDAY_TUESDAY",
+		3: "WEEKDAY_WEDNESDAY",
+		4: "WEEKDAY_THURSDAY",
+		5: "WEEKDAY_FRIDAY",
+		6: "WEEKDAY_SATURDAY",
+		7: "WEEKDAY_SUNDAY",
+	}
+	Weekday_value = map[string]int32{
+		"WEEKDAY_UNSPECIFIED": 0,
+		"WEEKDAY_MONDAY":      1,
+		"WEEKDAY_TUESDAY":     2,
+		"WEEKDAY_WEDNESDAY":   3,
+		"WEEKDAY_THURSDAY":    4,
+		"WEEKDAY_FRIDAY":      5,
+		"WEEKDAY_SATURDAY":    6,
+		"WEEKDAY_SUNDAY":      7,
+	}
+)
+
+func (x Weekday) Enum() *Weekday {
+	p := new(Weekday)
+	*p = x
+	return p
+}
+
+func (x Weekday) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weekday) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_service_proto_enumTypes[17].Descriptor()
+}
+
+func (Weekday) Type() protoreflect.EnumType {
+	return &file_booking_service_proto_enumTypes[17]
+}
+
+func (x Weekday) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weekday.Descriptor instead.
+func (Weekday) EnumDescriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{17}
+}
+
 type CreateHotelRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// не задана — бесплатная отмена за 24 часа, позже штраф в одну ночь
+	CancellationPolicy *CancellationPolicy `protobuf:"bytes,2,opt,name=cancellation_policy,json=cancellationPolicy,proto3" json:"cancellation_policy,omitempty"`
+	// код ISO 4217; в этой валюте задаются все цены и налоги отеля.
+	// Не задан — базовая валюта сервиса (pricing.base_currency)
+	Currency      string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHotelRequest) Reset() {
+	*x = CreateHotelRequest{}
+	mi := &file_booking_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHotelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHotelRequest) ProtoMessage() {}
+
+func (x *CreateHotelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHotelRequest.ProtoReflect.Descriptor instead.
+func (*CreateHotelRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateHotelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateHotelRequest) GetCancellationPolicy() *CancellationPolicy {
+	if x != nil {
+		return x.CancellationPolicy
+	}
+	return nil
+}
+
+func (x *CreateHotelRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CreateHotelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hotel         *Hotel                 `protobuf:"bytes,1,opt,name=hotel,proto3" json:"hotel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHotelResponse) Reset() {
+	*x = CreateHotelResponse{}
+	mi := &file_booking_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHotelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHotelResponse) ProtoMessage() {}
+
+func (x *CreateHotelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHotelResponse.ProtoReflect.Descriptor instead.
+func (*CreateHotelResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateHotelResponse) GetHotel() *Hotel {
+	if x != nil {
+		return x.Hotel
+	}
+	return nil
+}
+
+type CreateRoomRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Dto           []*CreateRoomRequest_DTO `protobuf:"bytes,1,rep,name=dto,proto3" json:"dto,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	mi := &file_booking_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateRoomRequest) GetDto() []*CreateRoomRequest_DTO {
+	if x != nil {
+		return x.Dto
+	}
+	return nil
+}
+
+type CreateRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomResponse) Reset() {
+	*x = CreateRoomResponse{}
+	mi := &file_booking_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomResponse) ProtoMessage() {}
+
+func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type UpdateRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        uint64                 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Number        string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	HotelId       uint64                 `protobuf:"varint,4,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoomRequest) Reset() {
+	*x = UpdateRoomRequest{}
+	mi := &file_booking_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomRequest) ProtoMessage() {}
+
+func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateRoomRequest) GetRoomId() uint64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *UpdateRoomRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UpdateRoomRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+type UpdateRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoomResponse) Reset() {
+	*x = UpdateRoomResponse{}
+	mi := &file_booking_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomResponse) ProtoMessage() {}
+
+func (x *UpdateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateRoomResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type CreateBookingRequest struct {
+	state     protoimpl.MessageState       `protogen:"open.v1"`
+	RoomId    uint64                       `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	StartDate *timestamppb.Timestamp       `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp       `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Comment   string                       `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Guests    []*CreateBookingRequestGuest `protobuf:"bytes,5,rep,name=guests,proto3" json:"guests,omitempty"`
+	// токен из QuoteStay; без него цена считается по текущим тарифам
+	QuoteToken string `protobuf:"bytes,6,opt,name=quote_token,json=quoteToken,proto3" json:"quote_token,omitempty"`
+	// с quote_token должен совпадать с промокодом предложения
+	PromoCode     string `protobuf:"bytes,7,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_booking_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateBookingRequest) GetRoomId() uint64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *CreateBookingRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetGuests() []*CreateBookingRequestGuest {
+	if x != nil {
+		return x.Guests
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetQuoteToken() string {
+	if x != nil {
+		return x.QuoteToken
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+type CreateBookingResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Booking *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	// токен доступа гостя к бронированию и к профилям гостей, созданным вместе
+	// с ним; передается в X-Booking-Token
+	AccessToken   string `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBookingResponse) Reset() {
+	*x = CreateBookingResponse{}
+	mi := &file_booking_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingResponse) ProtoMessage() {}
+
+func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *CreateBookingResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_booking_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelBookingRequest) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_booking_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{9}
+}
+
+type ModifyBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyBookingRequest) Reset() {
+	*x = ModifyBookingRequest{}
+	mi := &file_booking_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyBookingRequest) ProtoMessage() {}
+
+func (x *ModifyBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyBookingRequest.ProtoReflect.Descriptor instead.
+func (*ModifyBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ModifyBookingRequest) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *ModifyBookingRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ModifyBookingRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type ModifyBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyBookingResponse) Reset() {
+	*x = ModifyBookingResponse{}
+	mi := &file_booking_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyBookingResponse) ProtoMessage() {}
+
+func (x *ModifyBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyBookingResponse.ProtoReflect.Descriptor instead.
+func (*ModifyBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ModifyBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type ListMyBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyBookingsRequest) Reset() {
+	*x = ListMyBookingsRequest{}
+	mi := &file_booking_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBookingsRequest) ProtoMessage() {}
+
+func (x *ListMyBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{12}
+}
+
+type ListMyBookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyBookingsResponse) Reset() {
+	*x = ListMyBookingsResponse{}
+	mi := &file_booking_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBookingsResponse) ProtoMessage() {}
+
+func (x *ListMyBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListMyBookingsResponse) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+// Гость с теми же email, телефоном или документом и похожим именем не
+// создается заново: возвращается существующий профиль
+type CreateGuestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Document      *IdentityDocument      `protobuf:"bytes,4,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuestRequest) Reset() {
+	*x = CreateGuestRequest{}
+	mi := &file_booking_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestRequest) ProtoMessage() {}
+
+func (x *CreateGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestRequest.ProtoReflect.Descriptor instead.
+func (*CreateGuestRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateGuestRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGuestRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateGuestRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateGuestRequest) GetDocument() *IdentityDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type CreateGuestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guest         *Guest                 `protobuf:"bytes,1,opt,name=guest,proto3" json:"guest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuestResponse) Reset() {
+	*x = CreateGuestResponse{}
+	mi := &file_booking_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestResponse) ProtoMessage() {}
+
+func (x *CreateGuestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestResponse.ProtoReflect.Descriptor instead.
+func (*CreateGuestResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateGuestResponse) GetGuest() *Guest {
+	if x != nil {
+		return x.Guest
+	}
+	return nil
+}
+
+type GetGuestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuestId       uint64                 `protobuf:"varint,1,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuestRequest) Reset() {
+	*x = GetGuestRequest{}
+	mi := &file_booking_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuestRequest) ProtoMessage() {}
+
+func (x *GetGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuestRequest.ProtoReflect.Descriptor instead.
+func (*GetGuestRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetGuestRequest) GetGuestId() uint64 {
+	if x != nil {
+		return x.GuestId
+	}
+	return 0
+}
+
+type GetGuestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guest         *Guest                 `protobuf:"bytes,1,opt,name=guest,proto3" json:"guest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGuestResponse) Reset() {
+	*x = GetGuestResponse{}
+	mi := &file_booking_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGuestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGuestResponse) ProtoMessage() {}
+
+func (x *GetGuestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGuestResponse.ProtoReflect.Descriptor instead.
+func (*GetGuestResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetGuestResponse) GetGuest() *Guest {
+	if x != nil {
+		return x.Guest
+	}
+	return nil
+}
+
+type UpdateGuestRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GuestId uint64                 `protobuf:"varint,1,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email   string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// в формате E.164, например +14155552671
+	Phone       string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	DateOfBirth *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	// код страны ISO 3166-1 alpha-2
+	Nationality   string            `protobuf:"bytes,6,opt,name=nationality,proto3" json:"nationality,omitempty"`
+	Address       *Address          `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	Document      *IdentityDocument `protobuf:"bytes,8,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGuestRequest) Reset() {
+	*x = UpdateGuestRequest{}
+	mi := &file_booking_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGuestRequest) ProtoMessage() {}
+
+func (x *UpdateGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGuestRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGuestRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateGuestRequest) GetGuestId() uint64 {
+	if x != nil {
+		return x.GuestId
+	}
+	return 0
+}
+
+func (x *UpdateGuestRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGuestRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateGuestRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateGuestRequest) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *UpdateGuestRequest) GetNationality() string {
+	if x != nil {
+		return x.Nationality
+	}
+	return ""
+}
+
+func (x *UpdateGuestRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *UpdateGuestRequest) GetDocument() *IdentityDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type UpdateGuestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guest         *Guest                 `protobuf:"bytes,1,opt,name=guest,proto3" json:"guest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGuestResponse) Reset() {
+	*x = UpdateGuestResponse{}
+	mi := &file_booking_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGuestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGuestResponse) ProtoMessage() {}
+
+func (x *UpdateGuestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGuestResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGuestResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateGuestResponse) GetGuest() *Guest {
+	if x != nil {
+		return x.Guest
+	}
+	return nil
+}
+
+type SearchGuestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// подстрока имени без учета регистра
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email          string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone          string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	DocumentNumber string `protobuf:"bytes,4,opt,name=document_number,json=documentNumber,proto3" json:"document_number,omitempty"`
+	PageSize       int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken      string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// без hotel_id гостей всех отелей ищет только администратор
+	HotelId       uint64 `protobuf:"varint,7,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchGuestsRequest) Reset() {
+	*x = SearchGuestsRequest{}
+	mi := &file_booking_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchGuestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGuestsRequest) ProtoMessage() {}
+
+func (x *SearchGuestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGuestsRequest.ProtoReflect.Descriptor instead.
+func (*SearchGuestsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SearchGuestsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchGuestsRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SearchGuestsRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *SearchGuestsRequest) GetDocumentNumber() string {
+	if x != nil {
+		return x.DocumentNumber
+	}
+	return ""
+}
+
+func (x *SearchGuestsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchGuestsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *SearchGuestsRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+type SearchGuestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guests        []*Guest               `protobuf:"bytes,1,rep,name=guests,proto3" json:"guests,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchGuestsResponse) Reset() {
+	*x = SearchGuestsResponse{}
+	mi := &file_booking_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchGuestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchGuestsResponse) ProtoMessage() {}
+
+func (x *SearchGuestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchGuestsResponse.ProtoReflect.Descriptor instead.
+func (*SearchGuestsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchGuestsResponse) GetGuests() []*Guest {
+	if x != nil {
+		return x.Guests
+	}
+	return nil
+}
+
+func (x *SearchGuestsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MergeGuestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// гость, который остается
+	TargetGuestId uint64 `protobuf:"varint,1,opt,name=target_guest_id,json=targetGuestId,proto3" json:"target_guest_id,omitempty"`
+	// дубликат, который удаляется
+	SourceGuestId uint64 `protobuf:"varint,2,opt,name=source_guest_id,json=sourceGuestId,proto3" json:"source_guest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeGuestsRequest) Reset() {
+	*x = MergeGuestsRequest{}
+	mi := &file_booking_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeGuestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGuestsRequest) ProtoMessage() {}
+
+func (x *MergeGuestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGuestsRequest.ProtoReflect.Descriptor instead.
+func (*MergeGuestsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MergeGuestsRequest) GetTargetGuestId() uint64 {
+	if x != nil {
+		return x.TargetGuestId
+	}
+	return 0
+}
+
+func (x *MergeGuestsRequest) GetSourceGuestId() uint64 {
+	if x != nil {
+		return x.SourceGuestId
+	}
+	return 0
+}
+
+type MergeGuestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guest         *Guest                 `protobuf:"bytes,1,opt,name=guest,proto3" json:"guest,omitempty"`
+	BookingsMoved uint32                 `protobuf:"varint,2,opt,name=bookings_moved,json=bookingsMoved,proto3" json:"bookings_moved,omitempty"`
+	ReviewsMoved  uint32                 `protobuf:"varint,3,opt,name=reviews_moved,json=reviewsMoved,proto3" json:"reviews_moved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeGuestsResponse) Reset() {
+	*x = MergeGuestsResponse{}
+	mi := &file_booking_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeGuestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGuestsResponse) ProtoMessage() {}
+
+func (x *MergeGuestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGuestsResponse.ProtoReflect.Descriptor instead.
+func (*MergeGuestsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MergeGuestsResponse) GetGuest() *Guest {
+	if x != nil {
+		return x.Guest
+	}
+	return nil
+}
+
+func (x *MergeGuestsResponse) GetBookingsMoved() uint32 {
+	if x != nil {
+		return x.BookingsMoved
+	}
+	return 0
+}
+
+func (x *MergeGuestsResponse) GetReviewsMoved() uint32 {
+	if x != nil {
+		return x.ReviewsMoved
+	}
+	return 0
+}
+
+type ExportGuestDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuestId       uint64                 `protobuf:"varint,1,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportGuestDataRequest) Reset() {
+	*x = ExportGuestDataRequest{}
+	mi := &file_booking_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportGuestDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGuestDataRequest) ProtoMessage() {}
+
+func (x *ExportGuestDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGuestDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportGuestDataRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportGuestDataRequest) GetGuestId() uint64 {
+	if x != nil {
+		return x.GuestId
+	}
+	return 0
+}
+
+// Содержимое ответа ExportGuestData
+type GuestDataExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportedAt    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	Guest         *Guest                 `protobuf:"bytes,2,opt,name=guest,proto3" json:"guest,omitempty"`
+	Bookings      []*Booking             `protobuf:"bytes,3,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	Reviews       []*Review              `protobuf:"bytes,4,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestDataExport) Reset() {
+	*x = GuestDataExport{}
+	mi := &file_booking_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestDataExport) ProtoMessage() {}
+
+func (x *GuestDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestDataExport.ProtoReflect.Descriptor instead.
+func (*GuestDataExport) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GuestDataExport) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+func (x *GuestDataExport) GetGuest() *Guest {
+	if x != nil {
+		return x.Guest
+	}
+	return nil
+}
+
+func (x *GuestDataExport) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *GuestDataExport) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+type EraseGuestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuestId       uint64                 `protobuf:"varint,1,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseGuestRequest) Reset() {
+	*x = EraseGuestRequest{}
+	mi := &file_booking_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseGuestRequest) ProtoMessage() {}
+
+func (x *EraseGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseGuestRequest.ProtoReflect.Descriptor instead.
+func (*EraseGuestRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EraseGuestRequest) GetGuestId() uint64 {
+	if x != nil {
+		return x.GuestId
+	}
+	return 0
+}
+
+type EraseGuestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Guest         *Guest                 `protobuf:"bytes,1,opt,name=guest,proto3" json:"guest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseGuestResponse) Reset() {
+	*x = EraseGuestResponse{}
+	mi := &file_booking_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseGuestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseGuestResponse) ProtoMessage() {}
+
+func (x *EraseGuestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseGuestResponse.ProtoReflect.Descriptor instead.
+func (*EraseGuestResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EraseGuestResponse) GetGuest() *Guest {
+	if x != nil {
+		return x.Guest
+	}
+	return nil
+}
+
+type ListDuplicateGuestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuplicateGuestsRequest) Reset() {
+	*x = ListDuplicateGuestsRequest{}
+	mi := &file_booking_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateGuestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateGuestsRequest) ProtoMessage() {}
+
+func (x *ListDuplicateGuestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateGuestsRequest.ProtoReflect.Descriptor instead.
+func (*ListDuplicateGuestsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListDuplicateGuestsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListDuplicateGuestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Duplicates    []*DuplicateGuests     `protobuf:"bytes,1,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuplicateGuestsResponse) Reset() {
+	*x = ListDuplicateGuestsResponse{}
+	mi := &file_booking_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateGuestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateGuestsResponse) ProtoMessage() {}
+
+func (x *ListDuplicateGuestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateGuestsResponse.ProtoReflect.Descriptor instead.
+func (*ListDuplicateGuestsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListDuplicateGuestsResponse) GetDuplicates() []*DuplicateGuests {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+// Пара возможных дубликатов; guest создан раньше duplicate
+type DuplicateGuests struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Guest     *Guest                 `protobuf:"bytes,1,opt,name=guest,proto3" json:"guest,omitempty"`
+	Duplicate *Guest                 `protobuf:"bytes,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	MatchedBy []GuestMatchKey        `protobuf:"varint,3,rep,packed,name=matched_by,json=matchedBy,proto3,enum=booking_service.GuestMatchKey" json:"matched_by,omitempty"`
+	// сходство имен от 0 до 1
+	NameSimilarity float64 `protobuf:"fixed64,4,opt,name=name_similarity,json=nameSimilarity,proto3" json:"name_similarity,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DuplicateGuests) Reset() {
+	*x = DuplicateGuests{}
+	mi := &file_booking_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateGuests) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateGuests) ProtoMessage() {}
+
+func (x *DuplicateGuests) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateGuests.ProtoReflect.Descriptor instead.
+func (*DuplicateGuests) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DuplicateGuests) GetGuest() *Guest {
+	if x != nil {
+		return x.Guest
+	}
+	return nil
+}
+
+func (x *DuplicateGuests) GetDuplicate() *Guest {
+	if x != nil {
+		return x.Duplicate
+	}
+	return nil
+}
+
+func (x *DuplicateGuests) GetMatchedBy() []GuestMatchKey {
+	if x != nil {
+		return x.MatchedBy
+	}
+	return nil
+}
+
+func (x *DuplicateGuests) GetNameSimilarity() float64 {
+	if x != nil {
+		return x.NameSimilarity
+	}
+	return 0
+}
+
+type SubmitReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	GuestId       uint64                 `protobuf:"varint,2,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReviewRequest) Reset() {
+	*x = SubmitReviewRequest{}
+	mi := &file_booking_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReviewRequest) ProtoMessage() {}
+
+func (x *SubmitReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitReviewRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SubmitReviewRequest) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *SubmitReviewRequest) GetGuestId() uint64 {
+	if x != nil {
+		return x.GuestId
+	}
+	return 0
+}
+
+func (x *SubmitReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SubmitReviewRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type SubmitReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReviewResponse) Reset() {
+	*x = SubmitReviewResponse{}
+	mi := &file_booking_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReviewResponse) ProtoMessage() {}
+
+func (x *SubmitReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReviewResponse.ProtoReflect.Descriptor instead.
+func (*SubmitReviewResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SubmitReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type UpdateRoomStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        uint64                 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Status        RoomStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=booking_service.RoomStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoomStatusRequest) Reset() {
+	*x = UpdateRoomStatusRequest{}
+	mi := &file_booking_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomStatusRequest) ProtoMessage() {}
+
+func (x *UpdateRoomStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoomStatusRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateRoomStatusRequest) GetRoomId() uint64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *UpdateRoomStatusRequest) GetStatus() RoomStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RoomStatus_ROOM_STATUS_UNKNOWN
+}
+
+type UpdateRoomStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoomStatusResponse) Reset() {
+	*x = UpdateRoomStatusResponse{}
+	mi := &file_booking_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoomStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoomStatusResponse) ProtoMessage() {}
+
+func (x *UpdateRoomStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoomStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoomStatusResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateRoomStatusResponse) GetRoom() *Room {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type CreateEmployeeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role  EmployeeRole           `protobuf:"varint,2,opt,name=role,proto3,enum=booking_service.EmployeeRole" json:"role,omitempty"`
+	// 0 допустим только для роли администратора
+	HotelId uint64 `protobuf:"varint,3,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	// subject из JWT, по которому сотрудник сопоставляется с токеном
+	Subject       string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmployeeRequest) Reset() {
+	*x = CreateEmployeeRequest{}
+	mi := &file_booking_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmployeeRequest) ProtoMessage() {}
+
+func (x *CreateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*CreateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateEmployeeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEmployeeRequest) GetRole() EmployeeRole {
+	if x != nil {
+		return x.Role
+	}
+	return EmployeeRole_EMPLOYEE_ROLE_UNKNOWN
+}
+
+func (x *CreateEmployeeRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *CreateEmployeeRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type CreateEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmployeeResponse) Reset() {
+	*x = CreateEmployeeResponse{}
+	mi := &file_booking_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmployeeResponse) ProtoMessage() {}
+
+func (x *CreateEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*CreateEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+type GetEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    uint64                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeRequest) Reset() {
+	*x = GetEmployeeRequest{}
+	mi := &file_booking_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeRequest) ProtoMessage() {}
+
+func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*GetEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetEmployeeRequest) GetEmployeeId() uint64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+type GetEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmployeeResponse) Reset() {
+	*x = GetEmployeeResponse{}
+	mi := &file_booking_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmployeeResponse) ProtoMessage() {}
+
+func (x *GetEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*GetEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+type ListEmployeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployeesRequest) Reset() {
+	*x = ListEmployeesRequest{}
+	mi := &file_booking_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployeesRequest) ProtoMessage() {}
+
+func (x *ListEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ListEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListEmployeesRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+type ListEmployeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployeesResponse) Reset() {
+	*x = ListEmployeesResponse{}
+	mi := &file_booking_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployeesResponse) ProtoMessage() {}
+
+func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*ListEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListEmployeesResponse) GetEmployees() []*Employee {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
+}
+
+type UpdateEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    uint64                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role          EmployeeRole           `protobuf:"varint,3,opt,name=role,proto3,enum=booking_service.EmployeeRole" json:"role,omitempty"`
+	HotelId       uint64                 `protobuf:"varint,4,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Subject       string                 `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmployeeRequest) Reset() {
+	*x = UpdateEmployeeRequest{}
+	mi := &file_booking_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployeeRequest) ProtoMessage() {}
+
+func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateEmployeeRequest) GetEmployeeId() uint64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *UpdateEmployeeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeRequest) GetRole() EmployeeRole {
+	if x != nil {
+		return x.Role
+	}
+	return EmployeeRole_EMPLOYEE_ROLE_UNKNOWN
+}
+
+func (x *UpdateEmployeeRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *UpdateEmployeeRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type UpdateEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmployeeResponse) Reset() {
+	*x = UpdateEmployeeResponse{}
+	mi := &file_booking_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployeeResponse) ProtoMessage() {}
+
+func (x *UpdateEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+type DeleteEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    uint64                 `protobuf:"varint,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployeeRequest) Reset() {
+	*x = DeleteEmployeeRequest{}
+	mi := &file_booking_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployeeRequest) ProtoMessage() {}
+
+func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteEmployeeRequest) GetEmployeeId() uint64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+type DeleteEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployeeResponse) Reset() {
+	*x = DeleteEmployeeResponse{}
+	mi := &file_booking_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployeeResponse) ProtoMessage() {}
+
+func (x *DeleteEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{44}
+}
+
+type CreateRatePlanRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	HotelId      uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomType     RoomType               `protobuf:"varint,2,opt,name=room_type,json=roomType,proto3,enum=booking_service.RoomType" json:"room_type,omitempty"`
+	Name         string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	NightlyPrice *Money                 `protobuf:"bytes,4,opt,name=nightly_price,json=nightlyPrice,proto3" json:"nightly_price,omitempty"`
+	ValidFrom    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// пусто — тариф бессрочный
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRatePlanRequest) Reset() {
+	*x = CreateRatePlanRequest{}
+	mi := &file_booking_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRatePlanRequest) ProtoMessage() {}
+
+func (x *CreateRatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRatePlanRequest.ProtoReflect.Descriptor instead.
+func (*CreateRatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateRatePlanRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *CreateRatePlanRequest) GetRoomType() RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return RoomType_ROOM_TYPE_UNKNOWN
+}
+
+func (x *CreateRatePlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRatePlanRequest) GetNightlyPrice() *Money {
+	if x != nil {
+		return x.NightlyPrice
+	}
+	return nil
+}
+
+func (x *CreateRatePlanRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *CreateRatePlanRequest) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+type CreateRatePlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatePlan      *RatePlan              `protobuf:"bytes,1,opt,name=rate_plan,json=ratePlan,proto3" json:"rate_plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRatePlanResponse) Reset() {
+	*x = CreateRatePlanResponse{}
+	mi := &file_booking_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRatePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRatePlanResponse) ProtoMessage() {}
+
+func (x *CreateRatePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRatePlanResponse.ProtoReflect.Descriptor instead.
+func (*CreateRatePlanResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateRatePlanResponse) GetRatePlan() *RatePlan {
+	if x != nil {
+		return x.RatePlan
+	}
+	return nil
+}
+
+type ListRatePlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRatePlansRequest) Reset() {
+	*x = ListRatePlansRequest{}
+	mi := &file_booking_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRatePlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRatePlansRequest) ProtoMessage() {}
+
+func (x *ListRatePlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRatePlansRequest.ProtoReflect.Descriptor instead.
+func (*ListRatePlansRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListRatePlansRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+type ListRatePlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatePlans     []*RatePlan            `protobuf:"bytes,1,rep,name=rate_plans,json=ratePlans,proto3" json:"rate_plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRatePlansResponse) Reset() {
+	*x = ListRatePlansResponse{}
+	mi := &file_booking_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRatePlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRatePlansResponse) ProtoMessage() {}
+
+func (x *ListRatePlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRatePlansResponse.ProtoReflect.Descriptor instead.
+func (*ListRatePlansResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListRatePlansResponse) GetRatePlans() []*RatePlan {
+	if x != nil {
+		return x.RatePlans
+	}
+	return nil
+}
+
+type UpdateRatePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatePlanId    uint64                 `protobuf:"varint,1,opt,name=rate_plan_id,json=ratePlanId,proto3" json:"rate_plan_id,omitempty"`
+	RoomType      RoomType               `protobuf:"varint,2,opt,name=room_type,json=roomType,proto3,enum=booking_service.RoomType" json:"room_type,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	NightlyPrice  *Money                 `protobuf:"bytes,4,opt,name=nightly_price,json=nightlyPrice,proto3" json:"nightly_price,omitempty"`
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRatePlanRequest) Reset() {
+	*x = UpdateRatePlanRequest{}
+	mi := &file_booking_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRatePlanRequest) ProtoMessage() {}
+
+func (x *UpdateRatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRatePlanRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateRatePlanRequest) GetRatePlanId() uint64 {
+	if x != nil {
+		return x.RatePlanId
+	}
+	return 0
+}
+
+func (x *UpdateRatePlanRequest) GetRoomType() RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return RoomType_ROOM_TYPE_UNKNOWN
+}
+
+func (x *UpdateRatePlanRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRatePlanRequest) GetNightlyPrice() *Money {
+	if x != nil {
+		return x.NightlyPrice
+	}
+	return nil
+}
+
+func (x *UpdateRatePlanRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *UpdateRatePlanRequest) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+type UpdateRatePlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatePlan      *RatePlan              `protobuf:"bytes,1,opt,name=rate_plan,json=ratePlan,proto3" json:"rate_plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRatePlanResponse) Reset() {
+	*x = UpdateRatePlanResponse{}
+	mi := &file_booking_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRatePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRatePlanResponse) ProtoMessage() {}
+
+func (x *UpdateRatePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRatePlanResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRatePlanResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateRatePlanResponse) GetRatePlan() *RatePlan {
+	if x != nil {
+		return x.RatePlan
+	}
+	return nil
+}
+
+type DeleteRatePlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RatePlanId    uint64                 `protobuf:"varint,1,opt,name=rate_plan_id,json=ratePlanId,proto3" json:"rate_plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRatePlanRequest) Reset() {
+	*x = DeleteRatePlanRequest{}
+	mi := &file_booking_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRatePlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRatePlanRequest) ProtoMessage() {}
+
+func (x *DeleteRatePlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRatePlanRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRatePlanRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteRatePlanRequest) GetRatePlanId() uint64 {
+	if x != nil {
+		return x.RatePlanId
+	}
+	return 0
+}
+
+type DeleteRatePlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRatePlanResponse) Reset() {
+	*x = DeleteRatePlanResponse{}
+	mi := &file_booking_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRatePlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRatePlanResponse) ProtoMessage() {}
+
+func (x *DeleteRatePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRatePlanResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRatePlanResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{52}
+}
+
+type SetRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Ranges        []*RateRange           `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRatesRequest) Reset() {
+	*x = SetRatesRequest{}
+	mi := &file_booking_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRatesRequest) ProtoMessage() {}
+
+func (x *SetRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRatesRequest.ProtoReflect.Descriptor instead.
+func (*SetRatesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SetRatesRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *SetRatesRequest) GetRanges() []*RateRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+type SetRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRatesResponse) Reset() {
+	*x = SetRatesResponse{}
+	mi := &file_booking_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRatesResponse) ProtoMessage() {}
+
+func (x *SetRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRatesResponse.ProtoReflect.Descriptor instead.
+func (*SetRatesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{54}
+}
+
+type GetRateCalendarRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	HotelId   uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomType  RoomType               `protobuf:"varint,2,opt,name=room_type,json=roomType,proto3,enum=booking_service.RoomType" json:"room_type,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// первая ночь, не попадающая в календарь
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateCalendarRequest) Reset() {
+	*x = GetRateCalendarRequest{}
+	mi := &file_booking_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateCalendarRequest) ProtoMessage() {}
+
+func (x *GetRateCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetRateCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetRateCalendarRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *GetRateCalendarRequest) GetRoomType() RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return RoomType_ROOM_TYPE_UNKNOWN
+}
+
+func (x *GetRateCalendarRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetRateCalendarRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type GetRateCalendarResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nights        []*CalendarNight       `protobuf:"bytes,1,rep,name=nights,proto3" json:"nights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateCalendarResponse) Reset() {
+	*x = GetRateCalendarResponse{}
+	mi := &file_booking_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateCalendarResponse) ProtoMessage() {}
+
+func (x *GetRateCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetRateCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetRateCalendarResponse) GetNights() []*CalendarNight {
+	if x != nil {
+		return x.Nights
+	}
+	return nil
+}
+
+type SetPricingRuleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HotelId        uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomType       RoomType               `protobuf:"varint,2,opt,name=room_type,json=roomType,proto3,enum=booking_service.RoomType" json:"room_type,omitempty"`
+	Strategy       PricingStrategy        `protobuf:"varint,3,opt,name=strategy,proto3,enum=booking_service.PricingStrategy" json:"strategy,omitempty"`
+	OccupancyTiers []*OccupancyTier       `protobuf:"bytes,4,rep,name=occupancy_tiers,json=occupancyTiers,proto3" json:"occupancy_tiers,omitempty"`
+	// не заданы — цена не ограничивается
+	MinPrice      *Money `protobuf:"bytes,5,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice      *Money `protobuf:"bytes,6,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPricingRuleRequest) Reset() {
+	*x = SetPricingRuleRequest{}
+	mi := &file_booking_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPricingRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPricingRuleRequest) ProtoMessage() {}
+
+func (x *SetPricingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPricingRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetPricingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetPricingRuleRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *SetPricingRuleRequest) GetRoomType() RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return RoomType_ROOM_TYPE_UNKNOWN
+}
+
+func (x *SetPricingRuleRequest) GetStrategy() PricingStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return PricingStrategy_PRICING_STRATEGY_UNSPECIFIED
+}
+
+func (x *SetPricingRuleRequest) GetOccupancyTiers() []*OccupancyTier {
+	if x != nil {
+		return x.OccupancyTiers
+	}
+	return nil
+}
+
+func (x *SetPricingRuleRequest) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *SetPricingRuleRequest) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+type SetPricingRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *PricingRule           `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPricingRuleResponse) Reset() {
+	*x = SetPricingRuleResponse{}
+	mi := &file_booking_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPricingRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPricingRuleResponse) ProtoMessage() {}
+
+func (x *SetPricingRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPricingRuleResponse.ProtoReflect.Descriptor instead.
+func (*SetPricingRuleResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetPricingRuleResponse) GetRule() *PricingRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type ListPricingRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPricingRulesRequest) Reset() {
+	*x = ListPricingRulesRequest{}
+	mi := &file_booking_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPricingRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPricingRulesRequest) ProtoMessage() {}
+
+func (x *ListPricingRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPricingRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListPricingRulesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListPricingRulesRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+type ListPricingRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*PricingRule         `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPricingRulesResponse) Reset() {
+	*x = ListPricingRulesResponse{}
+	mi := &file_booking_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPricingRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPricingRulesResponse) ProtoMessage() {}
+
+func (x *ListPricingRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPricingRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListPricingRulesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListPricingRulesResponse) GetRules() []*PricingRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type DeletePricingRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomType      RoomType               `protobuf:"varint,2,opt,name=room_type,json=roomType,proto3,enum=booking_service.RoomType" json:"room_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePricingRuleRequest) Reset() {
+	*x = DeletePricingRuleRequest{}
+	mi := &file_booking_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePricingRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePricingRuleRequest) ProtoMessage() {}
+
+func (x *DeletePricingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePricingRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeletePricingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeletePricingRuleRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *DeletePricingRuleRequest) GetRoomType() RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return RoomType_ROOM_TYPE_UNKNOWN
+}
+
+type DeletePricingRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePricingRuleResponse) Reset() {
+	*x = DeletePricingRuleResponse{}
+	mi := &file_booking_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePricingRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePricingRuleResponse) ProtoMessage() {}
+
+func (x *DeletePricingRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePricingRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeletePricingRuleResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{62}
+}
+
+type SetDepositRuleRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	HotelId uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Kind    DepositKind            `protobuf:"varint,2,opt,name=kind,proto3,enum=booking_service.DepositKind" json:"kind,omitempty"`
+	// для DEPOSIT_KIND_PERCENT: 3000 — 30%
+	PercentBasisPoints uint32 `protobuf:"varint,3,opt,name=percent_basis_points,json=percentBasisPoints,proto3" json:"percent_basis_points,omitempty"`
+	// остаток списывается за столько дней до заезда
+	BalanceDaysBefore uint32 `protobuf:"varint,4,opt,name=balance_days_before,json=balanceDaysBefore,proto3" json:"balance_days_before,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetDepositRuleRequest) Reset() {
+	*x = SetDepositRuleRequest{}
+	mi := &file_booking_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDepositRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDepositRuleRequest) ProtoMessage() {}
+
+func (x *SetDepositRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDepositRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetDepositRuleRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetDepositRuleRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *SetDepositRuleRequest) GetKind() DepositKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DepositKind_DEPOSIT_KIND_UNSPECIFIED
+}
+
+func (x *SetDepositRuleRequest) GetPercentBasisPoints() uint32 {
+	if x != nil {
+		return x.PercentBasisPoints
+	}
+	return 0
+}
+
+func (x *SetDepositRuleRequest) GetBalanceDaysBefore() uint32 {
+	if x != nil {
+		return x.BalanceDaysBefore
+	}
+	return 0
+}
+
+type SetDepositRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *DepositRule           `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDepositRuleResponse) Reset() {
+	*x = SetDepositRuleResponse{}
+	mi := &file_booking_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDepositRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDepositRuleResponse) ProtoMessage() {}
+
+func (x *SetDepositRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDepositRuleResponse.ProtoReflect.Descriptor instead.
+func (*SetDepositRuleResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetDepositRuleResponse) GetRule() *DepositRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type GetDepositRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepositRuleRequest) Reset() {
+	*x = GetDepositRuleRequest{}
+	mi := &file_booking_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepositRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepositRuleRequest) ProtoMessage() {}
+
+func (x *GetDepositRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepositRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetDepositRuleRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetDepositRuleRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+type GetDepositRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *DepositRule           `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepositRuleResponse) Reset() {
+	*x = GetDepositRuleResponse{}
+	mi := &file_booking_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepositRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepositRuleResponse) ProtoMessage() {}
+
+func (x *GetDepositRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepositRuleResponse.ProtoReflect.Descriptor instead.
+func (*GetDepositRuleResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetDepositRuleResponse) GetRule() *DepositRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type DeleteDepositRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDepositRuleRequest) Reset() {
+	*x = DeleteDepositRuleRequest{}
+	mi := &file_booking_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDepositRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDepositRuleRequest) ProtoMessage() {}
+
+func (x *DeleteDepositRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDepositRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDepositRuleRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteDepositRuleRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+type DeleteDepositRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDepositRuleResponse) Reset() {
+	*x = DeleteDepositRuleResponse{}
+	mi := &file_booking_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDepositRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDepositRuleResponse) ProtoMessage() {}
+
+func (x *DeleteDepositRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDepositRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDepositRuleResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{68}
+}
+
+type CreateTaxRuleRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	HotelId uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Kind    TaxKind                `protobuf:"varint,2,opt,name=kind,proto3,enum=booking_service.TaxKind" json:"kind,omitempty"`
+	Name    string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// для туристического налога и сбора
+	Amount *Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// для НДС: 2000 — 20%
+	PercentBasisPoints uint32                 `protobuf:"varint,5,opt,name=percent_basis_points,json=percentBasisPoints,proto3" json:"percent_basis_points,omitempty"`
+	ValidFrom          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// не задана — бессрочно
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaxRuleRequest) Reset() {
+	*x = CreateTaxRuleRequest{}
+	mi := &file_booking_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaxRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaxRuleRequest) ProtoMessage() {}
+
+func (x *CreateTaxRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaxRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaxRuleRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateTaxRuleRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *CreateTaxRuleRequest) GetKind() TaxKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TaxKind_TAX_KIND_UNSPECIFIED
+}
+
+func (x *CreateTaxRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTaxRuleRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreateTaxRuleRequest) GetPercentBasisPoints() uint32 {
+	if x != nil {
+		return x.PercentBasisPoints
+	}
+	return 0
+}
+
+func (x *CreateTaxRuleRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *CreateTaxRuleRequest) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+type CreateTaxRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRule       *TaxRule               `protobuf:"bytes,1,opt,name=tax_rule,json=taxRule,proto3" json:"tax_rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaxRuleResponse) Reset() {
+	*x = CreateTaxRuleResponse{}
+	mi := &file_booking_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaxRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaxRuleResponse) ProtoMessage() {}
+
+func (x *CreateTaxRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaxRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaxRuleResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateTaxRuleResponse) GetTaxRule() *TaxRule {
+	if x != nil {
+		return x.TaxRule
+	}
+	return nil
+}
+
+type ListTaxRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxRulesRequest) Reset() {
+	*x = ListTaxRulesRequest{}
+	mi := &file_booking_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxRulesRequest) ProtoMessage() {}
+
+func (x *ListTaxRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaxRulesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListTaxRulesRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+type ListTaxRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRules      []*TaxRule             `protobuf:"bytes,1,rep,name=tax_rules,json=taxRules,proto3" json:"tax_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaxRulesResponse) Reset() {
+	*x = ListTaxRulesResponse{}
+	mi := &file_booking_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaxRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaxRulesResponse) ProtoMessage() {}
+
+func (x *ListTaxRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaxRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListTaxRulesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListTaxRulesResponse) GetTaxRules() []*TaxRule {
+	if x != nil {
+		return x.TaxRules
+	}
+	return nil
+}
+
+type DeleteTaxRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRuleId     uint64                 `protobuf:"varint,1,opt,name=tax_rule_id,json=taxRuleId,proto3" json:"tax_rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaxRuleRequest) Reset() {
+	*x = DeleteTaxRuleRequest{}
+	mi := &file_booking_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaxRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaxRuleRequest) ProtoMessage() {}
+
+func (x *DeleteTaxRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaxRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaxRuleRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteTaxRuleRequest) GetTaxRuleId() uint64 {
+	if x != nil {
+		return x.TaxRuleId
+	}
+	return 0
+}
+
+type DeleteTaxRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaxRuleResponse) Reset() {
+	*x = DeleteTaxRuleResponse{}
+	mi := &file_booking_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaxRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaxRuleResponse) ProtoMessage() {}
+
+func (x *DeleteTaxRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaxRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaxRuleResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{74}
+}
+
+type PayBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayBookingRequest) Reset() {
+	*x = PayBookingRequest{}
+	mi := &file_booking_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayBookingRequest) ProtoMessage() {}
+
+func (x *PayBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayBookingRequest.ProtoReflect.Descriptor instead.
+func (*PayBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PayBookingRequest) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+type PayBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayBookingResponse) Reset() {
+	*x = PayBookingResponse{}
+	mi := &file_booking_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayBookingResponse) ProtoMessage() {}
+
+func (x *PayBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayBookingResponse.ProtoReflect.Descriptor instead.
+func (*PayBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PayBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type GetFolioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFolioRequest) Reset() {
+	*x = GetFolioRequest{}
+	mi := &file_booking_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFolioRequest) ProtoMessage() {}
+
+func (x *GetFolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFolioRequest.ProtoReflect.Descriptor instead.
+func (*GetFolioRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetFolioRequest) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+type GetFolioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folio         *Folio                 `protobuf:"bytes,1,opt,name=folio,proto3" json:"folio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFolioResponse) Reset() {
+	*x = GetFolioResponse{}
+	mi := &file_booking_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFolioResponse) ProtoMessage() {}
+
+func (x *GetFolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFolioResponse.ProtoReflect.Descriptor instead.
+func (*GetFolioResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetFolioResponse) GetFolio() *Folio {
+	if x != nil {
+		return x.Folio
+	}
+	return nil
+}
+
+type PostFolioChargeRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	BookingId   uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Category    ChargeCategory         `protobuf:"varint,2,opt,name=category,proto3,enum=booking_service.ChargeCategory" json:"category,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity    uint32                 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// цена единицы в валюте отеля без налога
+	UnitPrice *Money `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// ставка налога на начисление: 2000 — 20%
+	TaxBasisPoints uint32 `protobuf:"varint,6,opt,name=tax_basis_points,json=taxBasisPoints,proto3" json:"tax_basis_points,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PostFolioChargeRequest) Reset() {
+	*x = PostFolioChargeRequest{}
+	mi := &file_booking_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostFolioChargeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostFolioChargeRequest) ProtoMessage() {}
+
+func (x *PostFolioChargeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostFolioChargeRequest.ProtoReflect.Descriptor instead.
+func (*PostFolioChargeRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *PostFolioChargeRequest) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *PostFolioChargeRequest) GetCategory() ChargeCategory {
+	if x != nil {
+		return x.Category
+	}
+	return ChargeCategory_CHARGE_CATEGORY_UNSPECIFIED
+}
+
+func (x *PostFolioChargeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PostFolioChargeRequest) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PostFolioChargeRequest) GetUnitPrice() *Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *PostFolioChargeRequest) GetTaxBasisPoints() uint32 {
+	if x != nil {
+		return x.TaxBasisPoints
+	}
+	return 0
+}
+
+type PostFolioChargeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Charge        *FolioCharge           `protobuf:"bytes,1,opt,name=charge,proto3" json:"charge,omitempty"`
+	Folio         *Folio                 `protobuf:"bytes,2,opt,name=folio,proto3" json:"folio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostFolioChargeResponse) Reset() {
+	*x = PostFolioChargeResponse{}
+	mi := &file_booking_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostFolioChargeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostFolioChargeResponse) ProtoMessage() {}
+
+func (x *PostFolioChargeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostFolioChargeResponse.ProtoReflect.Descriptor instead.
+func (*PostFolioChargeResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PostFolioChargeResponse) GetCharge() *FolioCharge {
+	if x != nil {
+		return x.Charge
+	}
+	return nil
+}
+
+func (x *PostFolioChargeResponse) GetFolio() *Folio {
+	if x != nil {
+		return x.Folio
+	}
+	return nil
+}
+
+type VoidFolioChargeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	ChargeId      uint64                 `protobuf:"varint,2,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidFolioChargeRequest) Reset() {
+	*x = VoidFolioChargeRequest{}
+	mi := &file_booking_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidFolioChargeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidFolioChargeRequest) ProtoMessage() {}
+
+func (x *VoidFolioChargeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidFolioChargeRequest.ProtoReflect.Descriptor instead.
+func (*VoidFolioChargeRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *VoidFolioChargeRequest) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *VoidFolioChargeRequest) GetChargeId() uint64 {
+	if x != nil {
+		return x.ChargeId
+	}
+	return 0
+}
+
+func (x *VoidFolioChargeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type VoidFolioChargeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folio         *Folio                 `protobuf:"bytes,1,opt,name=folio,proto3" json:"folio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidFolioChargeResponse) Reset() {
+	*x = VoidFolioChargeResponse{}
+	mi := &file_booking_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidFolioChargeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidFolioChargeResponse) ProtoMessage() {}
+
+func (x *VoidFolioChargeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidFolioChargeResponse.ProtoReflect.Descriptor instead.
+func (*VoidFolioChargeResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *VoidFolioChargeResponse) GetFolio() *Folio {
+	if x != nil {
+		return x.Folio
+	}
+	return nil
+}
+
+type SettleFolioRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BookingId uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// не задана — списывается весь остаток
+	Amount        *Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettleFolioRequest) Reset() {
+	*x = SettleFolioRequest{}
+	mi := &file_booking_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleFolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleFolioRequest) ProtoMessage() {}
+
+func (x *SettleFolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleFolioRequest.ProtoReflect.Descriptor instead.
+func (*SettleFolioRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SettleFolioRequest) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *SettleFolioRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type SettleFolioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folio         *Folio                 `protobuf:"bytes,1,opt,name=folio,proto3" json:"folio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettleFolioResponse) Reset() {
+	*x = SettleFolioResponse{}
+	mi := &file_booking_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleFolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleFolioResponse) ProtoMessage() {}
+
+func (x *SettleFolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleFolioResponse.ProtoReflect.Descriptor instead.
+func (*SettleFolioResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SettleFolioResponse) GetFolio() *Folio {
+	if x != nil {
+		return x.Folio
+	}
+	return nil
+}
+
+type CloseFolioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseFolioRequest) Reset() {
+	*x = CloseFolioRequest{}
+	mi := &file_booking_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseFolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseFolioRequest) ProtoMessage() {}
+
+func (x *CloseFolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseFolioRequest.ProtoReflect.Descriptor instead.
+func (*CloseFolioRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CloseFolioRequest) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+type CloseFolioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Folio         *Folio                 `protobuf:"bytes,1,opt,name=folio,proto3" json:"folio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseFolioResponse) Reset() {
+	*x = CloseFolioResponse{}
+	mi := &file_booking_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseFolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseFolioResponse) ProtoMessage() {}
+
+func (x *CloseFolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseFolioResponse.ProtoReflect.Descriptor instead.
+func (*CloseFolioResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CloseFolioResponse) GetFolio() *Folio {
+	if x != nil {
+		return x.Folio
+	}
+	return nil
+}
+
+type IssueInvoiceRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BookingId uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// без имени покупателем указывается первый гость бронирования
+	Buyer         *InvoiceParty `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueInvoiceRequest) Reset() {
+	*x = IssueInvoiceRequest{}
+	mi := &file_booking_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueInvoiceRequest) ProtoMessage() {}
+
+func (x *IssueInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*IssueInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *IssueInvoiceRequest) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *IssueInvoiceRequest) GetBuyer() *InvoiceParty {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+type IssueInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueInvoiceResponse) Reset() {
+	*x = IssueInvoiceResponse{}
+	mi := &file_booking_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueInvoiceResponse) ProtoMessage() {}
+
+func (x *IssueInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*IssueInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *IssueInvoiceResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+type GetInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     uint64                 `protobuf:"varint,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceRequest) Reset() {
+	*x = GetInvoiceRequest{}
+	mi := &file_booking_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceRequest) ProtoMessage() {}
+
+func (x *GetInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetInvoiceRequest) GetInvoiceId() uint64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+type GetInvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvoiceResponse) Reset() {
+	*x = GetInvoiceResponse{}
+	mi := &file_booking_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoiceResponse) ProtoMessage() {}
+
+func (x *GetInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*GetInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetInvoiceResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+type DownloadInvoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     uint64                 `protobuf:"varint,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Format        InvoiceFormat          `protobuf:"varint,2,opt,name=format,proto3,enum=booking_service.InvoiceFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadInvoiceRequest) Reset() {
+	*x = DownloadInvoiceRequest{}
+	mi := &file_booking_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadInvoiceRequest) ProtoMessage() {}
+
+func (x *DownloadInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*DownloadInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DownloadInvoiceRequest) GetInvoiceId() uint64 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *DownloadInvoiceRequest) GetFormat() InvoiceFormat {
+	if x != nil {
+		return x.Format
+	}
+	return InvoiceFormat_INVOICE_FORMAT_UNSPECIFIED
+}
+
+type CreatePromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         *PromotionTerms        `protobuf:"bytes,1,opt,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionRequest) Reset() {
+	*x = CreatePromotionRequest{}
+	mi := &file_booking_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionRequest) ProtoMessage() {}
+
+func (x *CreatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreatePromotionRequest) GetTerms() *PromotionTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type CreatePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotion     *Promotion             `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromotionResponse) Reset() {
+	*x = CreatePromotionResponse{}
+	mi := &file_booking_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionResponse) ProtoMessage() {}
+
+func (x *CreatePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CreatePromotionResponse) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+type ListPromotionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HotelId       uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsRequest) Reset() {
+	*x = ListPromotionsRequest{}
+	mi := &file_booking_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsRequest) ProtoMessage() {}
+
+func (x *ListPromotionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPromotionsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListPromotionsRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+type ListPromotionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*Promotion           `protobuf:"bytes,1,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromotionsResponse) Reset() {
+	*x = ListPromotionsResponse{}
+	mi := &file_booking_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromotionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromotionsResponse) ProtoMessage() {}
+
+func (x *ListPromotionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromotionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPromotionsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListPromotionsResponse) GetPromotions() []*Promotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+// Отель промокода не меняется, hotel_id в terms игнорируется
+type UpdatePromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   uint64                 `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Terms         *PromotionTerms        `protobuf:"bytes,2,opt,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromotionRequest) Reset() {
+	*x = UpdatePromotionRequest{}
+	mi := &file_booking_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromotionRequest) ProtoMessage() {}
+
+func (x *UpdatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdatePromotionRequest) GetPromotionId() uint64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+func (x *UpdatePromotionRequest) GetTerms() *PromotionTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type UpdatePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotion     *Promotion             `protobuf:"bytes,1,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromotionResponse) Reset() {
+	*x = UpdatePromotionResponse{}
+	mi := &file_booking_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromotionResponse) ProtoMessage() {}
+
+func (x *UpdatePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromotionResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UpdatePromotionResponse) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+type DeletePromotionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromotionId   uint64                 `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromotionRequest) Reset() {
+	*x = DeletePromotionRequest{}
+	mi := &file_booking_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromotionRequest) ProtoMessage() {}
+
+func (x *DeletePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromotionRequest.ProtoReflect.Descriptor instead.
+func (*DeletePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DeletePromotionRequest) GetPromotionId() uint64 {
+	if x != nil {
+		return x.PromotionId
+	}
+	return 0
+}
+
+type DeletePromotionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromotionResponse) Reset() {
+	*x = DeletePromotionResponse{}
+	mi := &file_booking_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromotionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromotionResponse) ProtoMessage() {}
+
+func (x *DeletePromotionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromotionResponse.ProtoReflect.Descriptor instead.
+func (*DeletePromotionResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{99}
+}
+
+type QuoteStayRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	HotelId   uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomType  RoomType               `protobuf:"varint,2,opt,name=room_type,json=roomType,proto3,enum=booking_service.RoomType" json:"room_type,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Guests    uint32                 `protobuf:"varint,5,opt,name=guests,proto3" json:"guests,omitempty"`
+	PromoCode string                 `protobuf:"bytes,6,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	// сколько гостей из guests освобождены от туристического налога и НДС
+	TaxExemptGuests uint32 `protobuf:"varint,7,opt,name=tax_exempt_guests,json=taxExemptGuests,proto3" json:"tax_exempt_guests,omitempty"`
+	// валюта гостя; цена в ней возвращается в display_price
+	DisplayCurrency string `protobuf:"bytes,8,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuoteStayRequest) Reset() {
+	*x = QuoteStayRequest{}
+	mi := &file_booking_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteStayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteStayRequest) ProtoMessage() {}
+
+func (x *QuoteStayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteStayRequest.ProtoReflect.Descriptor instead.
+func (*QuoteStayRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *QuoteStayRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *QuoteStayRequest) GetRoomType() RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return RoomType_ROOM_TYPE_UNKNOWN
+}
+
+func (x *QuoteStayRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *QuoteStayRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *QuoteStayRequest) GetGuests() uint32 {
+	if x != nil {
+		return x.Guests
+	}
+	return 0
+}
+
+func (x *QuoteStayRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *QuoteStayRequest) GetTaxExemptGuests() uint32 {
+	if x != nil {
+		return x.TaxExemptGuests
+	}
+	return 0
+}
+
+func (x *QuoteStayRequest) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+type QuoteStayResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Price        *BookingPrice          `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Cancellation *CancellationTerms     `protobuf:"bytes,2,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
+	QuoteToken   string                 `protobuf:"bytes,3,opt,name=quote_token,json=quoteToken,proto3" json:"quote_token,omitempty"`
+	ExpiresAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// цена в display_currency для показа; оплата в валюте отеля
+	DisplayPrice  *ConvertedPrice `protobuf:"bytes,5,opt,name=display_price,json=displayPrice,proto3" json:"display_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteStayResponse) Reset() {
+	*x = QuoteStayResponse{}
+	mi := &file_booking_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteStayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteStayResponse) ProtoMessage() {}
+
+func (x *QuoteStayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteStayResponse.ProtoReflect.Descriptor instead.
+func (*QuoteStayResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *QuoteStayResponse) GetPrice() *BookingPrice {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *QuoteStayResponse) GetCancellation() *CancellationTerms {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
+}
+
+func (x *QuoteStayResponse) GetQuoteToken() string {
+	if x != nil {
+		return x.QuoteToken
+	}
+	return ""
+}
+
+func (x *QuoteStayResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *QuoteStayResponse) GetDisplayPrice() *ConvertedPrice {
+	if x != nil {
+		return x.DisplayPrice
+	}
+	return nil
+}
+
+type SetExchangeRatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Rates []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	// курсы в CSV добавляются к rates
+	Csv string `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
+	// удалить курсы, которых нет в загрузке
+	Replace       bool `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRatesRequest) Reset() {
+	*x = SetExchangeRatesRequest{}
+	mi := &file_booking_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRatesRequest) ProtoMessage() {}
+
+func (x *SetExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SetExchangeRatesRequest) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *SetExchangeRatesRequest) GetCsv() string {
+	if x != nil {
+		return x.Csv
+	}
+	return ""
+}
+
+func (x *SetExchangeRatesRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type SetExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRatesResponse) Reset() {
+	*x = SetExchangeRatesResponse{}
+	mi := &file_booking_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRatesResponse) ProtoMessage() {}
+
+func (x *SetExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*SetExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SetExchangeRatesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type ListExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	mi := &file_booking_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{104}
+}
+
+type ListExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesResponse) Reset() {
+	*x = ListExchangeRatesResponse{}
+	mi := &file_booking_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListExchangeRatesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type GetReconciliationReportRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	HotelId uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	// вместе с уже устраненными расхождениями
+	IncludeResolved bool  `protobuf:"varint,2,opt,name=include_resolved,json=includeResolved,proto3" json:"include_resolved,omitempty"`
+	PageSize        int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportRequest) Reset() {
+	*x = GetReconciliationReportRequest{}
+	mi := &file_booking_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportRequest) ProtoMessage() {}
+
+func (x *GetReconciliationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetReconciliationReportRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *GetReconciliationReportRequest) GetIncludeResolved() bool {
+	if x != nil {
+		return x.IncludeResolved
+	}
+	return false
+}
+
+func (x *GetReconciliationReportRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetReconciliationReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*ReconciliationIssue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationReportResponse) Reset() {
+	*x = GetReconciliationReportResponse{}
+	mi := &file_booking_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportResponse) ProtoMessage() {}
+
+func (x *GetReconciliationReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationReportResponse.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetReconciliationReportResponse) GetIssues() []*ReconciliationIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type ReconciliationIssue struct {
+	state      protoimpl.MessageState  `protogen:"open.v1"`
+	Id         uint64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId  uint64                  `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	HotelId    uint64                  `protobuf:"varint,3,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Kind       ReconciliationIssueKind `protobuf:"varint,4,opt,name=kind,proto3,enum=booking_service.ReconciliationIssueKind" json:"kind,omitempty"`
+	Details    string                  `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	DetectedAt *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	// не задано, пока расхождение не устранено
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconciliationIssue) Reset() {
+	*x = ReconciliationIssue{}
+	mi := &file_booking_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationIssue) ProtoMessage() {}
+
+func (x *ReconciliationIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationIssue.ProtoReflect.Descriptor instead.
+func (*ReconciliationIssue) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ReconciliationIssue) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReconciliationIssue) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *ReconciliationIssue) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *ReconciliationIssue) GetKind() ReconciliationIssueKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ReconciliationIssueKind_RECONCILIATION_ISSUE_KIND_UNKNOWN
+}
+
+func (x *ReconciliationIssue) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *ReconciliationIssue) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+func (x *ReconciliationIssue) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    AuditEntityType        `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=booking_service.AuditEntityType" json:"entity_type,omitempty"`
+	EntityId      uint64                 `protobuf:"varint,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	HotelId       uint64                 `protobuf:"varint,3,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_booking_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListAuditEventsRequest) GetEntityType() AuditEntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return AuditEntityType_AUDIT_ENTITY_TYPE_UNKNOWN
+}
+
+func (x *ListAuditEventsRequest) GetEntityId() uint64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_booking_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	ActorKind  string                 `protobuf:"bytes,3,opt,name=actor_kind,json=actorKind,proto3" json:"actor_kind,omitempty"`
+	Actor      string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	EmployeeId uint64                 `protobuf:"varint,5,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	Rpc        string                 `protobuf:"bytes,6,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	EntityType AuditEntityType        `protobuf:"varint,7,opt,name=entity_type,json=entityType,proto3,enum=booking_service.AuditEntityType" json:"entity_type,omitempty"`
+	EntityId   uint64                 `protobuf:"varint,8,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	HotelId    uint64                 `protobuf:"varint,9,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Action     string                 `protobuf:"bytes,10,opt,name=action,proto3" json:"action,omitempty"`
+	// измененные поля: {"field": {"before": ..., "after": ...}}
+	Diff          *structpb.Struct `protobuf:"bytes,11,opt,name=diff,proto3" json:"diff,omitempty"`
+	RequestId     string           `protobuf:"bytes,12,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_booking_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *AuditEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetActorKind() string {
+	if x != nil {
+		return x.ActorKind
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetEmployeeId() uint64 {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetRpc() string {
+	if x != nil {
+		return x.Rpc
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetEntityType() AuditEntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return AuditEntityType_AUDIT_ENTITY_TYPE_UNKNOWN
+}
+
+func (x *AuditEvent) GetEntityId() uint64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetDiff() *structpb.Struct {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type Employee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Role          EmployeeRole           `protobuf:"varint,5,opt,name=role,proto3,enum=booking_service.EmployeeRole" json:"role,omitempty"`
+	HotelId       uint64                 `protobuf:"varint,6,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Subject       string                 `protobuf:"bytes,7,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Employee) Reset() {
+	*x = Employee{}
+	mi := &file_booking_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Employee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Employee) ProtoMessage() {}
+
+func (x *Employee) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Employee.ProtoReflect.Descriptor instead.
+func (*Employee) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *Employee) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Employee) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Employee) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Employee) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Employee) GetRole() EmployeeRole {
+	if x != nil {
+		return x.Role
+	}
+	return EmployeeRole_EMPLOYEE_ROLE_UNKNOWN
+}
+
+func (x *Employee) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *Employee) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type Room struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Number        string                 `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
+	Type          RoomType               `protobuf:"varint,5,opt,name=type,proto3,enum=booking_service.RoomType" json:"type,omitempty"`
+	HotelId       uint64                 `protobuf:"varint,6,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Status        RoomStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=booking_service.RoomStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Room) Reset() {
+	*x = Room{}
+	mi := &file_booking_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Room) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Room) ProtoMessage() {}
+
+func (x *Room) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *Room) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Room) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Room) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Room) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Room) GetType() RoomType {
+	if x != nil {
+		return x.Type
+	}
+	return RoomType_ROOM_TYPE_UNKNOWN
+}
+
+func (x *Room) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *Room) GetStatus() RoomStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RoomStatus_ROOM_STATUS_UNKNOWN
+}
+
+type Review struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	BookingId     uint64                 `protobuf:"varint,4,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	GuestId       uint64                 `protobuf:"varint,5,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment       string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_booking_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *Review) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Review) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Review) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Review) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *Review) GetGuestId() uint64 {
+	if x != nil {
+		return x.GuestId
+	}
+	return 0
+}
+
+func (x *Review) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type Hotel struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name               string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	CancellationPolicy *CancellationPolicy    `protobuf:"bytes,5,opt,name=cancellation_policy,json=cancellationPolicy,proto3" json:"cancellation_policy,omitempty"`
+	Currency           string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Hotel) Reset() {
+	*x = Hotel{}
+	mi := &file_booking_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hotel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hotel) ProtoMessage() {}
+
+func (x *Hotel) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hotel.ProtoReflect.Descriptor instead.
+func (*Hotel) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *Hotel) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Hotel) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Hotel) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Hotel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Hotel) GetCancellationPolicy() *CancellationPolicy {
+	if x != nil {
+		return x.CancellationPolicy
+	}
+	return nil
+}
+
+func (x *Hotel) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type CancellationPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// за сколько часов до заезда отмена бесплатна
+	FreeCancellationHours uint32 `protobuf:"varint,1,opt,name=free_cancellation_hours,json=freeCancellationHours,proto3" json:"free_cancellation_hours,omitempty"`
+	// штраф за позднюю отмену — цена стольких первых ночей
+	CancellationPenaltyNights uint32 `protobuf:"varint,2,opt,name=cancellation_penalty_nights,json=cancellationPenaltyNights,proto3" json:"cancellation_penalty_nights,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *CancellationPolicy) Reset() {
+	*x = CancellationPolicy{}
+	mi := &file_booking_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancellationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationPolicy) ProtoMessage() {}
+
+func (x *CancellationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationPolicy.ProtoReflect.Descriptor instead.
+func (*CancellationPolicy) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CancellationPolicy) GetFreeCancellationHours() uint32 {
+	if x != nil {
+		return x.FreeCancellationHours
+	}
+	return 0
+}
+
+func (x *CancellationPolicy) GetCancellationPenaltyNights() uint32 {
+	if x != nil {
+		return x.CancellationPenaltyNights
+	}
+	return 0
+}
+
+// Условия отмены бронирования: до free_until бесплатно, после — penalty
+type CancellationTerms struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FreeUntil     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=free_until,json=freeUntil,proto3" json:"free_until,omitempty"`
+	Penalty       *Money                 `protobuf:"bytes,2,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancellationTerms) Reset() {
+	*x = CancellationTerms{}
+	mi := &file_booking_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancellationTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationTerms) ProtoMessage() {}
+
+func (x *CancellationTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationTerms.ProtoReflect.Descriptor instead.
+func (*CancellationTerms) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CancellationTerms) GetFreeUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FreeUntil
+	}
+	return nil
+}
+
+func (x *CancellationTerms) GetPenalty() *Money {
+	if x != nil {
+		return x.Penalty
+	}
+	return nil
+}
+
+// Гость. В бронированиях заполнены только id, даты и name, профиль
+// возвращают GetGuest, UpdateGuest и SearchGuests
+type Guest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Name        string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Email       string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Phone       string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	DateOfBirth *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=date_of_birth,json=dateOfBirth,proto3" json:"date_of_birth,omitempty"`
+	Nationality string                 `protobuf:"bytes,8,opt,name=nationality,proto3" json:"nationality,omitempty"`
+	Address     *Address               `protobuf:"bytes,9,opt,name=address,proto3" json:"address,omitempty"`
+	Document    *IdentityDocument      `protobuf:"bytes,10,opt,name=document,proto3" json:"document,omitempty"`
+	// когда персональные данные гостя удалены; профиль больше не меняется
+	ErasedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=erased_at,json=erasedAt,proto3" json:"erased_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Guest) Reset() {
+	*x = Guest{}
+	mi := &file_booking_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Guest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guest) ProtoMessage() {}
+
+func (x *Guest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guest.ProtoReflect.Descriptor instead.
+func (*Guest) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *Guest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Guest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Guest) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Guest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Guest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Guest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Guest) GetDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return nil
+}
+
+func (x *Guest) GetNationality() string {
+	if x != nil {
+		return x.Nationality
+	}
+	return ""
+}
+
+func (x *Guest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Guest) GetDocument() *IdentityDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *Guest) GetErasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ErasedAt
+	}
+	return nil
+}
+
+type Address struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Line1      string                 `protobuf:"bytes,1,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2      string                 `protobuf:"bytes,2,opt,name=line2,proto3" json:"line2,omitempty"`
+	City       string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Region     string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode string                 `protobuf:"bytes,5,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// код страны ISO 3166-1 alpha-2
+	Country       string `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_booking_service_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *Address) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *Address) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// Документ, удостоверяющий личность; номер хранится зашифрованным
+type IdentityDocument struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Type   DocumentType           `protobuf:"varint,1,opt,name=type,proto3,enum=booking_service.DocumentType" json:"type,omitempty"`
+	Number string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	// страна выдачи, ISO 3166-1 alpha-2
+	IssuingCountry string                 `protobuf:"bytes,3,opt,name=issuing_country,json=issuingCountry,proto3" json:"issuing_country,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IdentityDocument) Reset() {
+	*x = IdentityDocument{}
+	mi := &file_booking_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityDocument) ProtoMessage() {}
+
+func (x *IdentityDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityDocument.ProtoReflect.Descriptor instead.
+func (*IdentityDocument) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *IdentityDocument) GetType() DocumentType {
+	if x != nil {
+		return x.Type
+	}
+	return DocumentType_DOCUMENT_TYPE_UNSPECIFIED
+}
+
+func (x *IdentityDocument) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *IdentityDocument) GetIssuingCountry() string {
+	if x != nil {
+		return x.IssuingCountry
+	}
+	return ""
+}
+
+func (x *IdentityDocument) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// Сумма в минимальных единицах валюты (копейки, центы) и код ISO 4217
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AmountMinor   int64                  `protobuf:"varint,1,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_booking_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *Money) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type RatePlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	HotelId       uint64                 `protobuf:"varint,4,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomType      RoomType               `protobuf:"varint,5,opt,name=room_type,json=roomType,proto3,enum=booking_service.RoomType" json:"room_type,omitempty"`
+	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	NightlyPrice  *Money                 `protobuf:"bytes,7,opt,name=nightly_price,json=nightlyPrice,proto3" json:"nightly_price,omitempty"`
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatePlan) Reset() {
+	*x = RatePlan{}
+	mi := &file_booking_service_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatePlan) ProtoMessage() {}
+
+func (x *RatePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatePlan.ProtoReflect.Descriptor instead.
+func (*RatePlan) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *RatePlan) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RatePlan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RatePlan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *RatePlan) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *RatePlan) GetRoomType() RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return RoomType_ROOM_TYPE_UNKNOWN
+}
+
+func (x *RatePlan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RatePlan) GetNightlyPrice() *Money {
+	if x != nil {
+		return x.NightlyPrice
+	}
+	return nil
+}
+
+func (x *RatePlan) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *RatePlan) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+type PricingRule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HotelId        uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomType       RoomType               `protobuf:"varint,2,opt,name=room_type,json=roomType,proto3,enum=booking_service.RoomType" json:"room_type,omitempty"`
+	Strategy       PricingStrategy        `protobuf:"varint,3,opt,name=strategy,proto3,enum=booking_service.PricingStrategy" json:"strategy,omitempty"`
+	OccupancyTiers []*OccupancyTier       `protobuf:"bytes,4,rep,name=occupancy_tiers,json=occupancyTiers,proto3" json:"occupancy_tiers,omitempty"`
+	MinPrice       *Money                 `protobuf:"bytes,5,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice       *Money                 `protobuf:"bytes,6,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PricingRule) Reset() {
+	*x = PricingRule{}
+	mi := &file_booking_service_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingRule) ProtoMessage() {}
+
+func (x *PricingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingRule.ProtoReflect.Descriptor instead.
+func (*PricingRule) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *PricingRule) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *PricingRule) GetRoomType() RoomType {
+	if x != nil {
+		return x.RoomType
+	}
+	return RoomType_ROOM_TYPE_UNKNOWN
+}
+
+func (x *PricingRule) GetStrategy() PricingStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return PricingStrategy_PRICING_STRATEGY_UNSPECIFIED
+}
+
+func (x *PricingRule) GetOccupancyTiers() []*OccupancyTier {
+	if x != nil {
+		return x.OccupancyTiers
+	}
+	return nil
+}
+
+func (x *PricingRule) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *PricingRule) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *PricingRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type DepositRule struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	HotelId            uint64                 `protobuf:"varint,1,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Kind               DepositKind            `protobuf:"varint,2,opt,name=kind,proto3,enum=booking_service.DepositKind" json:"kind,omitempty"`
+	PercentBasisPoints uint32                 `protobuf:"varint,3,opt,name=percent_basis_points,json=percentBasisPoints,proto3" json:"percent_basis_points,omitempty"`
+	BalanceDaysBefore  uint32                 `protobuf:"varint,4,opt,name=balance_days_before,json=balanceDaysBefore,proto3" json:"balance_days_before,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DepositRule) Reset() {
+	*x = DepositRule{}
+	mi := &file_booking_service_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositRule) ProtoMessage() {}
+
+func (x *DepositRule) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositRule.ProtoReflect.Descriptor instead.
+func (*DepositRule) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *DepositRule) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *DepositRule) GetKind() DepositKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DepositKind_DEPOSIT_KIND_UNSPECIFIED
+}
+
+func (x *DepositRule) GetPercentBasisPoints() uint32 {
+	if x != nil {
+		return x.PercentBasisPoints
+	}
+	return 0
+}
+
+func (x *DepositRule) GetBalanceDaysBefore() uint32 {
+	if x != nil {
+		return x.BalanceDaysBefore
+	}
+	return 0
+}
+
+func (x *DepositRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// При загрузке номеров типа от min_occupancy_percent цена меняется на adjustment_percent
+type OccupancyTier struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MinOccupancyPercent uint32                 `protobuf:"varint,1,opt,name=min_occupancy_percent,json=minOccupancyPercent,proto3" json:"min_occupancy_percent,omitempty"`
+	AdjustmentPercent   int32                  `protobuf:"varint,2,opt,name=adjustment_percent,json=adjustmentPercent,proto3" json:"adjustment_percent,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *OccupancyTier) Reset() {
+	*x = OccupancyTier{}
+	mi := &file_booking_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OccupancyTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OccupancyTier) ProtoMessage() {}
+
+func (x *OccupancyTier) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OccupancyTier.ProtoReflect.Descriptor instead.
+func (*OccupancyTier) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *OccupancyTier) GetMinOccupancyPercent() uint32 {
+	if x != nil {
+		return x.MinOccupancyPercent
+	}
+	return 0
+}
+
+func (x *OccupancyTier) GetAdjustmentPercent() int32 {
+	if x != nil {
+		return x.AdjustmentPercent
+	}
+	return 0
+}
+
+// Корректировка цены ночи с объяснением
+type PriceAdjustment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateHotelRequest) Reset() {
-	*x = CreateHotelRequest{}
-	mi := &file_booking_service_proto_msgTypes[0]
+func (x *PriceAdjustment) Reset() {
+	*x = PriceAdjustment{}
+	mi := &file_booking_service_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateHotelRequest) String() string {
+func (x *PriceAdjustment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateHotelRequest) ProtoMessage() {}
+func (*PriceAdjustment) ProtoMessage() {}
 
-func (x *CreateHotelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[0]
+func (x *PriceAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -162,40 +8142,187 @@ func (x *CreateHotelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateHotelRequest.ProtoReflect.Descriptor instead.
-func (*CreateHotelRequest) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use PriceAdjustment.ProtoReflect.Descriptor instead.
+func (*PriceAdjustment) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *CreateHotelRequest) GetName() string {
+func (x *PriceAdjustment) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *PriceAdjustment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PriceAdjustment) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type TaxRule struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	HotelId            uint64                 `protobuf:"varint,4,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Kind               TaxKind                `protobuf:"varint,5,opt,name=kind,proto3,enum=booking_service.TaxKind" json:"kind,omitempty"`
+	Name               string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	Amount             *Money                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	PercentBasisPoints uint32                 `protobuf:"varint,8,opt,name=percent_basis_points,json=percentBasisPoints,proto3" json:"percent_basis_points,omitempty"`
+	ValidFrom          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TaxRule) Reset() {
+	*x = TaxRule{}
+	mi := &file_booking_service_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxRule) ProtoMessage() {}
+
+func (x *TaxRule) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxRule.ProtoReflect.Descriptor instead.
+func (*TaxRule) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *TaxRule) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TaxRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaxRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TaxRule) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *TaxRule) GetKind() TaxKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TaxKind_TAX_KIND_UNSPECIFIED
+}
+
+func (x *TaxRule) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type CreateHotelResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hotel         *Hotel                 `protobuf:"bytes,1,opt,name=hotel,proto3" json:"hotel,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TaxRule) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
 }
 
-func (x *CreateHotelResponse) Reset() {
-	*x = CreateHotelResponse{}
-	mi := &file_booking_service_proto_msgTypes[1]
+func (x *TaxRule) GetPercentBasisPoints() uint32 {
+	if x != nil {
+		return x.PercentBasisPoints
+	}
+	return 0
+}
+
+func (x *TaxRule) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *TaxRule) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+// Условия промокода; незаданные ограничения не действуют
+type PromotionTerms struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// регистр не важен, хранится в верхнем
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// не задан — действует во всех отелях
+	HotelId      uint64       `protobuf:"varint,2,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	RoomTypes    []RoomType   `protobuf:"varint,3,rep,packed,name=room_types,json=roomTypes,proto3,enum=booking_service.RoomType" json:"room_types,omitempty"`
+	DiscountType DiscountType `protobuf:"varint,4,opt,name=discount_type,json=discountType,proto3,enum=booking_service.DiscountType" json:"discount_type,omitempty"`
+	PercentOff   uint32       `protobuf:"varint,5,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"`
+	AmountOff    *Money       `protobuf:"bytes,6,opt,name=amount_off,json=amountOff,proto3" json:"amount_off,omitempty"`
+	// все ночи проживания должны попадать в период
+	StayFrom  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=stay_from,json=stayFrom,proto3" json:"stay_from,omitempty"`
+	StayTo    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=stay_to,json=stayTo,proto3" json:"stay_to,omitempty"`
+	MinNights uint32                 `protobuf:"varint,9,opt,name=min_nights,json=minNights,proto3" json:"min_nights,omitempty"`
+	// когда можно бронировать с промокодом
+	BookFrom *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=book_from,json=bookFrom,proto3" json:"book_from,omitempty"`
+	BookTo   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=book_to,json=bookTo,proto3" json:"book_to,omitempty"`
+	// 0 — без лимита
+	MaxRedemptions uint32 `protobuf:"varint,12,opt,name=max_redemptions,json=maxRedemptions,proto3" json:"max_redemptions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PromotionTerms) Reset() {
+	*x = PromotionTerms{}
+	mi := &file_booking_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateHotelResponse) String() string {
+func (x *PromotionTerms) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateHotelResponse) ProtoMessage() {}
+func (*PromotionTerms) ProtoMessage() {}
 
-func (x *CreateHotelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[1]
+func (x *PromotionTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -206,40 +8333,121 @@ func (x *CreateHotelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateHotelResponse.ProtoReflect.Descriptor instead.
-func (*CreateHotelResponse) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use PromotionTerms.ProtoReflect.Descriptor instead.
+func (*PromotionTerms) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *CreateHotelResponse) GetHotel() *Hotel {
+func (x *PromotionTerms) GetCode() string {
 	if x != nil {
-		return x.Hotel
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PromotionTerms) GetHotelId() uint64 {
+	if x != nil {
+		return x.HotelId
+	}
+	return 0
+}
+
+func (x *PromotionTerms) GetRoomTypes() []RoomType {
+	if x != nil {
+		return x.RoomTypes
 	}
 	return nil
 }
 
-type CreateRoomRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Dto           []*CreateRoomRequest_DTO `protobuf:"bytes,1,rep,name=dto,proto3" json:"dto,omitempty"`
+func (x *PromotionTerms) GetDiscountType() DiscountType {
+	if x != nil {
+		return x.DiscountType
+	}
+	return DiscountType_DISCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *PromotionTerms) GetPercentOff() uint32 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *PromotionTerms) GetAmountOff() *Money {
+	if x != nil {
+		return x.AmountOff
+	}
+	return nil
+}
+
+func (x *PromotionTerms) GetStayFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StayFrom
+	}
+	return nil
+}
+
+func (x *PromotionTerms) GetStayTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StayTo
+	}
+	return nil
+}
+
+func (x *PromotionTerms) GetMinNights() uint32 {
+	if x != nil {
+		return x.MinNights
+	}
+	return 0
+}
+
+func (x *PromotionTerms) GetBookFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BookFrom
+	}
+	return nil
+}
+
+func (x *PromotionTerms) GetBookTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BookTo
+	}
+	return nil
+}
+
+func (x *PromotionTerms) GetMaxRedemptions() uint32 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
+}
+
+type Promotion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Terms         *PromotionTerms        `protobuf:"bytes,4,opt,name=terms,proto3" json:"terms,omitempty"`
+	Redemptions   uint32                 `protobuf:"varint,5,opt,name=redemptions,proto3" json:"redemptions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRoomRequest) Reset() {
-	*x = CreateRoomRequest{}
-	mi := &file_booking_service_proto_msgTypes[2]
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_booking_service_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoomRequest) String() string {
+func (x *Promotion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoomRequest) ProtoMessage() {}
+func (*Promotion) ProtoMessage() {}
 
-func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[2]
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,40 +8458,71 @@ func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
-func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *CreateRoomRequest) GetDto() []*CreateRoomRequest_DTO {
+func (x *Promotion) GetId() uint64 {
 	if x != nil {
-		return x.Dto
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Promotion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type CreateRoomResponse struct {
+func (x *Promotion) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Promotion) GetTerms() *PromotionTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *Promotion) GetRedemptions() uint32 {
+	if x != nil {
+		return x.Redemptions
+	}
+	return 0
+}
+
+// Скидка по промокоду; уже учтена в ценах ночей
+type Discount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	PromotionId   uint64                 `protobuf:"varint,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRoomResponse) Reset() {
-	*x = CreateRoomResponse{}
-	mi := &file_booking_service_proto_msgTypes[3]
+func (x *Discount) Reset() {
+	*x = Discount{}
+	mi := &file_booking_service_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoomResponse) String() string {
+func (x *Discount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoomResponse) ProtoMessage() {}
+func (*Discount) ProtoMessage() {}
 
-func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[3]
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,43 +8533,58 @@ func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
-func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *CreateRoomResponse) GetRoom() *Room {
+func (x *Discount) GetPromotionId() uint64 {
 	if x != nil {
-		return x.Room
+		return x.PromotionId
 	}
-	return nil
+	return 0
 }
 
-type UpdateRoomRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoomId        uint64                 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	Number        string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	HotelId       uint64                 `protobuf:"varint,4,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+func (x *Discount) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Discount) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// За единицу base_currency дают rate единиц quote_currency; rate — десятичная дробь
+type ExchangeRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRoomRequest) Reset() {
-	*x = UpdateRoomRequest{}
-	mi := &file_booking_service_proto_msgTypes[4]
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_booking_service_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRoomRequest) String() string {
+func (x *ExchangeRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoomRequest) ProtoMessage() {}
+func (*ExchangeRate) ProtoMessage() {}
 
-func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[4]
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -341,61 +8595,66 @@ func (x *UpdateRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoomRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRoomRequest) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *UpdateRoomRequest) GetRoomId() uint64 {
+func (x *ExchangeRate) GetBaseCurrency() string {
 	if x != nil {
-		return x.RoomId
+		return x.BaseCurrency
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateRoomRequest) GetNumber() string {
+func (x *ExchangeRate) GetQuoteCurrency() string {
 	if x != nil {
-		return x.Number
+		return x.QuoteCurrency
 	}
 	return ""
 }
 
-func (x *UpdateRoomRequest) GetType() string {
+func (x *ExchangeRate) GetRate() string {
 	if x != nil {
-		return x.Type
+		return x.Rate
 	}
 	return ""
 }
 
-func (x *UpdateRoomRequest) GetHotelId() uint64 {
+func (x *ExchangeRate) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.HotelId
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-type UpdateRoomResponse struct {
+// Цена, пересчитанная по курсу rate; total = subtotal + taxes + fees
+type ConvertedPrice struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Room          *Room                  `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	Total         *Money                 `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Subtotal      *Money                 `protobuf:"bytes,2,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Taxes         *Money                 `protobuf:"bytes,3,opt,name=taxes,proto3" json:"taxes,omitempty"`
+	Fees          *Money                 `protobuf:"bytes,4,opt,name=fees,proto3" json:"fees,omitempty"`
+	Rate          *ExchangeRate          `protobuf:"bytes,5,opt,name=rate,proto3" json:"rate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRoomResponse) Reset() {
-	*x = UpdateRoomResponse{}
-	mi := &file_booking_service_proto_msgTypes[5]
+func (x *ConvertedPrice) Reset() {
+	*x = ConvertedPrice{}
+	mi := &file_booking_service_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRoomResponse) String() string {
+func (x *ConvertedPrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoomResponse) ProtoMessage() {}
+func (*ConvertedPrice) ProtoMessage() {}
 
-func (x *UpdateRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[5]
+func (x *ConvertedPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,44 +8665,73 @@ func (x *UpdateRoomResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoomResponse.ProtoReflect.Descriptor instead.
-func (*UpdateRoomResponse) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ConvertedPrice.ProtoReflect.Descriptor instead.
+func (*ConvertedPrice) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *UpdateRoomResponse) GetRoom() *Room {
+func (x *ConvertedPrice) GetTotal() *Money {
 	if x != nil {
-		return x.Room
+		return x.Total
 	}
 	return nil
 }
 
-type CreateBookingRequest struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	RoomId        uint64                       `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	StartDate     *timestamppb.Timestamp       `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp       `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Comment       string                       `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	Guests        []*CreateBookingRequestGuest `protobuf:"bytes,5,rep,name=guests,proto3" json:"guests,omitempty"`
+func (x *ConvertedPrice) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *ConvertedPrice) GetTaxes() *Money {
+	if x != nil {
+		return x.Taxes
+	}
+	return nil
+}
+
+func (x *ConvertedPrice) GetFees() *Money {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+func (x *ConvertedPrice) GetRate() *ExchangeRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+// Строка налога или сбора в цене; quantity — число гостеночей, ночей или проживаний
+type PriceItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaxRuleId     uint64                 `protobuf:"varint,1,opt,name=tax_rule_id,json=taxRuleId,proto3" json:"tax_rule_id,omitempty"`
+	Kind          TaxKind                `protobuf:"varint,2,opt,name=kind,proto3,enum=booking_service.TaxKind" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBookingRequest) Reset() {
-	*x = CreateBookingRequest{}
-	mi := &file_booking_service_proto_msgTypes[6]
+func (x *PriceItem) Reset() {
+	*x = PriceItem{}
+	mi := &file_booking_service_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBookingRequest) String() string {
+func (x *PriceItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBookingRequest) ProtoMessage() {}
+func (*PriceItem) ProtoMessage() {}
 
-func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[6]
+func (x *PriceItem) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,68 +8742,77 @@ func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
-func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PriceItem.ProtoReflect.Descriptor instead.
+func (*PriceItem) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *CreateBookingRequest) GetRoomId() uint64 {
+func (x *PriceItem) GetTaxRuleId() uint64 {
 	if x != nil {
-		return x.RoomId
+		return x.TaxRuleId
 	}
 	return 0
 }
 
-func (x *CreateBookingRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *PriceItem) GetKind() TaxKind {
 	if x != nil {
-		return x.StartDate
+		return x.Kind
 	}
-	return nil
+	return TaxKind_TAX_KIND_UNSPECIFIED
 }
 
-func (x *CreateBookingRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *PriceItem) GetName() string {
 	if x != nil {
-		return x.EndDate
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateBookingRequest) GetComment() string {
+func (x *PriceItem) GetQuantity() uint32 {
 	if x != nil {
-		return x.Comment
+		return x.Quantity
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateBookingRequest) GetGuests() []*CreateBookingRequestGuest {
+func (x *PriceItem) GetAmount() *Money {
 	if x != nil {
-		return x.Guests
+		return x.Amount
 	}
 	return nil
 }
 
-type CreateBookingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+// Цена, зафиксированная при бронировании
+type BookingPrice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// total = subtotal + taxes + fees
+	Total  *Money        `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Nights []*NightPrice `protobuf:"bytes,2,rep,name=nights,proto3" json:"nights,omitempty"`
+	// сумма цен ночей
+	Subtotal      *Money       `protobuf:"bytes,3,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Taxes         *Money       `protobuf:"bytes,4,opt,name=taxes,proto3" json:"taxes,omitempty"`
+	Fees          *Money       `protobuf:"bytes,5,opt,name=fees,proto3" json:"fees,omitempty"`
+	Items         []*PriceItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	Discount      *Discount    `protobuf:"bytes,7,opt,name=discount,proto3" json:"discount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBookingResponse) Reset() {
-	*x = CreateBookingResponse{}
-	mi := &file_booking_service_proto_msgTypes[7]
+func (x *BookingPrice) Reset() {
+	*x = BookingPrice{}
+	mi := &file_booking_service_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBookingResponse) String() string {
+func (x *BookingPrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBookingResponse) ProtoMessage() {}
+func (*BookingPrice) ProtoMessage() {}
 
-func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[7]
+func (x *BookingPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -526,83 +8823,89 @@ func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
-func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BookingPrice.ProtoReflect.Descriptor instead.
+func (*BookingPrice) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *CreateBookingResponse) GetBooking() *Booking {
+func (x *BookingPrice) GetTotal() *Money {
 	if x != nil {
-		return x.Booking
+		return x.Total
 	}
 	return nil
 }
 
-type CancelBookingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookingId     uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BookingPrice) GetNights() []*NightPrice {
+	if x != nil {
+		return x.Nights
+	}
+	return nil
 }
 
-func (x *CancelBookingRequest) Reset() {
-	*x = CancelBookingRequest{}
-	mi := &file_booking_service_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BookingPrice) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
 }
 
-func (x *CancelBookingRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BookingPrice) GetTaxes() *Money {
+	if x != nil {
+		return x.Taxes
+	}
+	return nil
 }
 
-func (*CancelBookingRequest) ProtoMessage() {}
-
-func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[8]
+func (x *BookingPrice) GetFees() *Money {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Fees
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
-func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{8}
+func (x *BookingPrice) GetItems() []*PriceItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
 }
 
-func (x *CancelBookingRequest) GetBookingId() uint64 {
+func (x *BookingPrice) GetDiscount() *Discount {
 	if x != nil {
-		return x.BookingId
+		return x.Discount
 	}
-	return 0
+	return nil
 }
 
-type CancelBookingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type NightPrice struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Date       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Price      *Money                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	RatePlanId uint64                 `protobuf:"varint,3,opt,name=rate_plan_id,json=ratePlanId,proto3" json:"rate_plan_id,omitempty"`
+	// цена взята из календаря
+	Override bool `protobuf:"varint,4,opt,name=override,proto3" json:"override,omitempty"`
+	// цена до корректировок правилом ценообразования
+	BasePrice     *Money             `protobuf:"bytes,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	Adjustments   []*PriceAdjustment `protobuf:"bytes,6,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelBookingResponse) Reset() {
-	*x = CancelBookingResponse{}
-	mi := &file_booking_service_proto_msgTypes[9]
+func (x *NightPrice) Reset() {
+	*x = NightPrice{}
+	mi := &file_booking_service_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBookingResponse) String() string {
+func (x *NightPrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBookingResponse) ProtoMessage() {}
+func (*NightPrice) ProtoMessage() {}
 
-func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[9]
+func (x *NightPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -613,35 +8916,81 @@ func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
-func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use NightPrice.ProtoReflect.Descriptor instead.
+func (*NightPrice) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{135}
 }
 
-type ModifyBookingRequest struct {
+func (x *NightPrice) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *NightPrice) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *NightPrice) GetRatePlanId() uint64 {
+	if x != nil {
+		return x.RatePlanId
+	}
+	return 0
+}
+
+func (x *NightPrice) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+func (x *NightPrice) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *NightPrice) GetAdjustments() []*PriceAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+// Цена на ночи с start_date по end_date включительно; пустой weekdays — все дни.
+// Нулевая сумма снимает переопределение, ночи снова считаются по тарифам
+type RateRange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookingId     uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	RoomType      RoomType               `protobuf:"varint,1,opt,name=room_type,json=roomType,proto3,enum=booking_service.RoomType" json:"room_type,omitempty"`
 	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Weekdays      []Weekday              `protobuf:"varint,4,rep,packed,name=weekdays,proto3,enum=booking_service.Weekday" json:"weekdays,omitempty"`
+	Price         *Money                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ModifyBookingRequest) Reset() {
-	*x = ModifyBookingRequest{}
-	mi := &file_booking_service_proto_msgTypes[10]
+func (x *RateRange) Reset() {
+	*x = RateRange{}
+	mi := &file_booking_service_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModifyBookingRequest) String() string {
+func (x *RateRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifyBookingRequest) ProtoMessage() {}
+func (*RateRange) ProtoMessage() {}
 
-func (x *ModifyBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[10]
+func (x *RateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,54 +9001,75 @@ func (x *ModifyBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifyBookingRequest.ProtoReflect.Descriptor instead.
-func (*ModifyBookingRequest) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RateRange.ProtoReflect.Descriptor instead.
+func (*RateRange) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *ModifyBookingRequest) GetBookingId() uint64 {
+func (x *RateRange) GetRoomType() RoomType {
 	if x != nil {
-		return x.BookingId
+		return x.RoomType
 	}
-	return 0
+	return RoomType_ROOM_TYPE_UNKNOWN
 }
 
-func (x *ModifyBookingRequest) GetStartDate() *timestamppb.Timestamp {
+func (x *RateRange) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.StartDate
 	}
 	return nil
 }
 
-func (x *ModifyBookingRequest) GetEndDate() *timestamppb.Timestamp {
+func (x *RateRange) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.EndDate
 	}
 	return nil
 }
 
-type ModifyBookingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RateRange) GetWeekdays() []Weekday {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
 }
 
-func (x *ModifyBookingResponse) Reset() {
-	*x = ModifyBookingResponse{}
-	mi := &file_booking_service_proto_msgTypes[11]
+func (x *RateRange) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type CalendarNight struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Date  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// не задана, если на ночь нет ни тарифа, ни цены в календаре
+	Price            *Money             `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	RatePlanId       uint64             `protobuf:"varint,3,opt,name=rate_plan_id,json=ratePlanId,proto3" json:"rate_plan_id,omitempty"`
+	Override         bool               `protobuf:"varint,4,opt,name=override,proto3" json:"override,omitempty"`
+	BasePrice        *Money             `protobuf:"bytes,5,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	Adjustments      []*PriceAdjustment `protobuf:"bytes,6,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	OccupancyPercent uint32             `protobuf:"varint,7,opt,name=occupancy_percent,json=occupancyPercent,proto3" json:"occupancy_percent,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CalendarNight) Reset() {
+	*x = CalendarNight{}
+	mi := &file_booking_service_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModifyBookingResponse) String() string {
+func (x *CalendarNight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifyBookingResponse) ProtoMessage() {}
+func (*CalendarNight) ProtoMessage() {}
 
-func (x *ModifyBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[11]
+func (x *CalendarNight) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,40 +9080,95 @@ func (x *ModifyBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifyBookingResponse.ProtoReflect.Descriptor instead.
-func (*ModifyBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CalendarNight.ProtoReflect.Descriptor instead.
+func (*CalendarNight) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CalendarNight) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *CalendarNight) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CalendarNight) GetRatePlanId() uint64 {
+	if x != nil {
+		return x.RatePlanId
+	}
+	return 0
+}
+
+func (x *CalendarNight) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+func (x *CalendarNight) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *CalendarNight) GetAdjustments() []*PriceAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
 }
 
-func (x *ModifyBookingResponse) GetBooking() *Booking {
+func (x *CalendarNight) GetOccupancyPercent() uint32 {
 	if x != nil {
-		return x.Booking
+		return x.OccupancyPercent
 	}
-	return nil
+	return 0
 }
 
-type CreateGuestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+// Счет гостя; у счета без начислений id не заполнен
+type Folio struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	BookingId   uint64                 `protobuf:"varint,3,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Status      FolioStatus            `protobuf:"varint,4,opt,name=status,proto3,enum=booking_service.FolioStatus" json:"status,omitempty"`
+	ClosedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	Charges     []*FolioCharge         `protobuf:"bytes,6,rep,name=charges,proto3" json:"charges,omitempty"`
+	Settlements []*Settlement          `protobuf:"bytes,7,rep,name=settlements,proto3" json:"settlements,omitempty"`
+	// сумма несторнированных начислений
+	Charged *Money `protobuf:"bytes,8,opt,name=charged,proto3" json:"charged,omitempty"`
+	// сумма успешных оплат
+	Paid *Money `protobuf:"bytes,9,opt,name=paid,proto3" json:"paid,omitempty"`
+	// остаток к оплате: charged - paid
+	Balance       *Money `protobuf:"bytes,10,opt,name=balance,proto3" json:"balance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGuestRequest) Reset() {
-	*x = CreateGuestRequest{}
-	mi := &file_booking_service_proto_msgTypes[12]
+func (x *Folio) Reset() {
+	*x = Folio{}
+	mi := &file_booking_service_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGuestRequest) String() string {
+func (x *Folio) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGuestRequest) ProtoMessage() {}
+func (*Folio) ProtoMessage() {}
 
-func (x *CreateGuestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[12]
+func (x *Folio) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,87 +9179,116 @@ func (x *CreateGuestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGuestRequest.ProtoReflect.Descriptor instead.
-func (*CreateGuestRequest) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Folio.ProtoReflect.Descriptor instead.
+func (*Folio) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *CreateGuestRequest) GetName() string {
+func (x *Folio) GetId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type CreateGuestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Guest         *Guest                 `protobuf:"bytes,1,opt,name=guest,proto3" json:"guest,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Folio) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *CreateGuestResponse) Reset() {
-	*x = CreateGuestResponse{}
-	mi := &file_booking_service_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Folio) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
 }
 
-func (x *CreateGuestResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Folio) GetStatus() FolioStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FolioStatus_FOLIO_STATUS_UNSPECIFIED
 }
 
-func (*CreateGuestResponse) ProtoMessage() {}
+func (x *Folio) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
 
-func (x *CreateGuestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[13]
+func (x *Folio) GetCharges() []*FolioCharge {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Charges
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreateGuestResponse.ProtoReflect.Descriptor instead.
-func (*CreateGuestResponse) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{13}
+func (x *Folio) GetSettlements() []*Settlement {
+	if x != nil {
+		return x.Settlements
+	}
+	return nil
 }
 
-func (x *CreateGuestResponse) GetGuest() *Guest {
+func (x *Folio) GetCharged() *Money {
 	if x != nil {
-		return x.Guest
+		return x.Charged
 	}
 	return nil
 }
 
-type SubmitReviewRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookingId     uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	GuestId       uint64                 `protobuf:"varint,2,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
-	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Folio) GetPaid() *Money {
+	if x != nil {
+		return x.Paid
+	}
+	return nil
 }
 
-func (x *SubmitReviewRequest) Reset() {
-	*x = SubmitReviewRequest{}
-	mi := &file_booking_service_proto_msgTypes[14]
+func (x *Folio) GetBalance() *Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+// Начисление: total = net + tax, net = quantity * unit_price
+type FolioCharge struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Category       ChargeCategory         `protobuf:"varint,3,opt,name=category,proto3,enum=booking_service.ChargeCategory" json:"category,omitempty"`
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity       uint32                 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice      *Money                 `protobuf:"bytes,6,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	TaxBasisPoints uint32                 `protobuf:"varint,7,opt,name=tax_basis_points,json=taxBasisPoints,proto3" json:"tax_basis_points,omitempty"`
+	Net            *Money                 `protobuf:"bytes,8,opt,name=net,proto3" json:"net,omitempty"`
+	Tax            *Money                 `protobuf:"bytes,9,opt,name=tax,proto3" json:"tax,omitempty"`
+	Total          *Money                 `protobuf:"bytes,10,opt,name=total,proto3" json:"total,omitempty"`
+	PostedBy       string                 `protobuf:"bytes,11,opt,name=posted_by,json=postedBy,proto3" json:"posted_by,omitempty"`
+	VoidedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=voided_at,json=voidedAt,proto3" json:"voided_at,omitempty"`
+	VoidReason     string                 `protobuf:"bytes,13,opt,name=void_reason,json=voidReason,proto3" json:"void_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FolioCharge) Reset() {
+	*x = FolioCharge{}
+	mi := &file_booking_service_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitReviewRequest) String() string {
+func (x *FolioCharge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitReviewRequest) ProtoMessage() {}
+func (*FolioCharge) ProtoMessage() {}
 
-func (x *SubmitReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[14]
+func (x *FolioCharge) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -845,61 +9299,128 @@ func (x *SubmitReviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitReviewRequest.ProtoReflect.Descriptor instead.
-func (*SubmitReviewRequest) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use FolioCharge.ProtoReflect.Descriptor instead.
+func (*FolioCharge) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *SubmitReviewRequest) GetBookingId() uint64 {
+func (x *FolioCharge) GetId() uint64 {
 	if x != nil {
-		return x.BookingId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *SubmitReviewRequest) GetGuestId() uint64 {
+func (x *FolioCharge) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.GuestId
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FolioCharge) GetCategory() ChargeCategory {
+	if x != nil {
+		return x.Category
+	}
+	return ChargeCategory_CHARGE_CATEGORY_UNSPECIFIED
+}
+
+func (x *FolioCharge) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FolioCharge) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *SubmitReviewRequest) GetRating() int32 {
+func (x *FolioCharge) GetUnitPrice() *Money {
 	if x != nil {
-		return x.Rating
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *FolioCharge) GetTaxBasisPoints() uint32 {
+	if x != nil {
+		return x.TaxBasisPoints
 	}
 	return 0
 }
 
-func (x *SubmitReviewRequest) GetComment() string {
+func (x *FolioCharge) GetNet() *Money {
 	if x != nil {
-		return x.Comment
+		return x.Net
+	}
+	return nil
+}
+
+func (x *FolioCharge) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *FolioCharge) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *FolioCharge) GetPostedBy() string {
+	if x != nil {
+		return x.PostedBy
 	}
 	return ""
 }
 
-type SubmitReviewResponse struct {
+func (x *FolioCharge) GetVoidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VoidedAt
+	}
+	return nil
+}
+
+func (x *FolioCharge) GetVoidReason() string {
+	if x != nil {
+		return x.VoidReason
+	}
+	return ""
+}
+
+// Оплата счета через платежный сервис
+type Settlement struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status        SettlementStatus       `protobuf:"varint,3,opt,name=status,proto3,enum=booking_service.SettlementStatus" json:"status,omitempty"`
+	PaymentDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=payment_date,json=paymentDate,proto3" json:"payment_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitReviewResponse) Reset() {
-	*x = SubmitReviewResponse{}
-	mi := &file_booking_service_proto_msgTypes[15]
+func (x *Settlement) Reset() {
+	*x = Settlement{}
+	mi := &file_booking_service_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitReviewResponse) String() string {
+func (x *Settlement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitReviewResponse) ProtoMessage() {}
+func (*Settlement) ProtoMessage() {}
 
-func (x *SubmitReviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[15]
+func (x *Settlement) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,45 +9431,64 @@ func (x *SubmitReviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitReviewResponse.ProtoReflect.Descriptor instead.
-func (*SubmitReviewResponse) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use Settlement.ProtoReflect.Descriptor instead.
+func (*Settlement) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *SubmitReviewResponse) GetReview() *Review {
+func (x *Settlement) GetId() uint64 {
 	if x != nil {
-		return x.Review
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Settlement) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
 	}
 	return nil
 }
 
-type Room struct {
+func (x *Settlement) GetStatus() SettlementStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SettlementStatus_SETTLEMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Settlement) GetPaymentDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaymentDate
+	}
+	return nil
+}
+
+type InvoiceParty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Number        string                 `protobuf:"bytes,4,opt,name=number,proto3" json:"number,omitempty"`
-	Type          RoomType               `protobuf:"varint,5,opt,name=type,proto3,enum=booking_service.RoomType" json:"type,omitempty"`
-	HotelId       uint64                 `protobuf:"varint,6,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TaxId         string                 `protobuf:"bytes,2,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Room) Reset() {
-	*x = Room{}
-	mi := &file_booking_service_proto_msgTypes[16]
+func (x *InvoiceParty) Reset() {
+	*x = InvoiceParty{}
+	mi := &file_booking_service_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Room) String() string {
+func (x *InvoiceParty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Room) ProtoMessage() {}
+func (*InvoiceParty) ProtoMessage() {}
 
-func (x *Room) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[16]
+func (x *InvoiceParty) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,81 +9499,67 @@ func (x *Room) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Room.ProtoReflect.Descriptor instead.
-func (*Room) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *Room) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Room) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use InvoiceParty.ProtoReflect.Descriptor instead.
+func (*InvoiceParty) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *Room) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *InvoiceParty) GetName() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Room) GetNumber() string {
+func (x *InvoiceParty) GetTaxId() string {
 	if x != nil {
-		return x.Number
+		return x.TaxId
 	}
 	return ""
 }
 
-func (x *Room) GetType() RoomType {
+func (x *InvoiceParty) GetAddress() string {
 	if x != nil {
-		return x.Type
+		return x.Address
 	}
-	return RoomType_ROOM_TYPE_UNKNOWN
+	return ""
 }
 
-func (x *Room) GetHotelId() uint64 {
+func (x *InvoiceParty) GetEmail() string {
 	if x != nil {
-		return x.HotelId
+		return x.Email
 	}
-	return 0
+	return ""
 }
 
-type Review struct {
+// Строка счета: total = net + tax
+type InvoiceLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	BookingId     uint64                 `protobuf:"varint,4,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	GuestId       uint64                 `protobuf:"varint,5,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
-	Rating        int32                  `protobuf:"varint,6,opt,name=rating,proto3" json:"rating,omitempty"`
-	Comment       string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity      uint32                 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     *Money                 `protobuf:"bytes,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	Net           *Money                 `protobuf:"bytes,4,opt,name=net,proto3" json:"net,omitempty"`
+	Tax           *Money                 `protobuf:"bytes,5,opt,name=tax,proto3" json:"tax,omitempty"`
+	Total         *Money                 `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Review) Reset() {
-	*x = Review{}
-	mi := &file_booking_service_proto_msgTypes[17]
+func (x *InvoiceLine) Reset() {
+	*x = InvoiceLine{}
+	mi := &file_booking_service_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Review) String() string {
+func (x *InvoiceLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Review) ProtoMessage() {}
+func (*InvoiceLine) ProtoMessage() {}
 
-func (x *Review) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[17]
+func (x *InvoiceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,85 +9570,92 @@ func (x *Review) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Review.ProtoReflect.Descriptor instead.
-func (*Review) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *Review) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use InvoiceLine.ProtoReflect.Descriptor instead.
+func (*InvoiceLine) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *Review) GetCreatedAt() *timestamppb.Timestamp {
+func (x *InvoiceLine) GetDescription() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *Review) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *InvoiceLine) GetQuantity() uint32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Quantity
 	}
-	return nil
+	return 0
 }
 
-func (x *Review) GetBookingId() uint64 {
+func (x *InvoiceLine) GetUnitPrice() *Money {
 	if x != nil {
-		return x.BookingId
+		return x.UnitPrice
 	}
-	return 0
+	return nil
 }
 
-func (x *Review) GetGuestId() uint64 {
+func (x *InvoiceLine) GetNet() *Money {
 	if x != nil {
-		return x.GuestId
+		return x.Net
 	}
-	return 0
+	return nil
 }
 
-func (x *Review) GetRating() int32 {
+func (x *InvoiceLine) GetTax() *Money {
 	if x != nil {
-		return x.Rating
+		return x.Tax
 	}
-	return 0
+	return nil
 }
 
-func (x *Review) GetComment() string {
+func (x *InvoiceLine) GetTotal() *Money {
 	if x != nil {
-		return x.Comment
+		return x.Total
 	}
-	return ""
+	return nil
 }
 
-type Hotel struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+type Invoice struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Number         string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	HotelId        uint64                 `protobuf:"varint,3,opt,name=hotel_id,json=hotelId,proto3" json:"hotel_id,omitempty"`
+	BookingId      uint64                 `protobuf:"varint,4,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	FiscalYear     uint32                 `protobuf:"varint,5,opt,name=fiscal_year,json=fiscalYear,proto3" json:"fiscal_year,omitempty"`
+	SequenceNumber uint64                 `protobuf:"varint,6,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	IssuedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	Seller         *InvoiceParty          `protobuf:"bytes,8,opt,name=seller,proto3" json:"seller,omitempty"`
+	Buyer          *InvoiceParty          `protobuf:"bytes,9,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	StayStart      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=stay_start,json=stayStart,proto3" json:"stay_start,omitempty"`
+	StayEnd        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=stay_end,json=stayEnd,proto3" json:"stay_end,omitempty"`
+	Lines          []*InvoiceLine         `protobuf:"bytes,12,rep,name=lines,proto3" json:"lines,omitempty"`
+	Net            *Money                 `protobuf:"bytes,13,opt,name=net,proto3" json:"net,omitempty"`
+	Tax            *Money                 `protobuf:"bytes,14,opt,name=tax,proto3" json:"tax,omitempty"`
+	Total          *Money                 `protobuf:"bytes,15,opt,name=total,proto3" json:"total,omitempty"`
+	Paid           *Money                 `protobuf:"bytes,16,opt,name=paid,proto3" json:"paid,omitempty"`
+	// total - paid
+	Due           *Money `protobuf:"bytes,17,opt,name=due,proto3" json:"due,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Hotel) Reset() {
-	*x = Hotel{}
-	mi := &file_booking_service_proto_msgTypes[18]
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_booking_service_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Hotel) String() string {
+func (x *Invoice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Hotel) ProtoMessage() {}
+func (*Invoice) ProtoMessage() {}
 
-func (x *Hotel) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[18]
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_service_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1133,125 +9666,155 @@ func (x *Hotel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Hotel.ProtoReflect.Descriptor instead.
-func (*Hotel) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_booking_service_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *Hotel) GetId() uint64 {
+func (x *Invoice) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Hotel) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Invoice) GetNumber() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Number
 	}
-	return nil
+	return ""
 }
 
-func (x *Hotel) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Invoice) GetHotelId() uint64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.HotelId
 	}
-	return nil
+	return 0
 }
 
-func (x *Hotel) GetName() string {
+func (x *Invoice) GetBookingId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.BookingId
 	}
-	return ""
+	return 0
 }
 
-type Guest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Invoice) GetFiscalYear() uint32 {
+	if x != nil {
+		return x.FiscalYear
+	}
+	return 0
 }
 
-func (x *Guest) Reset() {
-	*x = Guest{}
-	mi := &file_booking_service_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Invoice) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
 }
 
-func (x *Guest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Invoice) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
 }
 
-func (*Guest) ProtoMessage() {}
+func (x *Invoice) GetSeller() *InvoiceParty {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
 
-func (x *Guest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[19]
+func (x *Invoice) GetBuyer() *InvoiceParty {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Buyer
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Guest.ProtoReflect.Descriptor instead.
-func (*Guest) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{19}
+func (x *Invoice) GetStayStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StayStart
+	}
+	return nil
 }
 
-func (x *Guest) GetId() uint64 {
+func (x *Invoice) GetStayEnd() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.StayEnd
 	}
-	return 0
+	return nil
 }
 
-func (x *Guest) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Invoice) GetLines() []*InvoiceLine {
 	if x != nil {
-		return x.CreatedAt
+		return x.Lines
 	}
 	return nil
 }
 
-func (x *Guest) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Invoice) GetNet() *Money {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Net
 	}
 	return nil
 }
 
-func (x *Guest) GetName() string {
+func (x *Invoice) GetTax() *Money {
 	if x != nil {
-		return x.Name
+		return x.Tax
 	}
-	return ""
+	return nil
+}
+
+func (x *Invoice) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *Invoice) GetPaid() *Money {
+	if x != nil {
+		return x.Paid
+	}
+	return nil
+}
+
+func (x *Invoice) GetDue() *Money {
+	if x != nil {
+		return x.Due
+	}
+	return nil
 }
 
 type Booking struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	RoomId        uint64                 `protobuf:"varint,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Comment       string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
-	Status        BookingStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=booking_service.BookingStatus" json:"status,omitempty"`
-	Guests        []*Guest               `protobuf:"bytes,10,rep,name=guests,proto3" json:"guests,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RoomId       uint64                 `protobuf:"varint,4,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	StartDate    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Comment      string                 `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	Status       BookingStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=booking_service.BookingStatus" json:"status,omitempty"`
+	Guests       []*Guest               `protobuf:"bytes,10,rep,name=guests,proto3" json:"guests,omitempty"`
+	Price        *BookingPrice          `protobuf:"bytes,11,opt,name=price,proto3" json:"price,omitempty"`
+	Cancellation *CancellationTerms     `protobuf:"bytes,12,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
+	// оплата проживания по данным платежного сервиса
+	PaymentStatus BookingPaymentStatus `protobuf:"varint,13,opt,name=payment_status,json=paymentStatus,proto3,enum=booking_service.BookingPaymentStatus" json:"payment_status,omitempty"`
+	AmountPaid    *Money               `protobuf:"bytes,14,opt,name=amount_paid,json=amountPaid,proto3" json:"amount_paid,omitempty"`
+	// когда будет списан остаток после депозита; не задано — остаток не запланирован
+	BalanceDueAt  *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=balance_due_at,json=balanceDueAt,proto3" json:"balance_due_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Booking) Reset() {
 	*x = Booking{}
-	mi := &file_booking_service_proto_msgTypes[20]
+	mi := &file_booking_service_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1263,7 +9826,7 @@ func (x *Booking) String() string {
 func (*Booking) ProtoMessage() {}
 
 func (x *Booking) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[20]
+	mi := &file_booking_service_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1276,7 +9839,7 @@ func (x *Booking) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Booking.ProtoReflect.Descriptor instead.
 func (*Booking) Descriptor() ([]byte, []int) {
-	return file_booking_service_proto_rawDescGZIP(), []int{20}
+	return file_booking_service_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *Booking) GetId() uint64 {
@@ -1342,6 +9905,41 @@ func (x *Booking) GetGuests() []*Guest {
 	return nil
 }
 
+func (x *Booking) GetPrice() *BookingPrice {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Booking) GetCancellation() *CancellationTerms {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
+}
+
+func (x *Booking) GetPaymentStatus() BookingPaymentStatus {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return BookingPaymentStatus_BOOKING_PAYMENT_STATUS_UNKNOWN
+}
+
+func (x *Booking) GetAmountPaid() *Money {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return nil
+}
+
+func (x *Booking) GetBalanceDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BalanceDueAt
+	}
+	return nil
+}
+
 type CreateRoomRequest_DTO struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Number        string                 `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
@@ -1353,7 +9951,7 @@ type CreateRoomRequest_DTO struct {
 
 func (x *CreateRoomRequest_DTO) Reset() {
 	*x = CreateRoomRequest_DTO{}
-	mi := &file_booking_service_proto_msgTypes[21]
+	mi := &file_booking_service_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1365,7 +9963,7 @@ func (x *CreateRoomRequest_DTO) String() string {
 func (*CreateRoomRequest_DTO) ProtoMessage() {}
 
 func (x *CreateRoomRequest_DTO) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[21]
+	mi := &file_booking_service_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,15 +10001,21 @@ func (x *CreateRoomRequest_DTO) GetHotelId() uint64 {
 }
 
 type CreateBookingRequestGuest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// гость освобожден от туристического налога и НДС
+	TaxExempt bool `protobuf:"varint,2,opt,name=tax_exempt,json=taxExempt,proto3" json:"tax_exempt,omitempty"`
+	// контакты и документ сопоставляют гостя с существующим профилем
+	Email         string            `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string            `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Document      *IdentityDocument `protobuf:"bytes,5,opt,name=document,proto3" json:"document,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateBookingRequestGuest) Reset() {
 	*x = CreateBookingRequestGuest{}
-	mi := &file_booking_service_proto_msgTypes[22]
+	mi := &file_booking_service_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1423,7 +10027,7 @@ func (x *CreateBookingRequestGuest) String() string {
 func (*CreateBookingRequestGuest) ProtoMessage() {}
 
 func (x *CreateBookingRequestGuest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_service_proto_msgTypes[22]
+	mi := &file_booking_service_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1446,13 +10050,43 @@ func (x *CreateBookingRequestGuest) GetName() string {
 	return ""
 }
 
+func (x *CreateBookingRequestGuest) GetTaxExempt() bool {
+	if x != nil {
+		return x.TaxExempt
+	}
+	return false
+}
+
+func (x *CreateBookingRequestGuest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateBookingRequestGuest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateBookingRequestGuest) GetDocument() *IdentityDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
 var File_booking_service_proto protoreflect.FileDescriptor
 
 const file_booking_service_proto_rawDesc = "" +
 	"\n" +
-	"\x15booking_service.proto\x12\x0fbooking_service\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"(\n" +
+	"\x15booking_service.proto\x12\x0fbooking_service\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9a\x01\n" +
 	"\x12CreateHotelRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"C\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12T\n" +
+	"\x13cancellation_policy\x18\x02 \x01(\v2#.booking_service.CancellationPolicyR\x12cancellationPolicy\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"C\n" +
 	"\x13CreateHotelResponse\x12,\n" +
 	"\x05hotel\x18\x01 \x01(\v2\x16.booking_service.HotelR\x05hotel\"\x9b\x01\n" +
 	"\x11CreateRoomRequest\x128\n" +
@@ -1469,18 +10103,28 @@ const file_booking_service_proto_rawDesc = "" +
 	"\x04type\x18\x03 \x01(\tR\x04type\x12\x19\n" +
 	"\bhotel_id\x18\x04 \x01(\x04R\ahotelId\"?\n" +
 	"\x12UpdateRoomResponse\x12)\n" +
-	"\x04room\x18\x01 \x01(\v2\x15.booking_service.RoomR\x04room\"\x9d\x02\n" +
+	"\x04room\x18\x01 \x01(\v2\x15.booking_service.RoomR\x04room\"\xe8\x03\n" +
 	"\x14CreateBookingRequest\x12\x17\n" +
 	"\aroom_id\x18\x01 \x01(\x04R\x06roomId\x129\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
 	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x18\n" +
 	"\acomment\x18\x04 \x01(\tR\acomment\x12C\n" +
-	"\x06guests\x18\x05 \x03(\v2+.booking_service.CreateBookingRequest.guestR\x06guests\x1a\x1b\n" +
+	"\x06guests\x18\x05 \x03(\v2+.booking_service.CreateBookingRequest.guestR\x06guests\x12\x1f\n" +
+	"\vquote_token\x18\x06 \x01(\tR\n" +
+	"quoteToken\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\a \x01(\tR\tpromoCode\x1a\xa5\x01\n" +
 	"\x05guest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"K\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"tax_exempt\x18\x02 \x01(\bR\ttaxExempt\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12=\n" +
+	"\bdocument\x18\x05 \x01(\v2!.booking_service.IdentityDocumentR\bdocument\"n\n" +
 	"\x15CreateBookingResponse\x122\n" +
-	"\abooking\x18\x01 \x01(\v2\x18.booking_service.BookingR\abooking\"5\n" +
+	"\abooking\x18\x01 \x01(\v2\x18.booking_service.BookingR\abooking\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\"5\n" +
 	"\x14CancelBookingRequest\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\x04R\tbookingId\"\x17\n" +
@@ -1492,11 +10136,75 @@ const file_booking_service_proto_rawDesc = "" +
 	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
 	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"K\n" +
 	"\x15ModifyBookingResponse\x122\n" +
-	"\abooking\x18\x01 \x01(\v2\x18.booking_service.BookingR\abooking\"(\n" +
+	"\abooking\x18\x01 \x01(\v2\x18.booking_service.BookingR\abooking\"\x17\n" +
+	"\x15ListMyBookingsRequest\"N\n" +
+	"\x16ListMyBookingsResponse\x124\n" +
+	"\bbookings\x18\x01 \x03(\v2\x18.booking_service.BookingR\bbookings\"\x93\x01\n" +
 	"\x12CreateGuestRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"C\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12=\n" +
+	"\bdocument\x18\x04 \x01(\v2!.booking_service.IdentityDocumentR\bdocument\"C\n" +
 	"\x13CreateGuestResponse\x12,\n" +
-	"\x05guest\x18\x01 \x01(\v2\x16.booking_service.GuestR\x05guest\"\x81\x01\n" +
+	"\x05guest\x18\x01 \x01(\v2\x16.booking_service.GuestR\x05guest\",\n" +
+	"\x0fGetGuestRequest\x12\x19\n" +
+	"\bguest_id\x18\x01 \x01(\x04R\aguestId\"@\n" +
+	"\x10GetGuestResponse\x12,\n" +
+	"\x05guest\x18\x01 \x01(\v2\x16.booking_service.GuestR\x05guest\"\xc4\x02\n" +
+	"\x12UpdateGuestRequest\x12\x19\n" +
+	"\bguest_id\x18\x01 \x01(\x04R\aguestId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12>\n" +
+	"\rdate_of_birth\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vdateOfBirth\x12 \n" +
+	"\vnationality\x18\x06 \x01(\tR\vnationality\x122\n" +
+	"\aaddress\x18\a \x01(\v2\x18.booking_service.AddressR\aaddress\x12=\n" +
+	"\bdocument\x18\b \x01(\v2!.booking_service.IdentityDocumentR\bdocument\"C\n" +
+	"\x13UpdateGuestResponse\x12,\n" +
+	"\x05guest\x18\x01 \x01(\v2\x16.booking_service.GuestR\x05guest\"\xd5\x01\n" +
+	"\x13SearchGuestsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12'\n" +
+	"\x0fdocument_number\x18\x04 \x01(\tR\x0edocumentNumber\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12\x19\n" +
+	"\bhotel_id\x18\a \x01(\x04R\ahotelId\"n\n" +
+	"\x14SearchGuestsResponse\x12.\n" +
+	"\x06guests\x18\x01 \x03(\v2\x16.booking_service.GuestR\x06guests\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"d\n" +
+	"\x12MergeGuestsRequest\x12&\n" +
+	"\x0ftarget_guest_id\x18\x01 \x01(\x04R\rtargetGuestId\x12&\n" +
+	"\x0fsource_guest_id\x18\x02 \x01(\x04R\rsourceGuestId\"\x8f\x01\n" +
+	"\x13MergeGuestsResponse\x12,\n" +
+	"\x05guest\x18\x01 \x01(\v2\x16.booking_service.GuestR\x05guest\x12%\n" +
+	"\x0ebookings_moved\x18\x02 \x01(\rR\rbookingsMoved\x12#\n" +
+	"\rreviews_moved\x18\x03 \x01(\rR\freviewsMoved\"3\n" +
+	"\x16ExportGuestDataRequest\x12\x19\n" +
+	"\bguest_id\x18\x01 \x01(\x04R\aguestId\"\xe5\x01\n" +
+	"\x0fGuestDataExport\x12;\n" +
+	"\vexported_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt\x12,\n" +
+	"\x05guest\x18\x02 \x01(\v2\x16.booking_service.GuestR\x05guest\x124\n" +
+	"\bbookings\x18\x03 \x03(\v2\x18.booking_service.BookingR\bbookings\x121\n" +
+	"\areviews\x18\x04 \x03(\v2\x17.booking_service.ReviewR\areviews\".\n" +
+	"\x11EraseGuestRequest\x12\x19\n" +
+	"\bguest_id\x18\x01 \x01(\x04R\aguestId\"B\n" +
+	"\x12EraseGuestResponse\x12,\n" +
+	"\x05guest\x18\x01 \x01(\v2\x16.booking_service.GuestR\x05guest\"9\n" +
+	"\x1aListDuplicateGuestsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\"_\n" +
+	"\x1bListDuplicateGuestsResponse\x12@\n" +
+	"\n" +
+	"duplicates\x18\x01 \x03(\v2 .booking_service.DuplicateGuestsR\n" +
+	"duplicates\"\xdd\x01\n" +
+	"\x0fDuplicateGuests\x12,\n" +
+	"\x05guest\x18\x01 \x01(\v2\x16.booking_service.GuestR\x05guest\x124\n" +
+	"\tduplicate\x18\x02 \x01(\v2\x16.booking_service.GuestR\tduplicate\x12=\n" +
+	"\n" +
+	"matched_by\x18\x03 \x03(\x0e2\x1e.booking_service.GuestMatchKeyR\tmatchedBy\x12'\n" +
+	"\x0fname_similarity\x18\x04 \x01(\x01R\x0enameSimilarity\"\x81\x01\n" +
 	"\x13SubmitReviewRequest\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\x04R\tbookingId\x12\x19\n" +
@@ -1504,7 +10212,293 @@ const file_booking_service_proto_rawDesc = "" +
 	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x18\n" +
 	"\acomment\x18\x04 \x01(\tR\acomment\"G\n" +
 	"\x14SubmitReviewResponse\x12/\n" +
-	"\x06review\x18\x01 \x01(\v2\x17.booking_service.ReviewR\x06review\"\xee\x01\n" +
+	"\x06review\x18\x01 \x01(\v2\x17.booking_service.ReviewR\x06review\"g\n" +
+	"\x17UpdateRoomStatusRequest\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x04R\x06roomId\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.booking_service.RoomStatusR\x06status\"E\n" +
+	"\x18UpdateRoomStatusResponse\x12)\n" +
+	"\x04room\x18\x01 \x01(\v2\x15.booking_service.RoomR\x04room\"\x93\x01\n" +
+	"\x15CreateEmployeeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x121\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1d.booking_service.EmployeeRoleR\x04role\x12\x19\n" +
+	"\bhotel_id\x18\x03 \x01(\x04R\ahotelId\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\"O\n" +
+	"\x16CreateEmployeeResponse\x125\n" +
+	"\bemployee\x18\x01 \x01(\v2\x19.booking_service.EmployeeR\bemployee\"5\n" +
+	"\x12GetEmployeeRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\x04R\n" +
+	"employeeId\"L\n" +
+	"\x13GetEmployeeResponse\x125\n" +
+	"\bemployee\x18\x01 \x01(\v2\x19.booking_service.EmployeeR\bemployee\"1\n" +
+	"\x14ListEmployeesRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\"P\n" +
+	"\x15ListEmployeesResponse\x127\n" +
+	"\temployees\x18\x01 \x03(\v2\x19.booking_service.EmployeeR\temployees\"\xb4\x01\n" +
+	"\x15UpdateEmployeeRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\x04R\n" +
+	"employeeId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x121\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1d.booking_service.EmployeeRoleR\x04role\x12\x19\n" +
+	"\bhotel_id\x18\x04 \x01(\x04R\ahotelId\x12\x18\n" +
+	"\asubject\x18\x05 \x01(\tR\asubject\"O\n" +
+	"\x16UpdateEmployeeResponse\x125\n" +
+	"\bemployee\x18\x01 \x01(\v2\x19.booking_service.EmployeeR\bemployee\"8\n" +
+	"\x15DeleteEmployeeRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\x04R\n" +
+	"employeeId\"\x18\n" +
+	"\x16DeleteEmployeeResponse\"\xad\x02\n" +
+	"\x15CreateRatePlanRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\x126\n" +
+	"\troom_type\x18\x02 \x01(\x0e2\x19.booking_service.RoomTypeR\broomType\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12;\n" +
+	"\rnightly_price\x18\x04 \x01(\v2\x16.booking_service.MoneyR\fnightlyPrice\x129\n" +
+	"\n" +
+	"valid_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\"P\n" +
+	"\x16CreateRatePlanResponse\x126\n" +
+	"\trate_plan\x18\x01 \x01(\v2\x19.booking_service.RatePlanR\bratePlan\"1\n" +
+	"\x14ListRatePlansRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\"Q\n" +
+	"\x15ListRatePlansResponse\x128\n" +
+	"\n" +
+	"rate_plans\x18\x01 \x03(\v2\x19.booking_service.RatePlanR\tratePlans\"\xb4\x02\n" +
+	"\x15UpdateRatePlanRequest\x12 \n" +
+	"\frate_plan_id\x18\x01 \x01(\x04R\n" +
+	"ratePlanId\x126\n" +
+	"\troom_type\x18\x02 \x01(\x0e2\x19.booking_service.RoomTypeR\broomType\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12;\n" +
+	"\rnightly_price\x18\x04 \x01(\v2\x16.booking_service.MoneyR\fnightlyPrice\x129\n" +
+	"\n" +
+	"valid_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\"P\n" +
+	"\x16UpdateRatePlanResponse\x126\n" +
+	"\trate_plan\x18\x01 \x01(\v2\x19.booking_service.RatePlanR\bratePlan\"9\n" +
+	"\x15DeleteRatePlanRequest\x12 \n" +
+	"\frate_plan_id\x18\x01 \x01(\x04R\n" +
+	"ratePlanId\"\x18\n" +
+	"\x16DeleteRatePlanResponse\"`\n" +
+	"\x0fSetRatesRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\x122\n" +
+	"\x06ranges\x18\x02 \x03(\v2\x1a.booking_service.RateRangeR\x06ranges\"\x12\n" +
+	"\x10SetRatesResponse\"\xdd\x01\n" +
+	"\x16GetRateCalendarRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\x126\n" +
+	"\troom_type\x18\x02 \x01(\x0e2\x19.booking_service.RoomTypeR\broomType\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"Q\n" +
+	"\x17GetRateCalendarResponse\x126\n" +
+	"\x06nights\x18\x01 \x03(\v2\x1e.booking_service.CalendarNightR\x06nights\"\xdb\x02\n" +
+	"\x15SetPricingRuleRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\x126\n" +
+	"\troom_type\x18\x02 \x01(\x0e2\x19.booking_service.RoomTypeR\broomType\x12<\n" +
+	"\bstrategy\x18\x03 \x01(\x0e2 .booking_service.PricingStrategyR\bstrategy\x12G\n" +
+	"\x0foccupancy_tiers\x18\x04 \x03(\v2\x1e.booking_service.OccupancyTierR\x0eoccupancyTiers\x123\n" +
+	"\tmin_price\x18\x05 \x01(\v2\x16.booking_service.MoneyR\bminPrice\x123\n" +
+	"\tmax_price\x18\x06 \x01(\v2\x16.booking_service.MoneyR\bmaxPrice\"J\n" +
+	"\x16SetPricingRuleResponse\x120\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1c.booking_service.PricingRuleR\x04rule\"4\n" +
+	"\x17ListPricingRulesRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\"N\n" +
+	"\x18ListPricingRulesResponse\x122\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1c.booking_service.PricingRuleR\x05rules\"m\n" +
+	"\x18DeletePricingRuleRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\x126\n" +
+	"\troom_type\x18\x02 \x01(\x0e2\x19.booking_service.RoomTypeR\broomType\"\x1b\n" +
+	"\x19DeletePricingRuleResponse\"\xc6\x01\n" +
+	"\x15SetDepositRuleRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\x120\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1c.booking_service.DepositKindR\x04kind\x120\n" +
+	"\x14percent_basis_points\x18\x03 \x01(\rR\x12percentBasisPoints\x12.\n" +
+	"\x13balance_days_before\x18\x04 \x01(\rR\x11balanceDaysBefore\"J\n" +
+	"\x16SetDepositRuleResponse\x120\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1c.booking_service.DepositRuleR\x04rule\"2\n" +
+	"\x15GetDepositRuleRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\"J\n" +
+	"\x16GetDepositRuleResponse\x120\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1c.booking_service.DepositRuleR\x04rule\"5\n" +
+	"\x18DeleteDepositRuleRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\"\x1b\n" +
+	"\x19DeleteDepositRuleResponse\"\xc7\x02\n" +
+	"\x14CreateTaxRuleRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\x12,\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x18.booking_service.TaxKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12.\n" +
+	"\x06amount\x18\x04 \x01(\v2\x16.booking_service.MoneyR\x06amount\x120\n" +
+	"\x14percent_basis_points\x18\x05 \x01(\rR\x12percentBasisPoints\x129\n" +
+	"\n" +
+	"valid_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\"L\n" +
+	"\x15CreateTaxRuleResponse\x123\n" +
+	"\btax_rule\x18\x01 \x01(\v2\x18.booking_service.TaxRuleR\ataxRule\"0\n" +
+	"\x13ListTaxRulesRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\"M\n" +
+	"\x14ListTaxRulesResponse\x125\n" +
+	"\ttax_rules\x18\x01 \x03(\v2\x18.booking_service.TaxRuleR\btaxRules\"6\n" +
+	"\x14DeleteTaxRuleRequest\x12\x1e\n" +
+	"\vtax_rule_id\x18\x01 \x01(\x04R\ttaxRuleId\"\x17\n" +
+	"\x15DeleteTaxRuleResponse\"2\n" +
+	"\x11PayBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x04R\tbookingId\"H\n" +
+	"\x12PayBookingResponse\x122\n" +
+	"\abooking\x18\x01 \x01(\v2\x18.booking_service.BookingR\abooking\"0\n" +
+	"\x0fGetFolioRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x04R\tbookingId\"@\n" +
+	"\x10GetFolioResponse\x12,\n" +
+	"\x05folio\x18\x01 \x01(\v2\x16.booking_service.FolioR\x05folio\"\x93\x02\n" +
+	"\x16PostFolioChargeRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x04R\tbookingId\x12;\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x1f.booking_service.ChargeCategoryR\bcategory\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\rR\bquantity\x125\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\v2\x16.booking_service.MoneyR\tunitPrice\x12(\n" +
+	"\x10tax_basis_points\x18\x06 \x01(\rR\x0etaxBasisPoints\"}\n" +
+	"\x17PostFolioChargeResponse\x124\n" +
+	"\x06charge\x18\x01 \x01(\v2\x1c.booking_service.FolioChargeR\x06charge\x12,\n" +
+	"\x05folio\x18\x02 \x01(\v2\x16.booking_service.FolioR\x05folio\"l\n" +
+	"\x16VoidFolioChargeRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x04R\tbookingId\x12\x1b\n" +
+	"\tcharge_id\x18\x02 \x01(\x04R\bchargeId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"G\n" +
+	"\x17VoidFolioChargeResponse\x12,\n" +
+	"\x05folio\x18\x01 \x01(\v2\x16.booking_service.FolioR\x05folio\"c\n" +
+	"\x12SettleFolioRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x04R\tbookingId\x12.\n" +
+	"\x06amount\x18\x02 \x01(\v2\x16.booking_service.MoneyR\x06amount\"C\n" +
+	"\x13SettleFolioResponse\x12,\n" +
+	"\x05folio\x18\x01 \x01(\v2\x16.booking_service.FolioR\x05folio\"2\n" +
+	"\x11CloseFolioRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x04R\tbookingId\"B\n" +
+	"\x12CloseFolioResponse\x12,\n" +
+	"\x05folio\x18\x01 \x01(\v2\x16.booking_service.FolioR\x05folio\"i\n" +
+	"\x13IssueInvoiceRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x04R\tbookingId\x123\n" +
+	"\x05buyer\x18\x02 \x01(\v2\x1d.booking_service.InvoicePartyR\x05buyer\"J\n" +
+	"\x14IssueInvoiceResponse\x122\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x18.booking_service.InvoiceR\ainvoice\"2\n" +
+	"\x11GetInvoiceRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\x04R\tinvoiceId\"H\n" +
+	"\x12GetInvoiceResponse\x122\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x18.booking_service.InvoiceR\ainvoice\"o\n" +
+	"\x16DownloadInvoiceRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\x04R\tinvoiceId\x126\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1e.booking_service.InvoiceFormatR\x06format\"O\n" +
+	"\x16CreatePromotionRequest\x125\n" +
+	"\x05terms\x18\x01 \x01(\v2\x1f.booking_service.PromotionTermsR\x05terms\"S\n" +
+	"\x17CreatePromotionResponse\x128\n" +
+	"\tpromotion\x18\x01 \x01(\v2\x1a.booking_service.PromotionR\tpromotion\"2\n" +
+	"\x15ListPromotionsRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\"T\n" +
+	"\x16ListPromotionsResponse\x12:\n" +
+	"\n" +
+	"promotions\x18\x01 \x03(\v2\x1a.booking_service.PromotionR\n" +
+	"promotions\"r\n" +
+	"\x16UpdatePromotionRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x04R\vpromotionId\x125\n" +
+	"\x05terms\x18\x02 \x01(\v2\x1f.booking_service.PromotionTermsR\x05terms\"S\n" +
+	"\x17UpdatePromotionResponse\x128\n" +
+	"\tpromotion\x18\x01 \x01(\v2\x1a.booking_service.PromotionR\tpromotion\";\n" +
+	"\x16DeletePromotionRequest\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x04R\vpromotionId\"\x19\n" +
+	"\x17DeletePromotionResponse\"\xe5\x02\n" +
+	"\x10QuoteStayRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\x126\n" +
+	"\troom_type\x18\x02 \x01(\x0e2\x19.booking_service.RoomTypeR\broomType\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x16\n" +
+	"\x06guests\x18\x05 \x01(\rR\x06guests\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x06 \x01(\tR\tpromoCode\x12*\n" +
+	"\x11tax_exempt_guests\x18\a \x01(\rR\x0ftaxExemptGuests\x12)\n" +
+	"\x10display_currency\x18\b \x01(\tR\x0fdisplayCurrency\"\xb2\x02\n" +
+	"\x11QuoteStayResponse\x123\n" +
+	"\x05price\x18\x01 \x01(\v2\x1d.booking_service.BookingPriceR\x05price\x12F\n" +
+	"\fcancellation\x18\x02 \x01(\v2\".booking_service.CancellationTermsR\fcancellation\x12\x1f\n" +
+	"\vquote_token\x18\x03 \x01(\tR\n" +
+	"quoteToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12D\n" +
+	"\rdisplay_price\x18\x05 \x01(\v2\x1f.booking_service.ConvertedPriceR\fdisplayPrice\"z\n" +
+	"\x17SetExchangeRatesRequest\x123\n" +
+	"\x05rates\x18\x01 \x03(\v2\x1d.booking_service.ExchangeRateR\x05rates\x12\x10\n" +
+	"\x03csv\x18\x02 \x01(\tR\x03csv\x12\x18\n" +
+	"\areplace\x18\x03 \x01(\bR\areplace\"O\n" +
+	"\x18SetExchangeRatesResponse\x123\n" +
+	"\x05rates\x18\x01 \x03(\v2\x1d.booking_service.ExchangeRateR\x05rates\"\x1a\n" +
+	"\x18ListExchangeRatesRequest\"P\n" +
+	"\x19ListExchangeRatesResponse\x123\n" +
+	"\x05rates\x18\x01 \x03(\v2\x1d.booking_service.ExchangeRateR\x05rates\"\x83\x01\n" +
+	"\x1eGetReconciliationReportRequest\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\x12)\n" +
+	"\x10include_resolved\x18\x02 \x01(\bR\x0fincludeResolved\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"_\n" +
+	"\x1fGetReconciliationReportResponse\x12<\n" +
+	"\x06issues\x18\x01 \x03(\v2$.booking_service.ReconciliationIssueR\x06issues\"\xb1\x02\n" +
+	"\x13ReconciliationIssue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\x04R\tbookingId\x12\x19\n" +
+	"\bhotel_id\x18\x03 \x01(\x04R\ahotelId\x12<\n" +
+	"\x04kind\x18\x04 \x01(\x0e2(.booking_service.ReconciliationIssueKindR\x04kind\x12\x18\n" +
+	"\adetails\x18\x05 \x01(\tR\adetails\x12;\n" +
+	"\vdetected_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAt\x12;\n" +
+	"\vresolved_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"\xab\x02\n" +
+	"\x16ListAuditEventsRequest\x12A\n" +
+	"\ventity_type\x18\x01 \x01(\x0e2 .booking_service.AuditEntityTypeR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\x04R\bentityId\x12\x19\n" +
+	"\bhotel_id\x18\x03 \x01(\x04R\ahotelId\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"v\n" +
+	"\x17ListAuditEventsResponse\x123\n" +
+	"\x06events\x18\x01 \x03(\v2\x1b.booking_service.AuditEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xa0\x03\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x1d\n" +
+	"\n" +
+	"actor_kind\x18\x03 \x01(\tR\tactorKind\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\x12\x1f\n" +
+	"\vemployee_id\x18\x05 \x01(\x04R\n" +
+	"employeeId\x12\x10\n" +
+	"\x03rpc\x18\x06 \x01(\tR\x03rpc\x12A\n" +
+	"\ventity_type\x18\a \x01(\x0e2 .booking_service.AuditEntityTypeR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\b \x01(\x04R\bentityId\x12\x19\n" +
+	"\bhotel_id\x18\t \x01(\x04R\ahotelId\x12\x16\n" +
+	"\x06action\x18\n" +
+	" \x01(\tR\x06action\x12+\n" +
+	"\x04diff\x18\v \x01(\v2\x17.google.protobuf.StructR\x04diff\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\f \x01(\tR\trequestId\"\x8c\x02\n" +
+	"\bEmployee\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x121\n" +
+	"\x04role\x18\x05 \x01(\x0e2\x1d.booking_service.EmployeeRoleR\x04role\x12\x19\n" +
+	"\bhotel_id\x18\x06 \x01(\x04R\ahotelId\x12\x18\n" +
+	"\asubject\x18\a \x01(\tR\asubject\"\xa3\x02\n" +
 	"\x04Room\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
 	"\n" +
@@ -1513,7 +10507,8 @@ const file_booking_service_proto_rawDesc = "" +
 	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
 	"\x06number\x18\x04 \x01(\tR\x06number\x12-\n" +
 	"\x04type\x18\x05 \x01(\x0e2\x19.booking_service.RoomTypeR\x04type\x12\x19\n" +
-	"\bhotel_id\x18\x06 \x01(\x04R\ahotelId\"\xfa\x01\n" +
+	"\bhotel_id\x18\x06 \x01(\x04R\ahotelId\x123\n" +
+	"\x06status\x18\a \x01(\x0e2\x1b.booking_service.RoomStatusR\x06status\"\xfa\x01\n" +
 	"\x06Review\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
 	"\n" +
@@ -1524,21 +10519,262 @@ const file_booking_service_proto_rawDesc = "" +
 	"booking_id\x18\x04 \x01(\x04R\tbookingId\x12\x19\n" +
 	"\bguest_id\x18\x05 \x01(\x04R\aguestId\x12\x16\n" +
 	"\x06rating\x18\x06 \x01(\x05R\x06rating\x12\x18\n" +
-	"\acomment\x18\a \x01(\tR\acomment\"\xa1\x01\n" +
+	"\acomment\x18\a \x01(\tR\acomment\"\x93\x02\n" +
 	"\x05Hotel\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
 	"\n" +
 	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
-	"\x04name\x18\x04 \x01(\tR\x04name\"\xa1\x01\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12T\n" +
+	"\x13cancellation_policy\x18\x05 \x01(\v2#.booking_service.CancellationPolicyR\x12cancellationPolicy\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\"\x8c\x01\n" +
+	"\x12CancellationPolicy\x126\n" +
+	"\x17free_cancellation_hours\x18\x01 \x01(\rR\x15freeCancellationHours\x12>\n" +
+	"\x1bcancellation_penalty_nights\x18\x02 \x01(\rR\x19cancellationPenaltyNights\"\x80\x01\n" +
+	"\x11CancellationTerms\x129\n" +
+	"\n" +
+	"free_until\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tfreeUntil\x120\n" +
+	"\apenalty\x18\x02 \x01(\v2\x16.booking_service.MoneyR\apenalty\"\xdb\x03\n" +
 	"\x05Guest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
 	"\n" +
 	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
-	"\x04name\x18\x04 \x01(\tR\x04name\"\x9c\x03\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x06 \x01(\tR\x05phone\x12>\n" +
+	"\rdate_of_birth\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vdateOfBirth\x12 \n" +
+	"\vnationality\x18\b \x01(\tR\vnationality\x122\n" +
+	"\aaddress\x18\t \x01(\v2\x18.booking_service.AddressR\aaddress\x12=\n" +
+	"\bdocument\x18\n" +
+	" \x01(\v2!.booking_service.IdentityDocumentR\bdocument\x127\n" +
+	"\terased_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\berasedAt\"\x9c\x01\n" +
+	"\aAddress\x12\x14\n" +
+	"\x05line1\x18\x01 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x02 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\x05 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\x06 \x01(\tR\acountry\"\xc1\x01\n" +
+	"\x10IdentityDocument\x121\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.booking_service.DocumentTypeR\x04type\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\x12'\n" +
+	"\x0fissuing_country\x18\x03 \x01(\tR\x0eissuingCountry\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"F\n" +
+	"\x05Money\x12!\n" +
+	"\famount_minor\x18\x01 \x01(\x03R\vamountMinor\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xa6\x03\n" +
+	"\bRatePlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
+	"\bhotel_id\x18\x04 \x01(\x04R\ahotelId\x126\n" +
+	"\troom_type\x18\x05 \x01(\x0e2\x19.booking_service.RoomTypeR\broomType\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12;\n" +
+	"\rnightly_price\x18\a \x01(\v2\x16.booking_service.MoneyR\fnightlyPrice\x129\n" +
+	"\n" +
+	"valid_from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\"\x8c\x03\n" +
+	"\vPricingRule\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\x126\n" +
+	"\troom_type\x18\x02 \x01(\x0e2\x19.booking_service.RoomTypeR\broomType\x12<\n" +
+	"\bstrategy\x18\x03 \x01(\x0e2 .booking_service.PricingStrategyR\bstrategy\x12G\n" +
+	"\x0foccupancy_tiers\x18\x04 \x03(\v2\x1e.booking_service.OccupancyTierR\x0eoccupancyTiers\x123\n" +
+	"\tmin_price\x18\x05 \x01(\v2\x16.booking_service.MoneyR\bminPrice\x123\n" +
+	"\tmax_price\x18\x06 \x01(\v2\x16.booking_service.MoneyR\bmaxPrice\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf7\x01\n" +
+	"\vDepositRule\x12\x19\n" +
+	"\bhotel_id\x18\x01 \x01(\x04R\ahotelId\x120\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1c.booking_service.DepositKindR\x04kind\x120\n" +
+	"\x14percent_basis_points\x18\x03 \x01(\rR\x12percentBasisPoints\x12.\n" +
+	"\x13balance_days_before\x18\x04 \x01(\rR\x11balanceDaysBefore\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"r\n" +
+	"\rOccupancyTier\x122\n" +
+	"\x15min_occupancy_percent\x18\x01 \x01(\rR\x13minOccupancyPercent\x12-\n" +
+	"\x12adjustment_percent\x18\x02 \x01(\x05R\x11adjustmentPercent\"w\n" +
+	"\x0fPriceAdjustment\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12.\n" +
+	"\x06amount\x18\x03 \x01(\v2\x16.booking_service.MoneyR\x06amount\"\xc0\x03\n" +
+	"\aTaxRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
+	"\bhotel_id\x18\x04 \x01(\x04R\ahotelId\x12,\n" +
+	"\x04kind\x18\x05 \x01(\x0e2\x18.booking_service.TaxKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12.\n" +
+	"\x06amount\x18\a \x01(\v2\x16.booking_service.MoneyR\x06amount\x120\n" +
+	"\x14percent_basis_points\x18\b \x01(\rR\x12percentBasisPoints\x129\n" +
+	"\n" +
+	"valid_from\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\"\xb9\x04\n" +
+	"\x0ePromotionTerms\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\bhotel_id\x18\x02 \x01(\x04R\ahotelId\x128\n" +
+	"\n" +
+	"room_types\x18\x03 \x03(\x0e2\x19.booking_service.RoomTypeR\troomTypes\x12B\n" +
+	"\rdiscount_type\x18\x04 \x01(\x0e2\x1d.booking_service.DiscountTypeR\fdiscountType\x12\x1f\n" +
+	"\vpercent_off\x18\x05 \x01(\rR\n" +
+	"percentOff\x125\n" +
+	"\n" +
+	"amount_off\x18\x06 \x01(\v2\x16.booking_service.MoneyR\tamountOff\x127\n" +
+	"\tstay_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bstayFrom\x123\n" +
+	"\astay_to\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06stayTo\x12\x1d\n" +
+	"\n" +
+	"min_nights\x18\t \x01(\rR\tminNights\x127\n" +
+	"\tbook_from\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bbookFrom\x123\n" +
+	"\abook_to\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x06bookTo\x12'\n" +
+	"\x0fmax_redemptions\x18\f \x01(\rR\x0emaxRedemptions\"\xea\x01\n" +
+	"\tPromotion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x125\n" +
+	"\x05terms\x18\x04 \x01(\v2\x1f.booking_service.PromotionTermsR\x05terms\x12 \n" +
+	"\vredemptions\x18\x05 \x01(\rR\vredemptions\"q\n" +
+	"\bDiscount\x12!\n" +
+	"\fpromotion_id\x18\x01 \x01(\x04R\vpromotionId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12.\n" +
+	"\x06amount\x18\x03 \x01(\v2\x16.booking_service.MoneyR\x06amount\"\xa9\x01\n" +
+	"\fExchangeRate\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xff\x01\n" +
+	"\x0eConvertedPrice\x12,\n" +
+	"\x05total\x18\x01 \x01(\v2\x16.booking_service.MoneyR\x05total\x122\n" +
+	"\bsubtotal\x18\x02 \x01(\v2\x16.booking_service.MoneyR\bsubtotal\x12,\n" +
+	"\x05taxes\x18\x03 \x01(\v2\x16.booking_service.MoneyR\x05taxes\x12*\n" +
+	"\x04fees\x18\x04 \x01(\v2\x16.booking_service.MoneyR\x04fees\x121\n" +
+	"\x04rate\x18\x05 \x01(\v2\x1d.booking_service.ExchangeRateR\x04rate\"\xb9\x01\n" +
+	"\tPriceItem\x12\x1e\n" +
+	"\vtax_rule_id\x18\x01 \x01(\x04R\ttaxRuleId\x12,\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x18.booking_service.TaxKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\rR\bquantity\x12.\n" +
+	"\x06amount\x18\x05 \x01(\v2\x16.booking_service.MoneyR\x06amount\"\xe8\x02\n" +
+	"\fBookingPrice\x12,\n" +
+	"\x05total\x18\x01 \x01(\v2\x16.booking_service.MoneyR\x05total\x123\n" +
+	"\x06nights\x18\x02 \x03(\v2\x1b.booking_service.NightPriceR\x06nights\x122\n" +
+	"\bsubtotal\x18\x03 \x01(\v2\x16.booking_service.MoneyR\bsubtotal\x12,\n" +
+	"\x05taxes\x18\x04 \x01(\v2\x16.booking_service.MoneyR\x05taxes\x12*\n" +
+	"\x04fees\x18\x05 \x01(\v2\x16.booking_service.MoneyR\x04fees\x120\n" +
+	"\x05items\x18\x06 \x03(\v2\x1a.booking_service.PriceItemR\x05items\x125\n" +
+	"\bdiscount\x18\a \x01(\v2\x19.booking_service.DiscountR\bdiscount\"\xa3\x02\n" +
+	"\n" +
+	"NightPrice\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12,\n" +
+	"\x05price\x18\x02 \x01(\v2\x16.booking_service.MoneyR\x05price\x12 \n" +
+	"\frate_plan_id\x18\x03 \x01(\x04R\n" +
+	"ratePlanId\x12\x1a\n" +
+	"\boverride\x18\x04 \x01(\bR\boverride\x125\n" +
+	"\n" +
+	"base_price\x18\x05 \x01(\v2\x16.booking_service.MoneyR\tbasePrice\x12B\n" +
+	"\vadjustments\x18\x06 \x03(\v2 .booking_service.PriceAdjustmentR\vadjustments\"\x99\x02\n" +
+	"\tRateRange\x126\n" +
+	"\troom_type\x18\x01 \x01(\x0e2\x19.booking_service.RoomTypeR\broomType\x129\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x124\n" +
+	"\bweekdays\x18\x04 \x03(\x0e2\x18.booking_service.WeekdayR\bweekdays\x12,\n" +
+	"\x05price\x18\x05 \x01(\v2\x16.booking_service.MoneyR\x05price\"\xd3\x02\n" +
+	"\rCalendarNight\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12,\n" +
+	"\x05price\x18\x02 \x01(\v2\x16.booking_service.MoneyR\x05price\x12 \n" +
+	"\frate_plan_id\x18\x03 \x01(\x04R\n" +
+	"ratePlanId\x12\x1a\n" +
+	"\boverride\x18\x04 \x01(\bR\boverride\x125\n" +
+	"\n" +
+	"base_price\x18\x05 \x01(\v2\x16.booking_service.MoneyR\tbasePrice\x12B\n" +
+	"\vadjustments\x18\x06 \x03(\v2 .booking_service.PriceAdjustmentR\vadjustments\x12+\n" +
+	"\x11occupancy_percent\x18\a \x01(\rR\x10occupancyPercent\"\xe7\x03\n" +
+	"\x05Folio\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x03 \x01(\x04R\tbookingId\x124\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1c.booking_service.FolioStatusR\x06status\x127\n" +
+	"\tclosed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\x126\n" +
+	"\acharges\x18\x06 \x03(\v2\x1c.booking_service.FolioChargeR\acharges\x12=\n" +
+	"\vsettlements\x18\a \x03(\v2\x1b.booking_service.SettlementR\vsettlements\x120\n" +
+	"\acharged\x18\b \x01(\v2\x16.booking_service.MoneyR\acharged\x12*\n" +
+	"\x04paid\x18\t \x01(\v2\x16.booking_service.MoneyR\x04paid\x120\n" +
+	"\abalance\x18\n" +
+	" \x01(\v2\x16.booking_service.MoneyR\abalance\"\xad\x04\n" +
+	"\vFolioCharge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\bcategory\x18\x03 \x01(\x0e2\x1f.booking_service.ChargeCategoryR\bcategory\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\rR\bquantity\x125\n" +
+	"\n" +
+	"unit_price\x18\x06 \x01(\v2\x16.booking_service.MoneyR\tunitPrice\x12(\n" +
+	"\x10tax_basis_points\x18\a \x01(\rR\x0etaxBasisPoints\x12(\n" +
+	"\x03net\x18\b \x01(\v2\x16.booking_service.MoneyR\x03net\x12(\n" +
+	"\x03tax\x18\t \x01(\v2\x16.booking_service.MoneyR\x03tax\x12,\n" +
+	"\x05total\x18\n" +
+	" \x01(\v2\x16.booking_service.MoneyR\x05total\x12\x1b\n" +
+	"\tposted_by\x18\v \x01(\tR\bpostedBy\x127\n" +
+	"\tvoided_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bvoidedAt\x12\x1f\n" +
+	"\vvoid_reason\x18\r \x01(\tR\n" +
+	"voidReason\"\xc6\x01\n" +
+	"\n" +
+	"Settlement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12.\n" +
+	"\x06amount\x18\x02 \x01(\v2\x16.booking_service.MoneyR\x06amount\x129\n" +
+	"\x06status\x18\x03 \x01(\x0e2!.booking_service.SettlementStatusR\x06status\x12=\n" +
+	"\fpayment_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vpaymentDate\"i\n" +
+	"\fInvoiceParty\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
+	"\x06tax_id\x18\x02 \x01(\tR\x05taxId\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\"\x84\x02\n" +
+	"\vInvoiceLine\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\rR\bquantity\x125\n" +
+	"\n" +
+	"unit_price\x18\x03 \x01(\v2\x16.booking_service.MoneyR\tunitPrice\x12(\n" +
+	"\x03net\x18\x04 \x01(\v2\x16.booking_service.MoneyR\x03net\x12(\n" +
+	"\x03tax\x18\x05 \x01(\v2\x16.booking_service.MoneyR\x03tax\x12,\n" +
+	"\x05total\x18\x06 \x01(\v2\x16.booking_service.MoneyR\x05total\"\xd8\x05\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\x12\x19\n" +
+	"\bhotel_id\x18\x03 \x01(\x04R\ahotelId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x04 \x01(\x04R\tbookingId\x12\x1f\n" +
+	"\vfiscal_year\x18\x05 \x01(\rR\n" +
+	"fiscalYear\x12'\n" +
+	"\x0fsequence_number\x18\x06 \x01(\x04R\x0esequenceNumber\x127\n" +
+	"\tissued_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x125\n" +
+	"\x06seller\x18\b \x01(\v2\x1d.booking_service.InvoicePartyR\x06seller\x123\n" +
+	"\x05buyer\x18\t \x01(\v2\x1d.booking_service.InvoicePartyR\x05buyer\x129\n" +
+	"\n" +
+	"stay_start\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstayStart\x125\n" +
+	"\bstay_end\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\astayEnd\x122\n" +
+	"\x05lines\x18\f \x03(\v2\x1c.booking_service.InvoiceLineR\x05lines\x12(\n" +
+	"\x03net\x18\r \x01(\v2\x16.booking_service.MoneyR\x03net\x12(\n" +
+	"\x03tax\x18\x0e \x01(\v2\x16.booking_service.MoneyR\x03tax\x12,\n" +
+	"\x05total\x18\x0f \x01(\v2\x16.booking_service.MoneyR\x05total\x12*\n" +
+	"\x04paid\x18\x10 \x01(\v2\x16.booking_service.MoneyR\x04paid\x12(\n" +
+	"\x03due\x18\x11 \x01(\v2\x16.booking_service.MoneyR\x03due\"\xe2\x05\n" +
 	"\aBooking\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
 	"\n" +
@@ -1552,7 +10788,13 @@ const file_booking_service_proto_rawDesc = "" +
 	"\acomment\x18\a \x01(\tR\acomment\x126\n" +
 	"\x06status\x18\b \x01(\x0e2\x1e.booking_service.BookingStatusR\x06status\x12.\n" +
 	"\x06guests\x18\n" +
-	" \x03(\v2\x16.booking_service.GuestR\x06guests*\x83\x01\n" +
+	" \x03(\v2\x16.booking_service.GuestR\x06guests\x123\n" +
+	"\x05price\x18\v \x01(\v2\x1d.booking_service.BookingPriceR\x05price\x12F\n" +
+	"\fcancellation\x18\f \x01(\v2\".booking_service.CancellationTermsR\fcancellation\x12L\n" +
+	"\x0epayment_status\x18\r \x01(\x0e2%.booking_service.BookingPaymentStatusR\rpaymentStatus\x127\n" +
+	"\vamount_paid\x18\x0e \x01(\v2\x16.booking_service.MoneyR\n" +
+	"amountPaid\x12@\n" +
+	"\x0ebalance_due_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\fbalanceDueAt*\x83\x01\n" +
 	"\rBookingStatus\x12\x1a\n" +
 	"\x16BOOKING_STATUS_UNKNOWN\x10\x00\x12\x1a\n" +
 	"\x16BOOKING_STATUS_SUCCESS\x10\x01\x12\x1c\n" +
@@ -1563,7 +10805,110 @@ const file_booking_service_proto_rawDesc = "" +
 	"\x14ROOM_TYPE_LOW_BUDGET\x10\x01\x12\x18\n" +
 	"\x14ROOM_TYPE_MID_BUDGET\x10\x02\x12\x19\n" +
 	"\x15ROOM_TYPE_HIGH_BUDGET\x10\x03\x12\x1c\n" +
-	"\x18ROOM_TYPE_HIGH_PRESIDENT\x10\x042\xbf\a\n" +
+	"\x18ROOM_TYPE_HIGH_PRESIDENT\x10\x04*\x8e\x01\n" +
+	"\n" +
+	"RoomStatus\x12\x17\n" +
+	"\x13ROOM_STATUS_UNKNOWN\x10\x00\x12\x15\n" +
+	"\x11ROOM_STATUS_CLEAN\x10\x01\x12\x15\n" +
+	"\x11ROOM_STATUS_DIRTY\x10\x02\x12\x19\n" +
+	"\x15ROOM_STATUS_INSPECTED\x10\x03\x12\x1e\n" +
+	"\x1aROOM_STATUS_OUT_OF_SERVICE\x10\x04*\x9d\x01\n" +
+	"\fEmployeeRole\x12\x19\n" +
+	"\x15EMPLOYEE_ROLE_UNKNOWN\x10\x00\x12\x17\n" +
+	"\x13EMPLOYEE_ROLE_ADMIN\x10\x01\x12\x19\n" +
+	"\x15EMPLOYEE_ROLE_MANAGER\x10\x02\x12\x1e\n" +
+	"\x1aEMPLOYEE_ROLE_RECEPTIONIST\x10\x03\x12\x1e\n" +
+	"\x1aEMPLOYEE_ROLE_HOUSEKEEPING\x10\x04*\xd6\x04\n" +
+	"\x0fAuditEntityType\x12\x1d\n" +
+	"\x19AUDIT_ENTITY_TYPE_UNKNOWN\x10\x00\x12\x1b\n" +
+	"\x17AUDIT_ENTITY_TYPE_HOTEL\x10\x01\x12\x1a\n" +
+	"\x16AUDIT_ENTITY_TYPE_ROOM\x10\x02\x12\x1d\n" +
+	"\x19AUDIT_ENTITY_TYPE_BOOKING\x10\x03\x12\x1b\n" +
+	"\x17AUDIT_ENTITY_TYPE_GUEST\x10\x04\x12\x1c\n" +
+	"\x18AUDIT_ENTITY_TYPE_REVIEW\x10\x05\x12\x1e\n" +
+	"\x1aAUDIT_ENTITY_TYPE_EMPLOYEE\x10\x06\x12\x1f\n" +
+	"\x1bAUDIT_ENTITY_TYPE_RATE_PLAN\x10\a\x12#\n" +
+	"\x1fAUDIT_ENTITY_TYPE_RATE_CALENDAR\x10\b\x12\"\n" +
+	"\x1eAUDIT_ENTITY_TYPE_PRICING_RULE\x10\t\x12\x1e\n" +
+	"\x1aAUDIT_ENTITY_TYPE_TAX_RULE\x10\n" +
+	"\x12\x1f\n" +
+	"\x1bAUDIT_ENTITY_TYPE_PROMOTION\x10\v\x12#\n" +
+	"\x1fAUDIT_ENTITY_TYPE_EXCHANGE_RATE\x10\f\x12\x1b\n" +
+	"\x17AUDIT_ENTITY_TYPE_FOLIO\x10\r\x12\"\n" +
+	"\x1eAUDIT_ENTITY_TYPE_FOLIO_CHARGE\x10\x0e\x12\x1d\n" +
+	"\x19AUDIT_ENTITY_TYPE_PAYMENT\x10\x0f\x12\x1d\n" +
+	"\x19AUDIT_ENTITY_TYPE_INVOICE\x10\x10\x12\"\n" +
+	"\x1eAUDIT_ENTITY_TYPE_DEPOSIT_RULE\x10\x11*\xce\x01\n" +
+	"\x14BookingPaymentStatus\x12\"\n" +
+	"\x1eBOOKING_PAYMENT_STATUS_UNKNOWN\x10\x00\x12!\n" +
+	"\x1dBOOKING_PAYMENT_STATUS_UNPAID\x10\x01\x12)\n" +
+	"%BOOKING_PAYMENT_STATUS_PARTIALLY_PAID\x10\x02\x12\x1f\n" +
+	"\x1bBOOKING_PAYMENT_STATUS_PAID\x10\x03\x12#\n" +
+	"\x1fBOOKING_PAYMENT_STATUS_REFUNDED\x10\x04*\xd5\x01\n" +
+	"\x17ReconciliationIssueKind\x12%\n" +
+	"!RECONCILIATION_ISSUE_KIND_UNKNOWN\x10\x00\x120\n" +
+	",RECONCILIATION_ISSUE_KIND_CANCELLED_BUT_PAID\x10\x01\x122\n" +
+	".RECONCILIATION_ISSUE_KIND_UNPAID_PAST_DEADLINE\x10\x02\x12-\n" +
+	")RECONCILIATION_ISSUE_KIND_AMOUNT_MISMATCH\x10\x03*`\n" +
+	"\rInvoiceFormat\x12\x1e\n" +
+	"\x1aINVOICE_FORMAT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12INVOICE_FORMAT_PDF\x10\x01\x12\x17\n" +
+	"\x13INVOICE_FORMAT_JSON\x10\x02*[\n" +
+	"\vFolioStatus\x12\x1c\n" +
+	"\x18FOLIO_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11FOLIO_STATUS_OPEN\x10\x01\x12\x17\n" +
+	"\x13FOLIO_STATUS_CLOSED\x10\x02*\xfe\x01\n" +
+	"\x0eChargeCategory\x12\x1f\n" +
+	"\x1bCHARGE_CATEGORY_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCHARGE_CATEGORY_ROOM_SERVICE\x10\x01\x12\x1b\n" +
+	"\x17CHARGE_CATEGORY_MINIBAR\x10\x02\x12\x1e\n" +
+	"\x1aCHARGE_CATEGORY_RESTAURANT\x10\x03\x12\x1b\n" +
+	"\x17CHARGE_CATEGORY_LAUNDRY\x10\x04\x12\x1b\n" +
+	"\x17CHARGE_CATEGORY_PARKING\x10\x05\x12\x17\n" +
+	"\x13CHARGE_CATEGORY_SPA\x10\x06\x12\x19\n" +
+	"\x15CHARGE_CATEGORY_OTHER\x10\a*\x92\x01\n" +
+	"\x10SettlementStatus\x12!\n" +
+	"\x1dSETTLEMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SETTLEMENT_STATUS_SUCCESS\x10\x01\x12\x1c\n" +
+	"\x18SETTLEMENT_STATUS_FAILED\x10\x02\x12\x1e\n" +
+	"\x1aSETTLEMENT_STATUS_CANCELED\x10\x03*a\n" +
+	"\fDiscountType\x12\x1d\n" +
+	"\x19DISCOUNT_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15DISCOUNT_TYPE_PERCENT\x10\x01\x12\x17\n" +
+	"\x13DISCOUNT_TYPE_FIXED\x10\x02*^\n" +
+	"\aTaxKind\x12\x18\n" +
+	"\x14TAX_KIND_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11TAX_KIND_CITY_TAX\x10\x01\x12\x10\n" +
+	"\fTAX_KIND_VAT\x10\x02\x12\x10\n" +
+	"\fTAX_KIND_FEE\x10\x03*o\n" +
+	"\x0fPricingStrategy\x12 \n" +
+	"\x1cPRICING_STRATEGY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PRICING_STRATEGY_FIXED\x10\x01\x12\x1e\n" +
+	"\x1aPRICING_STRATEGY_OCCUPANCY\x10\x02*\x8b\x01\n" +
+	"\fDocumentType\x12\x1d\n" +
+	"\x19DOCUMENT_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16DOCUMENT_TYPE_PASSPORT\x10\x01\x12\x1d\n" +
+	"\x19DOCUMENT_TYPE_NATIONAL_ID\x10\x02\x12!\n" +
+	"\x1dDOCUMENT_TYPE_DRIVING_LICENCE\x10\x03*\x9e\x01\n" +
+	"\rGuestMatchKey\x12\x1f\n" +
+	"\x1bGUEST_MATCH_KEY_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15GUEST_MATCH_KEY_EMAIL\x10\x01\x12\x19\n" +
+	"\x15GUEST_MATCH_KEY_PHONE\x10\x02\x12\x1c\n" +
+	"\x18GUEST_MATCH_KEY_DOCUMENT\x10\x03\x12\x18\n" +
+	"\x14GUEST_MATCH_KEY_NAME\x10\x04*c\n" +
+	"\vDepositKind\x12\x1c\n" +
+	"\x18DEPOSIT_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14DEPOSIT_KIND_PERCENT\x10\x01\x12\x1c\n" +
+	"\x18DEPOSIT_KIND_FIRST_NIGHT\x10\x02*\xb6\x01\n" +
+	"\aWeekday\x12\x17\n" +
+	"\x13WEEKDAY_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eWEEKDAY_MONDAY\x10\x01\x12\x13\n" +
+	"\x0fWEEKDAY_TUESDAY\x10\x02\x12\x15\n" +
+	"\x11WEEKDAY_WEDNESDAY\x10\x03\x12\x14\n" +
+	"\x10WEEKDAY_THURSDAY\x10\x04\x12\x12\n" +
+	"\x0eWEEKDAY_FRIDAY\x10\x05\x12\x14\n" +
+	"\x10WEEKDAY_SATURDAY\x10\x06\x12\x12\n" +
+	"\x0eWEEKDAY_SUNDAY\x10\a2\xfc9\n" +
 	"\x0eBookingService\x12o\n" +
 	"\vCreateHotel\x12#.booking_service.CreateHotelRequest\x1a$.booking_service.CreateHotelResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/v1/hotels\x12j\n" +
@@ -1573,11 +10918,62 @@ const file_booking_service_proto_rawDesc = "" +
 	"UpdateRoom\x12\".booking_service.UpdateRoomRequest\x1a#.booking_service.UpdateRoomResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\x1a\b/v1/room\x12v\n" +
 	"\rCreateBooking\x12%.booking_service.CreateBookingRequest\x1a&.booking_service.CreateBookingResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\x1a\v/v1/booking\x12\x80\x01\n" +
 	"\rCancelBooking\x12%.booking_service.CancelBookingRequest\x1a&.booking_service.CancelBookingResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/v1/booking/{booking_id}\x12\x83\x01\n" +
-	"\rModifyBooking\x12%.booking_service.ModifyBookingRequest\x1a&.booking_service.ModifyBookingResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/v1/booking/{booking_id}\x12o\n" +
+	"\rModifyBooking\x12%.booking_service.ModifyBookingRequest\x1a&.booking_service.ModifyBookingResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/v1/booking/{booking_id}\x12z\n" +
+	"\x0eListMyBookings\x12&.booking_service.ListMyBookingsRequest\x1a'.booking_service.ListMyBookingsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/me/bookings\x12o\n" +
 	"\vCreateGuest\x12#.booking_service.CreateGuestRequest\x1a$.booking_service.CreateGuestResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
-	"/v1/guests\x12r\n" +
+	"/v1/guests\x12n\n" +
+	"\bGetGuest\x12 .booking_service.GetGuestRequest\x1a!.booking_service.GetGuestResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/guests/{guest_id}\x12z\n" +
+	"\vUpdateGuest\x12#.booking_service.UpdateGuestRequest\x1a$.booking_service.UpdateGuestResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/guests/{guest_id}\x12v\n" +
+	"\fSearchGuests\x12$.booking_service.SearchGuestsRequest\x1a%.booking_service.SearchGuestsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/guests:search\x12\x87\x01\n" +
+	"\vMergeGuests\x12#.booking_service.MergeGuestsRequest\x1a$.booking_service.MergeGuestsResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/guests/{target_guest_id}:merge\x12v\n" +
+	"\x0fExportGuestData\x12'.booking_service.ExportGuestDataRequest\x1a\x14.google.api.HttpBody\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/guests/{guest_id}/export\x12}\n" +
+	"\n" +
+	"EraseGuest\x12\".booking_service.EraseGuestRequest\x1a#.booking_service.EraseGuestResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/guests/{guest_id}:erase\x12\x8f\x01\n" +
+	"\x13ListDuplicateGuests\x12+.booking_service.ListDuplicateGuestsRequest\x1a,.booking_service.ListDuplicateGuestsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/guests:duplicates\x12r\n" +
 	"\fSubmitReview\x12$.booking_service.SubmitReviewRequest\x1a%.booking_service.SubmitReviewResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
-	"/v1/reviewBAZ?github.com/dezzmol/booking-service/internal/generated;generatedb\x06proto3"
+	"/v1/review\x12\x8d\x01\n" +
+	"\x10UpdateRoomStatus\x12(.booking_service.UpdateRoomStatusRequest\x1a).booking_service.UpdateRoomStatusResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v1/room/{room_id}/status\x12{\n" +
+	"\x0eCreateEmployee\x12&.booking_service.CreateEmployeeRequest\x1a'.booking_service.CreateEmployeeResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/employees\x12}\n" +
+	"\vGetEmployee\x12#.booking_service.GetEmployeeRequest\x1a$.booking_service.GetEmployeeResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/employees/{employee_id}\x12\x87\x01\n" +
+	"\rListEmployees\x12%.booking_service.ListEmployeesRequest\x1a&.booking_service.ListEmployeesResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/hotels/{hotel_id}/employees\x12\x89\x01\n" +
+	"\x0eUpdateEmployee\x12&.booking_service.UpdateEmployeeRequest\x1a'.booking_service.UpdateEmployeeResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/v1/employees/{employee_id}\x12\x86\x01\n" +
+	"\x0eDeleteEmployee\x12&.booking_service.DeleteEmployeeRequest\x1a'.booking_service.DeleteEmployeeResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/employees/{employee_id}\x12\x8e\x01\n" +
+	"\x0eCreateRatePlan\x12&.booking_service.CreateRatePlanRequest\x1a'.booking_service.CreateRatePlanResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/hotels/{hotel_id}/rate-plans\x12\x88\x01\n" +
+	"\rListRatePlans\x12%.booking_service.ListRatePlansRequest\x1a&.booking_service.ListRatePlansResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/hotels/{hotel_id}/rate-plans\x12\x8b\x01\n" +
+	"\x0eUpdateRatePlan\x12&.booking_service.UpdateRatePlanRequest\x1a'.booking_service.UpdateRatePlanResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/v1/rate-plans/{rate_plan_id}\x12\x88\x01\n" +
+	"\x0eDeleteRatePlan\x12&.booking_service.DeleteRatePlanRequest\x1a'.booking_service.DeleteRatePlanResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/rate-plans/{rate_plan_id}\x12w\n" +
+	"\bSetRates\x12 .booking_service.SetRatesRequest\x1a!.booking_service.SetRatesResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/hotels/{hotel_id}/rates\x12\x91\x01\n" +
+	"\x0fGetRateCalendar\x12'.booking_service.GetRateCalendarRequest\x1a(.booking_service.GetRateCalendarResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/hotels/{hotel_id}/rate-calendar\x12\x9d\x01\n" +
+	"\x0eSetPricingRule\x12&.booking_service.SetPricingRuleRequest\x1a'.booking_service.SetPricingRuleResponse\":\x82\xd3\xe4\x93\x024:\x01*\x1a//v1/hotels/{hotel_id}/pricing-rules/{room_type}\x12\x94\x01\n" +
+	"\x10ListPricingRules\x12(.booking_service.ListPricingRulesRequest\x1a).booking_service.ListPricingRulesResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/hotels/{hotel_id}/pricing-rules\x12\xa3\x01\n" +
+	"\x11DeletePricingRule\x12).booking_service.DeletePricingRuleRequest\x1a*.booking_service.DeletePricingRuleResponse\"7\x82\xd3\xe4\x93\x021*//v1/hotels/{hotel_id}/pricing-rules/{room_type}\x12\x90\x01\n" +
+	"\x0eSetDepositRule\x12&.booking_service.SetDepositRuleRequest\x1a'.booking_service.SetDepositRuleResponse\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/v1/hotels/{hotel_id}/deposit-rule\x12\x8d\x01\n" +
+	"\x0eGetDepositRule\x12&.booking_service.GetDepositRuleRequest\x1a'.booking_service.GetDepositRuleResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/hotels/{hotel_id}/deposit-rule\x12\x96\x01\n" +
+	"\x11DeleteDepositRule\x12).booking_service.DeleteDepositRuleRequest\x1a*.booking_service.DeleteDepositRuleResponse\"*\x82\xd3\xe4\x93\x02$*\"/v1/hotels/{hotel_id}/deposit-rule\x12\x8a\x01\n" +
+	"\rCreateTaxRule\x12%.booking_service.CreateTaxRuleRequest\x1a&.booking_service.CreateTaxRuleResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/hotels/{hotel_id}/tax-rules\x12\x84\x01\n" +
+	"\fListTaxRules\x12$.booking_service.ListTaxRulesRequest\x1a%.booking_service.ListTaxRulesResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/hotels/{hotel_id}/tax-rules\x12\x83\x01\n" +
+	"\rDeleteTaxRule\x12%.booking_service.DeleteTaxRuleRequest\x1a&.booking_service.DeleteTaxRuleResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/tax-rules/{tax_rule_id}\x12\x7f\n" +
+	"\n" +
+	"PayBooking\x12\".booking_service.PayBookingRequest\x1a#.booking_service.PayBookingResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/bookings/{booking_id}/pay\x12x\n" +
+	"\bGetFolio\x12 .booking_service.GetFolioRequest\x1a!.booking_service.GetFolioResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/bookings/{booking_id}/folio\x12\x98\x01\n" +
+	"\x0fPostFolioCharge\x12'.booking_service.PostFolioChargeRequest\x1a(.booking_service.PostFolioChargeResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/bookings/{booking_id}/folio/charges\x12\xa9\x01\n" +
+	"\x0fVoidFolioCharge\x12'.booking_service.VoidFolioChargeRequest\x1a(.booking_service.VoidFolioChargeResponse\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/bookings/{booking_id}/folio/charges/{charge_id}/void\x12\x8b\x01\n" +
+	"\vSettleFolio\x12#.booking_service.SettleFolioRequest\x1a$.booking_service.SettleFolioResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/bookings/{booking_id}/folio/settle\x12\x87\x01\n" +
+	"\n" +
+	"CloseFolio\x12\".booking_service.CloseFolioRequest\x1a#.booking_service.CloseFolioResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/bookings/{booking_id}/folio/close\x12\x89\x01\n" +
+	"\fIssueInvoice\x12$.booking_service.IssueInvoiceRequest\x1a%.booking_service.IssueInvoiceResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/bookings/{booking_id}/invoice\x12x\n" +
+	"\n" +
+	"GetInvoice\x12\".booking_service.GetInvoiceRequest\x1a#.booking_service.GetInvoiceResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/invoices/{invoice_id}\x12|\n" +
+	"\x0fDownloadInvoice\x12'.booking_service.DownloadInvoiceRequest\x1a\x14.google.api.HttpBody\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/invoices/{invoice_id}/download\x12\x7f\n" +
+	"\x0fCreatePromotion\x12'.booking_service.CreatePromotionRequest\x1a(.booking_service.CreatePromotionResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/promotions\x12y\n" +
+	"\x0eListPromotions\x12&.booking_service.ListPromotionsRequest\x1a'.booking_service.ListPromotionsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/promotions\x12\x8e\x01\n" +
+	"\x0fUpdatePromotion\x12'.booking_service.UpdatePromotionRequest\x1a(.booking_service.UpdatePromotionResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/v1/promotions/{promotion_id}\x12\x8b\x01\n" +
+	"\x0fDeletePromotion\x12'.booking_service.DeletePromotionRequest\x1a(.booking_service.DeletePromotionResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/promotions/{promotion_id}\x12{\n" +
+	"\tQuoteStay\x12!.booking_service.QuoteStayRequest\x1a\".booking_service.QuoteStayResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/hotels/{hotel_id}/quotes\x12\x86\x01\n" +
+	"\x10SetExchangeRates\x12(.booking_service.SetExchangeRatesRequest\x1a).booking_service.SetExchangeRatesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/v1/exchange-rates\x12\x86\x01\n" +
+	"\x11ListExchangeRates\x12).booking_service.ListExchangeRatesRequest\x1a*.booking_service.ListExchangeRatesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/exchange-rates\x12\x9f\x01\n" +
+	"\x17GetReconciliationReport\x12/.booking_service.GetReconciliationReportRequest\x1a0.booking_service.GetReconciliationReportResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/reconciliation/report\x12~\n" +
+	"\x0fListAuditEvents\x12'.booking_service.ListAuditEventsRequest\x1a(.booking_service.ListAuditEventsResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/audit-eventsBAZ?github.com/dezzmol/booking-service/internal/generated;generatedb\x06proto3"
 
 var (
 	file_booking_service_proto_rawDescOnce sync.Once
@@ -1591,86 +10987,534 @@ func file_booking_service_proto_rawDescGZIP() []byte {
 	return file_booking_service_proto_rawDescData
 }
 
-var file_booking_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_booking_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_booking_service_proto_enumTypes = make([]protoimpl.EnumInfo, 18)
+var file_booking_service_proto_msgTypes = make([]protoimpl.MessageInfo, 147)
 var file_booking_service_proto_goTypes = []any{
-	(BookingStatus)(0),                // 0: booking_service.BookingStatus
-	(RoomType)(0),                     // 1: booking_service.RoomType
-	(*CreateHotelRequest)(nil),        // 2: booking_service.CreateHotelRequest
-	(*CreateHotelResponse)(nil),       // 3: booking_service.CreateHotelResponse
-	(*CreateRoomRequest)(nil),         // 4: booking_service.CreateRoomRequest
-	(*CreateRoomResponse)(nil),        // 5: booking_service.CreateRoomResponse
-	(*UpdateRoomRequest)(nil),         // 6: booking_service.UpdateRoomRequest
-	(*UpdateRoomResponse)(nil),        // 7: booking_service.UpdateRoomResponse
-	(*CreateBookingRequest)(nil),      // 8: booking_service.CreateBookingRequest
-	(*CreateBookingResponse)(nil),     // 9: booking_service.CreateBookingResponse
-	(*CancelBookingRequest)(nil),      // 10: booking_service.CancelBookingRequest
-	(*CancelBookingResponse)(nil),     // 11: booking_service.CancelBookingResponse
-	(*ModifyBookingRequest)(nil),      // 12: booking_service.ModifyBookingRequest
-	(*ModifyBookingResponse)(nil),     // 13: booking_service.ModifyBookingResponse
-	(*CreateGuestRequest)(nil),        // 14: booking_service.CreateGuestRequest
-	(*CreateGuestResponse)(nil),       // 15: booking_service.CreateGuestResponse
-	(*SubmitReviewRequest)(nil),       // 16: booking_service.SubmitReviewRequest
-	(*SubmitReviewResponse)(nil),      // 17: booking_service.SubmitReviewResponse
-	(*Room)(nil),                      // 18: booking_service.Room
-	(*Review)(nil),                    // 19: booking_service.Review
-	(*Hotel)(nil),                     // 20: booking_service.Hotel
-	(*Guest)(nil),                     // 21: booking_service.Guest
-	(*Booking)(nil),                   // 22: booking_service.Booking
-	(*CreateRoomRequest_DTO)(nil),     // 23: booking_service.CreateRoomRequest.DTO
-	(*CreateBookingRequestGuest)(nil), // 24: booking_service.CreateBookingRequest.guest
-	(*timestamppb.Timestamp)(nil),     // 25: google.protobuf.Timestamp
+	(BookingStatus)(0),                      // 0: booking_service.BookingStatus
+	(RoomType)(0),                           // 1: booking_service.RoomType
+	(RoomStatus)(0),                         // 2: booking_service.RoomStatus
+	(EmployeeRole)(0),                       // 3: booking_service.EmployeeRole
+	(AuditEntityType)(0),                    // 4: booking_service.AuditEntityType
+	(BookingPaymentStatus)(0),               // 5: booking_service.BookingPaymentStatus
+	(ReconciliationIssueKind)(0),            // 6: booking_service.ReconciliationIssueKind
+	(InvoiceFormat)(0),                      // 7: booking_service.InvoiceFormat
+	(FolioStatus)(0),                        // 8: booking_service.FolioStatus
+	(ChargeCategory)(0),                     // 9: booking_service.ChargeCategory
+	(SettlementStatus)(0),                   // 10: booking_service.SettlementStatus
+	(DiscountType)(0),                       // 11: booking_service.DiscountType
+	(TaxKind)(0),                            // 12: booking_service.TaxKind
+	(PricingStrategy)(0),                    // 13: booking_service.PricingStrategy
+	(DocumentType)(0),                       // 14: booking_service.DocumentType
+	(GuestMatchKey)(0),                      // 15: booking_service.GuestMatchKey
+	(DepositKind)(0),                        // 16: booking_service.DepositKind
+	(Weekday)(0),                            // 17: booking_service.Weekday
+	(*CreateHotelRequest)(nil),              // 18: booking_service.CreateHotelRequest
+	(*CreateHotelResponse)(nil),             // 19: booking_service.CreateHotelResponse
+	(*CreateRoomRequest)(nil),               // 20: booking_service.CreateRoomRequest
+	(*CreateRoomResponse)(nil),              // 21: booking_service.CreateRoomResponse
+	(*UpdateRoomRequest)(nil),               // 22: booking_service.UpdateRoomRequest
+	(*UpdateRoomResponse)(nil),              // 23: booking_service.UpdateRoomResponse
+	(*CreateBookingRequest)(nil),            // 24: booking_service.CreateBookingRequest
+	(*CreateBookingResponse)(nil),           // 25: booking_service.CreateBookingResponse
+	(*CancelBookingRequest)(nil),            // 26: booking_service.CancelBookingRequest
+	(*CancelBookingResponse)(nil),           // 27: booking_service.CancelBookingResponse
+	(*ModifyBookingRequest)(nil),            // 28: booking_service.ModifyBookingRequest
+	(*ModifyBookingResponse)(nil),           // 29: booking_service.ModifyBookingResponse
+	(*ListMyBookingsRequest)(nil),           // 30: booking_service.ListMyBookingsRequest
+	(*ListMyBookingsResponse)(nil),          // 31: booking_service.ListMyBookingsResponse
+	(*CreateGuestRequest)(nil),              // 32: booking_service.CreateGuestRequest
+	(*CreateGuestResponse)(nil),             // 33: booking_service.CreateGuestResponse
+	(*GetGuestRequest)(nil),                 // 34: booking_service.GetGuestRequest
+	(*GetGuestResponse)(nil),                // 35: booking_service.GetGuestResponse
+	(*UpdateGuestRequest)(nil),              // 36: booking_service.UpdateGuestRequest
+	(*UpdateGuestResponse)(nil),             // 37: booking_service.UpdateGuestResponse
+	(*SearchGuestsRequest)(nil),             // 38: booking_service.SearchGuestsRequest
+	(*SearchGuestsResponse)(nil),            // 39: booking_service.SearchGuestsResponse
+	(*MergeGuestsRequest)(nil),              // 40: booking_service.MergeGuestsRequest
+	(*MergeGuestsResponse)(nil),             // 41: booking_service.MergeGuestsResponse
+	(*ExportGuestDataRequest)(nil),          // 42: booking_service.ExportGuestDataRequest
+	(*GuestDataExport)(nil),                 // 43: booking_service.GuestDataExport
+	(*EraseGuestRequest)(nil),               // 44: booking_service.EraseGuestRequest
+	(*EraseGuestResponse)(nil),              // 45: booking_service.EraseGuestResponse
+	(*ListDuplicateGuestsRequest)(nil),      // 46: booking_service.ListDuplicateGuestsRequest
+	(*ListDuplicateGuestsResponse)(nil),     // 47: booking_service.ListDuplicateGuestsResponse
+	(*DuplicateGuests)(nil),                 // 48: booking_service.DuplicateGuests
+	(*SubmitReviewRequest)(nil),             // 49: booking_service.SubmitReviewRequest
+	(*SubmitReviewResponse)(nil),            // 50: booking_service.SubmitReviewResponse
+	(*UpdateRoomStatusRequest)(nil),         // 51: booking_service.UpdateRoomStatusRequest
+	(*UpdateRoomStatusResponse)(nil),        // 52: booking_service.UpdateRoomStatusResponse
+	(*CreateEmployeeRequest)(nil),           // 53: booking_service.CreateEmployeeRequest
+	(*CreateEmployeeResponse)(nil),          // 54: booking_service.CreateEmployeeResponse
+	(*GetEmployeeRequest)(nil),              // 55: booking_service.GetEmployeeRequest
+	(*GetEmployeeResponse)(nil),             // 56: booking_service.GetEmployeeResponse
+	(*ListEmployeesRequest)(nil),            // 57: booking_service.ListEmployeesRequest
+	(*ListEmployeesResponse)(nil),           // 58: booking_service.ListEmployeesResponse
+	(*UpdateEmployeeRequest)(nil),           // 59: booking_service.UpdateEmployeeRequest
+	(*UpdateEmployeeResponse)(nil),          // 60: booking_service.UpdateEmployeeResponse
+	(*DeleteEmployeeRequest)(nil),           // 61: booking_service.DeleteEmployeeRequest
+	(*DeleteEmployeeResponse)(nil),          // 62: booking_service.DeleteEmployeeResponse
+	(*CreateRatePlanRequest)(nil),           // 63: booking_service.CreateRatePlanRequest
+	(*CreateRatePlanResponse)(nil),          // 64: booking_service.CreateRatePlanResponse
+	(*ListRatePlansRequest)(nil),            // 65: booking_service.ListRatePlansRequest
+	(*ListRatePlansResponse)(nil),           // 66: booking_service.ListRatePlansResponse
+	(*UpdateRatePlanRequest)(nil),           // 67: booking_service.UpdateRatePlanRequest
+	(*UpdateRatePlanResponse)(nil),          // 68: booking_service.UpdateRatePlanResponse
+	(*DeleteRatePlanRequest)(nil),           // 69: booking_service.DeleteRatePlanRequest
+	(*DeleteRatePlanResponse)(nil),          // 70: booking_service.DeleteRatePlanResponse
+	(*SetRatesRequest)(nil),                 // 71: booking_service.SetRatesRequest
+	(*SetRatesResponse)(nil),                // 72: booking_service.SetRatesResponse
+	(*GetRateCalendarRequest)(nil),          // 73: booking_service.GetRateCalendarRequest
+	(*GetRateCalendarResponse)(nil),         // 74: booking_service.GetRateCalendarResponse
+	(*SetPricingRuleRequest)(nil),           // 75: booking_service.SetPricingRuleRequest
+	(*SetPricingRuleResponse)(nil),          // 76: booking_service.SetPricingRuleResponse
+	(*ListPricingRulesRequest)(nil),         // 77: booking_service.ListPricingRulesRequest
+	(*ListPricingRulesResponse)(nil),        // 78: booking_service.ListPricingRulesResponse
+	(*DeletePricingRuleRequest)(nil),        // 79: booking_service.DeletePricingRuleRequest
+	(*DeletePricingRuleResponse)(nil),       // 80: booking_service.DeletePricingRuleResponse
+	(*SetDepositRuleRequest)(nil),           // 81: booking_service.SetDepositRuleRequest
+	(*SetDepositRuleResponse)(nil),          // 82: booking_service.SetDepositRuleResponse
+	(*GetDepositRuleRequest)(nil),           // 83: booking_service.GetDepositRuleRequest
+	(*GetDepositRuleResponse)(nil),          // 84: booking_service.GetDepositRuleResponse
+	(*DeleteDepositRuleRequest)(nil),        // 85: booking_service.DeleteDepositRuleRequest
+	(*DeleteDepositRuleResponse)(nil),       // 86: booking_service.DeleteDepositRuleResponse
+	(*CreateTaxRuleRequest)(nil),            // 87: booking_service.CreateTaxRuleRequest
+	(*CreateTaxRuleResponse)(nil),           // 88: booking_service.CreateTaxRuleResponse
+	(*ListTaxRulesRequest)(nil),             // 89: booking_service.ListTaxRulesRequest
+	(*ListTaxRulesResponse)(nil),            // 90: booking_service.ListTaxRulesResponse
+	(*DeleteTaxRuleRequest)(nil),            // 91: booking_service.DeleteTaxRuleRequest
+	(*DeleteTaxRuleResponse)(nil),           // 92: booking_service.DeleteTaxRuleResponse
+	(*PayBookingRequest)(nil),               // 93: booking_service.PayBookingRequest
+	(*PayBookingResponse)(nil),              // 94: booking_service.PayBookingResponse
+	(*GetFolioRequest)(nil),                 // 95: booking_service.GetFolioRequest
+	(*GetFolioResponse)(nil),                // 96: booking_service.GetFolioResponse
+	(*PostFolioChargeRequest)(nil),          // 97: booking_service.PostFolioChargeRequest
+	(*PostFolioChargeResponse)(nil),         // 98: booking_service.PostFolioChargeResponse
+	(*VoidFolioChargeRequest)(nil),          // 99: booking_service.VoidFolioChargeRequest
+	(*VoidFolioChargeResponse)(nil),         // 100: booking_service.VoidFolioChargeResponse
+	(*SettleFolioRequest)(nil),              // 101: booking_service.SettleFolioRequest
+	(*SettleFolioResponse)(nil),             // 102: booking_service.SettleFolioResponse
+	(*CloseFolioRequest)(nil),               // 103: booking_service.CloseFolioRequest
+	(*CloseFolioResponse)(nil),              // 104: booking_service.CloseFolioResponse
+	(*IssueInvoiceRequest)(nil),             // 105: booking_service.IssueInvoiceRequest
+	(*IssueInvoiceResponse)(nil),            // 106: booking_service.IssueInvoiceResponse
+	(*GetInvoiceRequest)(nil),               // 107: booking_service.GetInvoiceRequest
+	(*GetInvoiceResponse)(nil),              // 108: booking_service.GetInvoiceResponse
+	(*DownloadInvoiceRequest)(nil),          // 109: booking_service.DownloadInvoiceRequest
+	(*CreatePromotionRequest)(nil),          // 110: booking_service.CreatePromotionRequest
+	(*CreatePromotionResponse)(nil),         // 111: booking_service.CreatePromotionResponse
+	(*ListPromotionsRequest)(nil),           // 112: booking_service.ListPromotionsRequest
+	(*ListPromotionsResponse)(nil),          // 113: booking_service.ListPromotionsResponse
+	(*UpdatePromotionRequest)(nil),          // 114: booking_service.UpdatePromotionRequest
+	(*UpdatePromotionResponse)(nil),         // 115: booking_service.UpdatePromotionResponse
+	(*DeletePromotionRequest)(nil),          // 116: booking_service.DeletePromotionRequest
+	(*DeletePromotionResponse)(nil),         // 117: booking_service.DeletePromotionResponse
+	(*QuoteStayRequest)(nil),                // 118: booking_service.QuoteStayRequest
+	(*QuoteStayResponse)(nil),               // 119: booking_service.QuoteStayResponse
+	(*SetExchangeRatesRequest)(nil),         // 120: booking_service.SetExchangeRatesRequest
+	(*SetExchangeRatesResponse)(nil),        // 121: booking_service.SetExchangeRatesResponse
+	(*ListExchangeRatesRequest)(nil),        // 122: booking_service.ListExchangeRatesRequest
+	(*ListExchangeRatesResponse)(nil),       // 123: booking_service.ListExchangeRatesResponse
+	(*GetReconciliationReportRequest)(nil),  // 124: booking_service.GetReconciliationReportRequest
+	(*GetReconciliationReportResponse)(nil), // 125: booking_service.GetReconciliationReportResponse
+	(*ReconciliationIssue)(nil),             // 126: booking_service.ReconciliationIssue
+	(*ListAuditEventsRequest)(nil),          // 127: booking_service.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),         // 128: booking_service.ListAuditEventsResponse
+	(*AuditEvent)(nil),                      // 129: booking_service.AuditEvent
+	(*Employee)(nil),                        // 130: booking_service.Employee
+	(*Room)(nil),                            // 131: booking_service.Room
+	(*Review)(nil),                          // 132: booking_service.Review
+	(*Hotel)(nil),                           // 133: booking_service.Hotel
+	(*CancellationPolicy)(nil),              // 134: booking_service.CancellationPolicy
+	(*CancellationTerms)(nil),               // 135: booking_service.CancellationTerms
+	(*Guest)(nil),                           // 136: booking_service.Guest
+	(*Address)(nil),                         // 137: booking_service.Address
+	(*IdentityDocument)(nil),                // 138: booking_service.IdentityDocument
+	(*Money)(nil),                           // 139: booking_service.Money
+	(*RatePlan)(nil),                        // 140: booking_service.RatePlan
+	(*PricingRule)(nil),                     // 141: booking_service.PricingRule
+	(*DepositRule)(nil),                     // 142: booking_service.DepositRule
+	(*OccupancyTier)(nil),                   // 143: booking_service.OccupancyTier
+	(*PriceAdjustment)(nil),                 // 144: booking_service.PriceAdjustment
+	(*TaxRule)(nil),                         // 145: booking_service.TaxRule
+	(*PromotionTerms)(nil),                  // 146: booking_service.PromotionTerms
+	(*Promotion)(nil),                       // 147: booking_service.Promotion
+	(*Discount)(nil),                        // 148: booking_service.Discount
+	(*ExchangeRate)(nil),                    // 149: booking_service.ExchangeRate
+	(*ConvertedPrice)(nil),                  // 150: booking_service.ConvertedPrice
+	(*PriceItem)(nil),                       // 151: booking_service.PriceItem
+	(*BookingPrice)(nil),                    // 152: booking_service.BookingPrice
+	(*NightPrice)(nil),                      // 153: booking_service.NightPrice
+	(*RateRange)(nil),                       // 154: booking_service.RateRange
+	(*CalendarNight)(nil),                   // 155: booking_service.CalendarNight
+	(*Folio)(nil),                           // 156: booking_service.Folio
+	(*FolioCharge)(nil),                     // 157: booking_service.FolioCharge
+	(*Settlement)(nil),                      // 158: booking_service.Settlement
+	(*InvoiceParty)(nil),                    // 159: booking_service.InvoiceParty
+	(*InvoiceLine)(nil),                     // 160: booking_service.InvoiceLine
+	(*Invoice)(nil),                         // 161: booking_service.Invoice
+	(*Booking)(nil),                         // 162: booking_service.Booking
+	(*CreateRoomRequest_DTO)(nil),           // 163: booking_service.CreateRoomRequest.DTO
+	(*CreateBookingRequestGuest)(nil),       // 164: booking_service.CreateBookingRequest.guest
+	(*timestamppb.Timestamp)(nil),           // 165: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                 // 166: google.protobuf.Struct
+	(*httpbody.HttpBody)(nil),               // 167: google.api.HttpBody
 }
 var file_booking_service_proto_depIdxs = []int32{
-	20, // 0: booking_service.CreateHotelResponse.hotel:type_name -> booking_service.Hotel
-	23, // 1: booking_service.CreateRoomRequest.dto:type_name -> booking_service.CreateRoomRequest.DTO
-	18, // 2: booking_service.CreateRoomResponse.room:type_name -> booking_service.Room
-	18, // 3: booking_service.UpdateRoomResponse.room:type_name -> booking_service.Room
-	25, // 4: booking_service.CreateBookingRequest.start_date:type_name -> google.protobuf.Timestamp
-	25, // 5: booking_service.CreateBookingRequest.end_date:type_name -> google.protobuf.Timestamp
-	24, // 6: booking_service.CreateBookingRequest.guests:type_name -> booking_service.CreateBookingRequest.guest
-	22, // 7: booking_service.CreateBookingResponse.booking:type_name -> booking_service.Booking
-	25, // 8: booking_service.ModifyBookingRequest.start_date:type_name -> google.protobuf.Timestamp
-	25, // 9: booking_service.ModifyBookingRequest.end_date:type_name -> google.protobuf.Timestamp
-	22, // 10: booking_service.ModifyBookingResponse.booking:type_name -> booking_service.Booking
-	21, // 11: booking_service.CreateGuestResponse.guest:type_name -> booking_service.Guest
-	19, // 12: booking_service.SubmitReviewResponse.review:type_name -> booking_service.Review
-	25, // 13: booking_service.Room.created_at:type_name -> google.protobuf.Timestamp
-	25, // 14: booking_service.Room.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 15: booking_service.Room.type:type_name -> booking_service.RoomType
-	25, // 16: booking_service.Review.created_at:type_name -> google.protobuf.Timestamp
-	25, // 17: booking_service.Review.updated_at:type_name -> google.protobuf.Timestamp
-	25, // 18: booking_service.Hotel.created_at:type_name -> google.protobuf.Timestamp
-	25, // 19: booking_service.Hotel.updated_at:type_name -> google.protobuf.Timestamp
-	25, // 20: booking_service.Guest.created_at:type_name -> google.protobuf.Timestamp
-	25, // 21: booking_service.Guest.updated_at:type_name -> google.protobuf.Timestamp
-	25, // 22: booking_service.Booking.created_at:type_name -> google.protobuf.Timestamp
-	25, // 23: booking_service.Booking.updated_at:type_name -> google.protobuf.Timestamp
-	25, // 24: booking_service.Booking.start_date:type_name -> google.protobuf.Timestamp
-	25, // 25: booking_service.Booking.end_date:type_name -> google.protobuf.Timestamp
-	0,  // 26: booking_service.Booking.status:type_name -> booking_service.BookingStatus
-	21, // 27: booking_service.Booking.guests:type_name -> booking_service.Guest
-	2,  // 28: booking_service.BookingService.CreateHotel:input_type -> booking_service.CreateHotelRequest
-	4,  // 29: booking_service.BookingService.CreateRoom:input_type -> booking_service.CreateRoomRequest
-	6,  // 30: booking_service.BookingService.UpdateRoom:input_type -> booking_service.UpdateRoomRequest
-	8,  // 31: booking_service.BookingService.CreateBooking:input_type -> booking_service.CreateBookingRequest
-	10, // 32: booking_service.BookingService.CancelBooking:input_type -> booking_service.CancelBookingRequest
-	12, // 33: booking_service.BookingService.ModifyBooking:input_type -> booking_service.ModifyBookingRequest
-	14, // 34: booking_service.BookingService.CreateGuest:input_type -> booking_service.CreateGuestRequest
-	16, // 35: booking_service.BookingService.SubmitReview:input_type -> booking_service.SubmitReviewRequest
-	3,  // 36: booking_service.BookingService.CreateHotel:output_type -> booking_service.CreateHotelResponse
-	5,  // 37: booking_service.BookingService.CreateRoom:output_type -> booking_service.CreateRoomResponse
-	7,  // 38: booking_service.BookingService.UpdateRoom:output_type -> booking_service.UpdateRoomResponse
-	9,  // 39: booking_service.BookingService.CreateBooking:output_type -> booking_service.CreateBookingResponse
-	11, // 40: booking_service.BookingService.CancelBooking:output_type -> booking_service.CancelBookingResponse
-	13, // 41: booking_service.BookingService.ModifyBooking:output_type -> booking_service.ModifyBookingResponse
-	15, // 42: booking_service.BookingService.CreateGuest:output_type -> booking_service.CreateGuestResponse
-	17, // 43: booking_service.BookingService.SubmitReview:output_type -> booking_service.SubmitReviewResponse
-	36, // [36:44] is the sub-list for method output_type
-	28, // [28:36] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	134, // 0: booking_service.CreateHotelRequest.cancellation_policy:type_name -> booking_service.CancellationPolicy
+	133, // 1: booking_service.CreateHotelResponse.hotel:type_name -> booking_service.Hotel
+	163, // 2: booking_service.CreateRoomRequest.dto:type_name -> booking_service.CreateRoomRequest.DTO
+	131, // 3: booking_service.CreateRoomResponse.room:type_name -> booking_service.Room
+	131, // 4: booking_service.UpdateRoomResponse.room:type_name -> booking_service.Room
+	165, // 5: booking_service.CreateBookingRequest.start_date:type_name -> google.protobuf.Timestamp
+	165, // 6: booking_service.CreateBookingRequest.end_date:type_name -> google.protobuf.Timestamp
+	164, // 7: booking_service.CreateBookingRequest.guests:type_name -> booking_service.CreateBookingRequest.guest
+	162, // 8: booking_service.CreateBookingResponse.booking:type_name -> booking_service.Booking
+	165, // 9: booking_service.ModifyBookingRequest.start_date:type_name -> google.protobuf.Timestamp
+	165, // 10: booking_service.ModifyBookingRequest.end_date:type_name -> google.protobuf.Timestamp
+	162, // 11: booking_service.ModifyBookingResponse.booking:type_name -> booking_service.Booking
+	162, // 12: booking_service.ListMyBookingsResponse.bookings:type_name -> booking_service.Booking
+	138, // 13: booking_service.CreateGuestRequest.document:type_name -> booking_service.IdentityDocument
+	136, // 14: booking_service.CreateGuestResponse.guest:type_name -> booking_service.Guest
+	136, // 15: booking_service.GetGuestResponse.guest:type_name -> booking_service.Guest
+	165, // 16: booking_service.UpdateGuestRequest.date_of_birth:type_name -> google.protobuf.Timestamp
+	137, // 17: booking_service.UpdateGuestRequest.address:type_name -> booking_service.Address
+	138, // 18: booking_service.UpdateGuestRequest.document:type_name -> booking_service.IdentityDocument
+	136, // 19: booking_service.UpdateGuestResponse.guest:type_name -> booking_service.Guest
+	136, // 20: booking_service.SearchGuestsResponse.guests:type_name -> booking_service.Guest
+	136, // 21: booking_service.MergeGuestsResponse.guest:type_name -> booking_service.Guest
+	165, // 22: booking_service.GuestDataExport.exported_at:type_name -> google.protobuf.Timestamp
+	136, // 23: booking_service.GuestDataExport.guest:type_name -> booking_service.Guest
+	162, // 24: booking_service.GuestDataExport.bookings:type_name -> booking_service.Booking
+	132, // 25: booking_service.GuestDataExport.reviews:type_name -> booking_service.Review
+	136, // 26: booking_service.EraseGuestResponse.guest:type_name -> booking_service.Guest
+	48,  // 27: booking_service.ListDuplicateGuestsResponse.duplicates:type_name -> booking_service.DuplicateGuests
+	136, // 28: booking_service.DuplicateGuests.guest:type_name -> booking_service.Guest
+	136, // 29: booking_service.DuplicateGuests.duplicate:type_name -> booking_service.Guest
+	15,  // 30: booking_service.DuplicateGuests.matched_by:type_name -> booking_service.GuestMatchKey
+	132, // 31: booking_service.SubmitReviewResponse.review:type_name -> booking_service.Review
+	2,   // 32: booking_service.UpdateRoomStatusRequest.status:type_name -> booking_service.RoomStatus
+	131, // 33: booking_service.UpdateRoomStatusResponse.room:type_name -> booking_service.Room
+	3,   // 34: booking_service.CreateEmployeeRequest.role:type_name -> booking_service.EmployeeRole
+	130, // 35: booking_service.CreateEmployeeResponse.employee:type_name -> booking_service.Employee
+	130, // 36: booking_service.GetEmployeeResponse.employee:type_name -> booking_service.Employee
+	130, // 37: booking_service.ListEmployeesResponse.employees:type_name -> booking_service.Employee
+	3,   // 38: booking_service.UpdateEmployeeRequest.role:type_name -> booking_service.EmployeeRole
+	130, // 39: booking_service.UpdateEmployeeResponse.employee:type_name -> booking_service.Employee
+	1,   // 40: booking_service.CreateRatePlanRequest.room_type:type_name -> booking_service.RoomType
+	139, // 41: booking_service.CreateRatePlanRequest.nightly_price:type_name -> booking_service.Money
+	165, // 42: booking_service.CreateRatePlanRequest.valid_from:type_name -> google.protobuf.Timestamp
+	165, // 43: booking_service.CreateRatePlanRequest.valid_to:type_name -> google.protobuf.Timestamp
+	140, // 44: booking_service.CreateRatePlanResponse.rate_plan:type_name -> booking_service.RatePlan
+	140, // 45: booking_service.ListRatePlansResponse.rate_plans:type_name -> booking_service.RatePlan
+	1,   // 46: booking_service.UpdateRatePlanRequest.room_type:type_name -> booking_service.RoomType
+	139, // 47: booking_service.UpdateRatePlanRequest.nightly_price:type_name -> booking_service.Money
+	165, // 48: booking_service.UpdateRatePlanRequest.valid_from:type_name -> google.protobuf.Timestamp
+	165, // 49: booking_service.UpdateRatePlanRequest.valid_to:type_name -> google.protobuf.Timestamp
+	140, // 50: booking_service.UpdateRatePlanResponse.rate_plan:type_name -> booking_service.RatePlan
+	154, // 51: booking_service.SetRatesRequest.ranges:type_name -> booking_service.RateRange
+	1,   // 52: booking_service.GetRateCalendarRequest.room_type:type_name -> booking_service.RoomType
+	165, // 53: booking_service.GetRateCalendarRequest.start_date:type_name -> google.protobuf.Timestamp
+	165, // 54: booking_service.GetRateCalendarRequest.end_date:type_name -> google.protobuf.Timestamp
+	155, // 55: booking_service.GetRateCalendarResponse.nights:type_name -> booking_service.CalendarNight
+	1,   // 56: booking_service.SetPricingRuleRequest.room_type:type_name -> booking_service.RoomType
+	13,  // 57: booking_service.SetPricingRuleRequest.strategy:type_name -> booking_service.PricingStrategy
+	143, // 58: booking_service.SetPricingRuleRequest.occupancy_tiers:type_name -> booking_service.OccupancyTier
+	139, // 59: booking_service.SetPricingRuleRequest.min_price:type_name -> booking_service.Money
+	139, // 60: booking_service.SetPricingRuleRequest.max_price:type_name -> booking_service.Money
+	141, // 61: booking_service.SetPricingRuleResponse.rule:type_name -> booking_service.PricingRule
+	141, // 62: booking_service.ListPricingRulesResponse.rules:type_name -> booking_service.PricingRule
+	1,   // 63: booking_service.DeletePricingRuleRequest.room_type:type_name -> booking_service.RoomType
+	16,  // 64: booking_service.SetDepositRuleRequest.kind:type_name -> booking_service.DepositKind
+	142, // 65: booking_service.SetDepositRuleResponse.rule:type_name -> booking_service.DepositRule
+	142, // 66: booking_service.GetDepositRuleResponse.rule:type_name -> booking_service.DepositRule
+	12,  // 67: booking_service.CreateTaxRuleRequest.kind:type_name -> booking_service.TaxKind
+	139, // 68: booking_service.CreateTaxRuleRequest.amount:type_name -> booking_service.Money
+	165, // 69: booking_service.CreateTaxRuleRequest.valid_from:type_name -> google.protobuf.Timestamp
+	165, // 70: booking_service.CreateTaxRuleRequest.valid_to:type_name -> google.protobuf.Timestamp
+	145, // 71: booking_service.CreateTaxRuleResponse.tax_rule:type_name -> booking_service.TaxRule
+	145, // 72: booking_service.ListTaxRulesResponse.tax_rules:type_name -> booking_service.TaxRule
+	162, // 73: booking_service.PayBookingResponse.booking:type_name -> booking_service.Booking
+	156, // 74: booking_service.GetFolioResponse.folio:type_name -> booking_service.Folio
+	9,   // 75: booking_service.PostFolioChargeRequest.category:type_name -> booking_service.ChargeCategory
+	139, // 76: booking_service.PostFolioChargeRequest.unit_price:type_name -> booking_service.Money
+	157, // 77: booking_service.PostFolioChargeResponse.charge:type_name -> booking_service.FolioCharge
+	156, // 78: booking_service.PostFolioChargeResponse.folio:type_name -> booking_service.Folio
+	156, // 79: booking_service.VoidFolioChargeResponse.folio:type_name -> booking_service.Folio
+	139, // 80: booking_service.SettleFolioRequest.amount:type_name -> booking_service.Money
+	156, // 81: booking_service.SettleFolioResponse.folio:type_name -> booking_service.Folio
+	156, // 82: booking_service.CloseFolioResponse.folio:type_name -> booking_service.Folio
+	159, // 83: booking_service.IssueInvoiceRequest.buyer:type_name -> booking_service.InvoiceParty
+	161, // 84: booking_service.IssueInvoiceResponse.invoice:type_name -> booking_service.Invoice
+	161, // 85: booking_service.GetInvoiceResponse.invoice:type_name -> booking_service.Invoice
+	7,   // 86: booking_service.DownloadInvoiceRequest.format:type_name -> booking_service.InvoiceFormat
+	146, // 87: booking_service.CreatePromotionRequest.terms:type_name -> booking_service.PromotionTerms
+	147, // 88: booking_service.CreatePromotionResponse.promotion:type_name -> booking_service.Promotion
+	147, // 89: booking_service.ListPromotionsResponse.promotions:type_name -> booking_service.Promotion
+	146, // 90: booking_service.UpdatePromotionRequest.terms:type_name -> booking_service.PromotionTerms
+	147, // 91: booking_service.UpdatePromotionResponse.promotion:type_name -> booking_service.Promotion
+	1,   // 92: booking_service.QuoteStayRequest.room_type:type_name -> booking_service.RoomType
+	165, // 93: booking_service.QuoteStayRequest.start_date:type_name -> google.protobuf.Timestamp
+	165, // 94: booking_service.QuoteStayRequest.end_date:type_name -> google.protobuf.Timestamp
+	152, // 95: booking_service.QuoteStayResponse.price:type_name -> booking_service.BookingPrice
+	135, // 96: booking_service.QuoteStayResponse.cancellation:type_name -> booking_service.CancellationTerms
+	165, // 97: booking_service.QuoteStayResponse.expires_at:type_name -> google.protobuf.Timestamp
+	150, // 98: booking_service.QuoteStayResponse.display_price:type_name -> booking_service.ConvertedPrice
+	149, // 99: booking_service.SetExchangeRatesRequest.rates:type_name -> booking_service.ExchangeRate
+	149, // 100: booking_service.SetExchangeRatesResponse.rates:type_name -> booking_service.ExchangeRate
+	149, // 101: booking_service.ListExchangeRatesResponse.rates:type_name -> booking_service.ExchangeRate
+	126, // 102: booking_service.GetReconciliationReportResponse.issues:type_name -> booking_service.ReconciliationIssue
+	6,   // 103: booking_service.ReconciliationIssue.kind:type_name -> booking_service.ReconciliationIssueKind
+	165, // 104: booking_service.ReconciliationIssue.detected_at:type_name -> google.protobuf.Timestamp
+	165, // 105: booking_service.ReconciliationIssue.resolved_at:type_name -> google.protobuf.Timestamp
+	4,   // 106: booking_service.ListAuditEventsRequest.entity_type:type_name -> booking_service.AuditEntityType
+	165, // 107: booking_service.ListAuditEventsRequest.from:type_name -> google.protobuf.Timestamp
+	165, // 108: booking_service.ListAuditEventsRequest.to:type_name -> google.protobuf.Timestamp
+	129, // 109: booking_service.ListAuditEventsResponse.events:type_name -> booking_service.AuditEvent
+	165, // 110: booking_service.AuditEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	4,   // 111: booking_service.AuditEvent.entity_type:type_name -> booking_service.AuditEntityType
+	166, // 112: booking_service.AuditEvent.diff:type_name -> google.protobuf.Struct
+	165, // 113: booking_service.Employee.created_at:type_name -> google.protobuf.Timestamp
+	165, // 114: booking_service.Employee.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 115: booking_service.Employee.role:type_name -> booking_service.EmployeeRole
+	165, // 116: booking_service.Room.created_at:type_name -> google.protobuf.Timestamp
+	165, // 117: booking_service.Room.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 118: booking_service.Room.type:type_name -> booking_service.RoomType
+	2,   // 119: booking_service.Room.status:type_name -> booking_service.RoomStatus
+	165, // 120: booking_service.Review.created_at:type_name -> google.protobuf.Timestamp
+	165, // 121: booking_service.Review.updated_at:type_name -> google.protobuf.Timestamp
+	165, // 122: booking_service.Hotel.created_at:type_name -> google.protobuf.Timestamp
+	165, // 123: booking_service.Hotel.updated_at:type_name -> google.protobuf.Timestamp
+	134, // 124: booking_service.Hotel.cancellation_policy:type_name -> booking_service.CancellationPolicy
+	165, // 125: booking_service.CancellationTerms.free_until:type_name -> google.protobuf.Timestamp
+	139, // 126: booking_service.CancellationTerms.penalty:type_name -> booking_service.Money
+	165, // 127: booking_service.Guest.created_at:type_name -> google.protobuf.Timestamp
+	165, // 128: booking_service.Guest.updated_at:type_name -> google.protobuf.Timestamp
+	165, // 129: booking_service.Guest.date_of_birth:type_name -> google.protobuf.Timestamp
+	137, // 130: booking_service.Guest.address:type_name -> booking_service.Address
+	138, // 131: booking_service.Guest.document:type_name -> booking_service.IdentityDocument
+	165, // 132: booking_service.Guest.erased_at:type_name -> google.protobuf.Timestamp
+	14,  // 133: booking_service.IdentityDocument.type:type_name -> booking_service.DocumentType
+	165, // 134: booking_service.IdentityDocument.expires_at:type_name -> google.protobuf.Timestamp
+	165, // 135: booking_service.RatePlan.created_at:type_name -> google.protobuf.Timestamp
+	165, // 136: booking_service.RatePlan.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 137: booking_service.RatePlan.room_type:type_name -> booking_service.RoomType
+	139, // 138: booking_service.RatePlan.nightly_price:type_name -> booking_service.Money
+	165, // 139: booking_service.RatePlan.valid_from:type_name -> google.protobuf.Timestamp
+	165, // 140: booking_service.RatePlan.valid_to:type_name -> google.protobuf.Timestamp
+	1,   // 141: booking_service.PricingRule.room_type:type_name -> booking_service.RoomType
+	13,  // 142: booking_service.PricingRule.strategy:type_name -> booking_service.PricingStrategy
+	143, // 143: booking_service.PricingRule.occupancy_tiers:type_name -> booking_service.OccupancyTier
+	139, // 144: booking_service.PricingRule.min_price:type_name -> booking_service.Money
+	139, // 145: booking_service.PricingRule.max_price:type_name -> booking_service.Money
+	165, // 146: booking_service.PricingRule.updated_at:type_name -> google.protobuf.Timestamp
+	16,  // 147: booking_service.DepositRule.kind:type_name -> booking_service.DepositKind
+	165, // 148: booking_service.DepositRule.updated_at:type_name -> google.protobuf.Timestamp
+	139, // 149: booking_service.PriceAdjustment.amount:type_name -> booking_service.Money
+	165, // 150: booking_service.TaxRule.created_at:type_name -> google.protobuf.Timestamp
+	165, // 151: booking_service.TaxRule.updated_at:type_name -> google.protobuf.Timestamp
+	12,  // 152: booking_service.TaxRule.kind:type_name -> booking_service.TaxKind
+	139, // 153: booking_service.TaxRule.amount:type_name -> booking_service.Money
+	165, // 154: booking_service.TaxRule.valid_from:type_name -> google.protobuf.Timestamp
+	165, // 155: booking_service.TaxRule.valid_to:type_name -> google.protobuf.Timestamp
+	1,   // 156: booking_service.PromotionTerms.room_types:type_name -> booking_service.RoomType
+	11,  // 157: booking_service.PromotionTerms.discount_type:type_name -> booking_service.DiscountType
+	139, // 158: booking_service.PromotionTerms.amount_off:type_name -> booking_service.Money
+	165, // 159: booking_service.PromotionTerms.stay_from:type_name -> google.protobuf.Timestamp
+	165, // 160: booking_service.PromotionTerms.stay_to:type_name -> google.protobuf.Timestamp
+	165, // 161: booking_service.PromotionTerms.book_from:type_name -> google.protobuf.Timestamp
+	165, // 162: booking_service.PromotionTerms.book_to:type_name -> google.protobuf.Timestamp
+	165, // 163: booking_service.Promotion.created_at:type_name -> google.protobuf.Timestamp
+	165, // 164: booking_service.Promotion.updated_at:type_name -> google.protobuf.Timestamp
+	146, // 165: booking_service.Promotion.terms:type_name -> booking_service.PromotionTerms
+	139, // 166: booking_service.Discount.amount:type_name -> booking_service.Money
+	165, // 167: booking_service.ExchangeRate.updated_at:type_name -> google.protobuf.Timestamp
+	139, // 168: booking_service.ConvertedPrice.total:type_name -> booking_service.Money
+	139, // 169: booking_service.ConvertedPrice.subtotal:type_name -> booking_service.Money
+	139, // 170: booking_service.ConvertedPrice.taxes:type_name -> booking_service.Money
+	139, // 171: booking_service.ConvertedPrice.fees:type_name -> booking_service.Money
+	149, // 172: booking_service.ConvertedPrice.rate:type_name -> booking_service.ExchangeRate
+	12,  // 173: booking_service.PriceItem.kind:type_name -> booking_service.TaxKind
+	139, // 174: booking_service.PriceItem.amount:type_name -> booking_service.Money
+	139, // 175: booking_service.BookingPrice.total:type_name -> booking_service.Money
+	153, // 176: booking_service.BookingPrice.nights:type_name -> booking_service.NightPrice
+	139, // 177: booking_service.BookingPrice.subtotal:type_name -> booking_service.Money
+	139, // 178: booking_service.BookingPrice.taxes:type_name -> booking_service.Money
+	139, // 179: booking_service.BookingPrice.fees:type_name -> booking_service.Money
+	151, // 180: booking_service.BookingPrice.items:type_name -> booking_service.PriceItem
+	148, // 181: booking_service.BookingPrice.discount:type_name -> booking_service.Discount
+	165, // 182: booking_service.NightPrice.date:type_name -> google.protobuf.Timestamp
+	139, // 183: booking_service.NightPrice.price:type_name -> booking_service.Money
+	139, // 184: booking_service.NightPrice.base_price:type_name -> booking_service.Money
+	144, // 185: booking_service.NightPrice.adjustments:type_name -> booking_service.PriceAdjustment
+	1,   // 186: booking_service.RateRange.room_type:type_name -> booking_service.RoomType
+	165, // 187: booking_service.RateRange.start_date:type_name -> google.protobuf.Timestamp
+	165, // 188: booking_service.RateRange.end_date:type_name -> google.protobuf.Timestamp
+	17,  // 189: booking_service.RateRange.weekdays:type_name -> booking_service.Weekday
+	139, // 190: booking_service.RateRange.price:type_name -> booking_service.Money
+	165, // 191: booking_service.CalendarNight.date:type_name -> google.protobuf.Timestamp
+	139, // 192: booking_service.CalendarNight.price:type_name -> booking_service.Money
+	139, // 193: booking_service.CalendarNight.base_price:type_name -> booking_service.Money
+	144, // 194: booking_service.CalendarNight.adjustments:type_name -> booking_service.PriceAdjustment
+	165, // 195: booking_service.Folio.created_at:type_name -> google.protobuf.Timestamp
+	8,   // 196: booking_service.Folio.status:type_name -> booking_service.FolioStatus
+	165, // 197: booking_service.Folio.closed_at:type_name -> google.protobuf.Timestamp
+	157, // 198: booking_service.Folio.charges:type_name -> booking_service.FolioCharge
+	158, // 199: booking_service.Folio.settlements:type_name -> booking_service.Settlement
+	139, // 200: booking_service.Folio.charged:type_name -> booking_service.Money
+	139, // 201: booking_service.Folio.paid:type_name -> booking_service.Money
+	139, // 202: booking_service.Folio.balance:type_name -> booking_service.Money
+	165, // 203: booking_service.FolioCharge.created_at:type_name -> google.protobuf.Timestamp
+	9,   // 204: booking_service.FolioCharge.category:type_name -> booking_service.ChargeCategory
+	139, // 205: booking_service.FolioCharge.unit_price:type_name -> booking_service.Money
+	139, // 206: booking_service.FolioCharge.net:type_name -> booking_service.Money
+	139, // 207: booking_service.FolioCharge.tax:type_name -> booking_service.Money
+	139, // 208: booking_service.FolioCharge.total:type_name -> booking_service.Money
+	165, // 209: booking_service.FolioCharge.voided_at:type_name -> google.protobuf.Timestamp
+	139, // 210: booking_service.Settlement.amount:type_name -> booking_service.Money
+	10,  // 211: booking_service.Settlement.status:type_name -> booking_service.SettlementStatus
+	165, // 212: booking_service.Settlement.payment_date:type_name -> google.protobuf.Timestamp
+	139, // 213: booking_service.InvoiceLine.unit_price:type_name -> booking_service.Money
+	139, // 214: booking_service.InvoiceLine.net:type_name -> booking_service.Money
+	139, // 215: booking_service.InvoiceLine.tax:type_name -> booking_service.Money
+	139, // 216: booking_service.InvoiceLine.total:type_name -> booking_service.Money
+	165, // 217: booking_service.Invoice.issued_at:type_name -> google.protobuf.Timestamp
+	159, // 218: booking_service.Invoice.seller:type_name -> booking_service.InvoiceParty
+	159, // 219: booking_service.Invoice.buyer:type_name -> booking_service.InvoiceParty
+	165, // 220: booking_service.Invoice.stay_start:type_name -> google.protobuf.Timestamp
+	165, // 221: booking_service.Invoice.stay_end:type_name -> google.protobuf.Timestamp
+	160, // 222: booking_service.Invoice.lines:type_name -> booking_service.InvoiceLine
+	139, // 223: booking_service.Invoice.net:type_name -> booking_service.Money
+	139, // 224: booking_service.Invoice.tax:type_name -> booking_service.Money
+	139, // 225: booking_service.Invoice.total:type_name -> booking_service.Money
+	139, // 226: booking_service.Invoice.paid:type_name -> booking_service.Money
+	139, // 227: booking_service.Invoice.due:type_name -> booking_service.Money
+	165, // 228: booking_service.Booking.created_at:type_name -> google.protobuf.Timestamp
+	165, // 229: booking_service.Booking.updated_at:type_name -> google.protobuf.Timestamp
+	165, // 230: booking_service.Booking.start_date:type_name -> google.protobuf.Timestamp
+	165, // 231: booking_service.Booking.end_date:type_name -> google.protobuf.Timestamp
+	0,   // 232: booking_service.Booking.status:type_name -> booking_service.BookingStatus
+	136, // 233: booking_service.Booking.guests:type_name -> booking_service.Guest
+	152, // 234: booking_service.Booking.price:type_name -> booking_service.BookingPrice
+	135, // 235: booking_service.Booking.cancellation:type_name -> booking_service.CancellationTerms
+	5,   // 236: booking_service.Booking.payment_status:type_name -> booking_service.BookingPaymentStatus
+	139, // 237: booking_service.Booking.amount_paid:type_name -> booking_service.Money
+	165, // 238: booking_service.Booking.balance_due_at:type_name -> google.protobuf.Timestamp
+	138, // 239: booking_service.CreateBookingRequest.guest.document:type_name -> booking_service.IdentityDocument
+	18,  // 240: booking_service.BookingService.CreateHotel:input_type -> booking_service.CreateHotelRequest
+	20,  // 241: booking_service.BookingService.CreateRoom:input_type -> booking_service.CreateRoomRequest
+	22,  // 242: booking_service.BookingService.UpdateRoom:input_type -> booking_service.UpdateRoomRequest
+	24,  // 243: booking_service.BookingService.CreateBooking:input_type -> booking_service.CreateBookingRequest
+	26,  // 244: booking_service.BookingService.CancelBooking:input_type -> booking_service.CancelBookingRequest
+	28,  // 245: booking_service.BookingService.ModifyBooking:input_type -> booking_service.ModifyBookingRequest
+	30,  // 246: booking_service.BookingService.ListMyBookings:input_type -> booking_service.ListMyBookingsRequest
+	32,  // 247: booking_service.BookingService.CreateGuest:input_type -> booking_service.CreateGuestRequest
+	34,  // 248: booking_service.BookingService.GetGuest:input_type -> booking_service.GetGuestRequest
+	36,  // 249: booking_service.BookingService.UpdateGuest:input_type -> booking_service.UpdateGuestRequest
+	38,  // 250: booking_service.BookingService.SearchGuests:input_type -> booking_service.SearchGuestsRequest
+	40,  // 251: booking_service.BookingService.MergeGuests:input_type -> booking_service.MergeGuestsRequest
+	42,  // 252: booking_service.BookingService.ExportGuestData:input_type -> booking_service.ExportGuestDataRequest
+	44,  // 253: booking_service.BookingService.EraseGuest:input_type -> booking_service.EraseGuestRequest
+	46,  // 254: booking_service.BookingService.ListDuplicateGuests:input_type -> booking_service.ListDuplicateGuestsRequest
+	49,  // 255: booking_service.BookingService.SubmitReview:input_type -> booking_service.SubmitReviewRequest
+	51,  // 256: booking_service.BookingService.UpdateRoomStatus:input_type -> booking_service.UpdateRoomStatusRequest
+	53,  // 257: booking_service.BookingService.CreateEmployee:input_type -> booking_service.CreateEmployeeRequest
+	55,  // 258: booking_service.BookingService.GetEmployee:input_type -> booking_service.GetEmployeeRequest
+	57,  // 259: booking_service.BookingService.ListEmployees:input_type -> booking_service.ListEmployeesRequest
+	59,  // 260: booking_service.BookingService.UpdateEmployee:input_type -> booking_service.UpdateEmployeeRequest
+	61,  // 261: booking_service.BookingService.DeleteEmployee:input_type -> booking_service.DeleteEmployeeRequest
+	63,  // 262: booking_service.BookingService.CreateRatePlan:input_type -> booking_service.CreateRatePlanRequest
+	65,  // 263: booking_service.BookingService.ListRatePlans:input_type -> booking_service.ListRatePlansRequest
+	67,  // 264: booking_service.BookingService.UpdateRatePlan:input_type -> booking_service.UpdateRatePlanRequest
+	69,  // 265: booking_service.BookingService.DeleteRatePlan:input_type -> booking_service.DeleteRatePlanRequest
+	71,  // 266: booking_service.BookingService.SetRates:input_type -> booking_service.SetRatesRequest
+	73,  // 267: booking_service.BookingService.GetRateCalendar:input_type -> booking_service.GetRateCalendarRequest
+	75,  // 268: booking_service.BookingService.SetPricingRule:input_type -> booking_service.SetPricingRuleRequest
+	77,  // 269: booking_service.BookingService.ListPricingRules:input_type -> booking_service.ListPricingRulesRequest
+	79,  // 270: booking_service.BookingService.DeletePricingRule:input_type -> booking_service.DeletePricingRuleRequest
+	81,  // 271: booking_service.BookingService.SetDepositRule:input_type -> booking_service.SetDepositRuleRequest
+	83,  // 272: booking_service.BookingService.GetDepositRule:input_type -> booking_service.GetDepositRuleRequest
+	85,  // 273: booking_service.BookingService.DeleteDepositRule:input_type -> booking_service.DeleteDepositRuleRequest
+	87,  // 274: booking_service.BookingService.CreateTaxRule:input_type -> booking_service.CreateTaxRuleRequest
+	89,  // 275: booking_service.BookingService.ListTaxRules:input_type -> booking_service.ListTaxRulesRequest
+	91,  // 276: booking_service.BookingService.DeleteTaxRule:input_type -> booking_service.DeleteTaxRuleRequest
+	93,  // 277: booking_service.BookingService.PayBooking:input_type -> booking_service.PayBookingRequest
+	95,  // 278: booking_service.BookingService.GetFolio:input_type -> booking_service.GetFolioRequest
+	97,  // 279: booking_service.BookingService.PostFolioCharge:input_type -> booking_service.PostFolioChargeRequest
+	99,  // 280: booking_service.BookingService.VoidFolioCharge:input_type -> booking_service.VoidFolioChargeRequest
+	101, // 281: booking_service.BookingService.SettleFolio:input_type -> booking_service.SettleFolioRequest
+	103, // 282: booking_service.BookingService.CloseFolio:input_type -> booking_service.CloseFolioRequest
+	105, // 283: booking_service.BookingService.IssueInvoice:input_type -> booking_service.IssueInvoiceRequest
+	107, // 284: booking_service.BookingService.GetInvoice:input_type -> booking_service.GetInvoiceRequest
+	109, // 285: booking_service.BookingService.DownloadInvoice:input_type -> booking_service.DownloadInvoiceRequest
+	110, // 286: booking_service.BookingService.CreatePromotion:input_type -> booking_service.CreatePromotionRequest
+	112, // 287: booking_service.BookingService.ListPromotions:input_type -> booking_service.ListPromotionsRequest
+	114, // 288: booking_service.BookingService.UpdatePromotion:input_type -> booking_service.UpdatePromotionRequest
+	116, // 289: booking_service.BookingService.DeletePromotion:input_type -> booking_service.DeletePromotionRequest
+	118, // 290: booking_service.BookingService.QuoteStay:input_type -> booking_service.QuoteStayRequest
+	120, // 291: booking_service.BookingService.SetExchangeRates:input_type -> booking_service.SetExchangeRatesRequest
+	122, // 292: booking_service.BookingService.ListExchangeRates:input_type -> booking_service.ListExchangeRatesRequest
+	124, // 293: booking_service.BookingService.GetReconciliationReport:input_type -> booking_service.GetReconciliationReportRequest
+	127, // 294: booking_service.BookingService.ListAuditEvents:input_type -> booking_service.ListAuditEventsRequest
+	19,  // 295: booking_service.BookingService.CreateHotel:output_type -> booking_service.CreateHotelResponse
+	21,  // 296: booking_service.BookingService.CreateRoom:output_type -> booking_service.CreateRoomResponse
+	23,  // 297: booking_service.BookingService.UpdateRoom:output_type -> booking_service.UpdateRoomResponse
+	25,  // 298: booking_service.BookingService.CreateBooking:output_type -> booking_service.CreateBookingResponse
+	27,  // 299: booking_service.BookingService.CancelBooking:output_type -> booking_service.CancelBookingResponse
+	29,  // 300: booking_service.BookingService.ModifyBooking:output_type -> booking_service.ModifyBookingResponse
+	31,  // 301: booking_service.BookingService.ListMyBookings:output_type -> booking_service.ListMyBookingsResponse
+	33,  // 302: booking_service.BookingService.CreateGuest:output_type -> booking_service.CreateGuestResponse
+	35,  // 303: booking_service.BookingService.GetGuest:output_type -> booking_service.GetGuestResponse
+	37,  // 304: booking_service.BookingService.UpdateGuest:output_type -> booking_service.UpdateGuestResponse
+	39,  // 305: booking_service.BookingService.SearchGuests:output_type -> booking_service.SearchGuestsResponse
+	41,  // 306: booking_service.BookingService.MergeGuests:output_type -> booking_service.MergeGuestsResponse
+	167, // 307: booking_service.BookingService.ExportGuestData:output_type -> google.api.HttpBody
+	45,  // 308: booking_service.BookingService.EraseGuest:output_type -> booking_service.EraseGuestResponse
+	47,  // 309: booking_service.BookingService.ListDuplicateGuests:output_type -> booking_service.ListDuplicateGuestsResponse
+	50,  // 310: booking_service.BookingService.SubmitReview:output_type -> booking_service.SubmitReviewResponse
+	52,  // 311: booking_service.BookingService.UpdateRoomStatus:output_type -> booking_service.UpdateRoomStatusResponse
+	54,  // 312: booking_service.BookingService.CreateEmployee:output_type -> booking_service.CreateEmployeeResponse
+	56,  // 313: booking_service.BookingService.GetEmployee:output_type -> booking_service.GetEmployeeResponse
+	58,  // 314: booking_service.BookingService.ListEmployees:output_type -> booking_service.ListEmployeesResponse
+	60,  // 315: booking_service.BookingService.UpdateEmployee:output_type -> booking_service.UpdateEmployeeResponse
+	62,  // 316: booking_service.BookingService.DeleteEmployee:output_type -> booking_service.DeleteEmployeeResponse
+	64,  // 317: booking_service.BookingService.CreateRatePlan:output_type -> booking_service.CreateRatePlanResponse
+	66,  // 318: booking_service.BookingService.ListRatePlans:output_type -> booking_service.ListRatePlansResponse
+	68,  // 319: booking_service.BookingService.UpdateRatePlan:output_type -> booking_service.UpdateRatePlanResponse
+	70,  // 320: booking_service.BookingService.DeleteRatePlan:output_type -> booking_service.DeleteRatePlanResponse
+	72,  // 321: booking_service.BookingService.SetRates:output_type -> booking_service.SetRatesResponse
+	74,  // 322: booking_service.BookingService.GetRateCalendar:output_type -> booking_service.GetRateCalendarResponse
+	76,  // 323: booking_service.BookingService.SetPricingRule:output_type -> booking_service.SetPricingRuleResponse
+	78,  // 324: booking_service.BookingService.ListPricingRules:output_type -> booking_service.ListPricingRulesResponse
+	80,  // 325: booking_service.BookingService.DeletePricingRule:output_type -> booking_service.DeletePricingRuleResponse
+	82,  // 326: booking_service.BookingService.SetDepositRule:output_type -> booking_service.SetDepositRuleResponse
+	84,  // 327: booking_service.BookingService.GetDepositRule:output_type -> booking_service.GetDepositRuleResponse
+	86,  // 328: booking_service.BookingService.DeleteDepositRule:output_type -> booking_service.DeleteDepositRuleResponse
+	88,  // 329: booking_service.BookingService.CreateTaxRule:output_type -> booking_service.CreateTaxRuleResponse
+	90,  // 330: booking_service.BookingService.ListTaxRules:output_type -> booking_service.ListTaxRulesResponse
+	92,  // 331: booking_service.BookingService.DeleteTaxRule:output_type -> booking_service.DeleteTaxRuleResponse
+	94,  // 332: booking_service.BookingService.PayBooking:output_type -> booking_service.PayBookingResponse
+	96,  // 333: booking_service.BookingService.GetFolio:output_type -> booking_service.GetFolioResponse
+	98,  // 334: booking_service.BookingService.PostFolioCharge:output_type -> booking_service.PostFolioChargeResponse
+	100, // 335: booking_service.BookingService.VoidFolioCharge:output_type -> booking_service.VoidFolioChargeResponse
+	102, // 336: booking_service.BookingService.SettleFolio:output_type -> booking_service.SettleFolioResponse
+	104, // 337: booking_service.BookingService.CloseFolio:output_type -> booking_service.CloseFolioResponse
+	106, // 338: booking_service.BookingService.IssueInvoice:output_type -> booking_service.IssueInvoiceResponse
+	108, // 339: booking_service.BookingService.GetInvoice:output_type -> booking_service.GetInvoiceResponse
+	167, // 340: booking_service.BookingService.DownloadInvoice:output_type -> google.api.HttpBody
+	111, // 341: booking_service.BookingService.CreatePromotion:output_type -> booking_service.CreatePromotionResponse
+	113, // 342: booking_service.BookingService.ListPromotions:output_type -> booking_service.ListPromotionsResponse
+	115, // 343: booking_service.BookingService.UpdatePromotion:output_type -> booking_service.UpdatePromotionResponse
+	117, // 344: booking_service.BookingService.DeletePromotion:output_type -> booking_service.DeletePromotionResponse
+	119, // 345: booking_service.BookingService.QuoteStay:output_type -> booking_service.QuoteStayResponse
+	121, // 346: booking_service.BookingService.SetExchangeRates:output_type -> booking_service.SetExchangeRatesResponse
+	123, // 347: booking_service.BookingService.ListExchangeRates:output_type -> booking_service.ListExchangeRatesResponse
+	125, // 348: booking_service.BookingService.GetReconciliationReport:output_type -> booking_service.GetReconciliationReportResponse
+	128, // 349: booking_service.BookingService.ListAuditEvents:output_type -> booking_service.ListAuditEventsResponse
+	295, // [295:350] is the sub-list for method output_type
+	240, // [240:295] is the sub-list for method input_type
+	240, // [240:240] is the sub-list for extension type_name
+	240, // [240:240] is the sub-list for extension extendee
+	0,   // [0:240] is the sub-list for field type_name
 }
 
 func init() { file_booking_service_proto_init() }
@@ -1683,8 +11527,8 @@ func file_booking_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_service_proto_rawDesc), len(file_booking_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   23,
+			NumEnums:      18,
+			NumMessages:   147,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
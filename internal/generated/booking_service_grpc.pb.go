@@ -8,6 +8,7 @@ package generated
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,14 +20,61 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookingService_CreateHotel_FullMethodName   = "/booking_service.BookingService/CreateHotel"
-	BookingService_CreateRoom_FullMethodName    = "/booking_service.BookingService/CreateRoom"
-	BookingService_UpdateRoom_FullMethodName    = "/booking_service.BookingService/UpdateRoom"
-	BookingService_CreateBooking_FullMethodName = "/booking_service.BookingService/CreateBooking"
-	BookingService_CancelBooking_FullMethodName = "/booking_service.BookingService/CancelBooking"
-	BookingService_ModifyBooking_FullMethodName = "/booking_service.BookingService/ModifyBooking"
-	BookingService_CreateGuest_FullMethodName   = "/booking_service.BookingService/CreateGuest"
-	BookingService_SubmitReview_FullMethodName  = "/booking_service.BookingService/SubmitReview"
+	BookingService_CreateHotel_FullMethodName             = "/booking_service.BookingService/CreateHotel"
+	BookingService_CreateRoom_FullMethodName              = "/booking_service.BookingService/CreateRoom"
+	BookingService_UpdateRoom_FullMethodName              = "/booking_service.BookingService/UpdateRoom"
+	BookingService_CreateBooking_FullMethodName           = "/booking_service.BookingService/CreateBooking"
+	BookingService_CancelBooking_FullMethodName           = "/booking_service.BookingService/CancelBooking"
+	BookingService_ModifyBooking_FullMethodName           = "/booking_service.BookingService/ModifyBooking"
+	BookingService_ListMyBookings_FullMethodName          = "/booking_service.BookingService/ListMyBookings"
+	BookingService_CreateGuest_FullMethodName             = "/booking_service.BookingService/CreateGuest"
+	BookingService_GetGuest_FullMethodName                = "/booking_service.BookingService/GetGuest"
+	BookingService_UpdateGuest_FullMethodName             = "/booking_service.BookingService/UpdateGuest"
+	BookingService_SearchGuests_FullMethodName            = "/booking_service.BookingService/SearchGuests"
+	BookingService_MergeGuests_FullMethodName             = "/booking_service.BookingService/MergeGuests"
+	BookingService_ExportGuestData_FullMethodName         = "/booking_service.BookingService/ExportGuestData"
+	BookingService_EraseGuest_FullMethodName              = "/booking_service.BookingService/EraseGuest"
+	BookingService_ListDuplicateGuests_FullMethodName     = "/booking_service.BookingService/ListDuplicateGuests"
+	BookingService_SubmitReview_FullMethodName            = "/booking_service.BookingService/SubmitReview"
+	BookingService_UpdateRoomStatus_FullMethodName        = "/booking_service.BookingService/UpdateRoomStatus"
+	BookingService_CreateEmployee_FullMethodName          = "/booking_service.BookingService/CreateEmployee"
+	BookingService_GetEmployee_FullMethodName             = "/booking_service.BookingService/GetEmployee"
+	BookingService_ListEmployees_FullMethodName           = "/booking_service.BookingService/ListEmployees"
+	BookingService_UpdateEmployee_FullMethodName          = "/booking_service.BookingService/UpdateEmployee"
+	BookingService_DeleteEmployee_FullMethodName          = "/booking_service.BookingService/DeleteEmployee"
+	BookingService_CreateRatePlan_FullMethodName          = "/booking_service.BookingService/CreateRatePlan"
+	BookingService_ListRatePlans_FullMethodName           = "/booking_service.BookingService/ListRatePlans"
+	BookingService_UpdateRatePlan_FullMethodName          = "/booking_service.BookingService/UpdateRatePlan"
+	BookingService_DeleteRatePlan_FullMethodName          = "/booking_service.BookingService/DeleteRatePlan"
+	BookingService_SetRates_FullMethodName                = "/booking_service.BookingService/SetRates"
+	BookingService_GetRateCalendar_FullMethodName         = "/booking_service.BookingService/GetRateCalendar"
+	BookingService_SetPricingRule_FullMethodName          = "/booking_service.BookingService/SetPricingRule"
+	BookingService_ListPricingRules_FullMethodName        = "/booking_service.BookingService/ListPricingRules"
+	BookingService_DeletePricingRule_FullMethodName       = "/booking_service.BookingService/DeletePricingRule"
+	BookingService_SetDepositRule_FullMethodName          = "/booking_service.BookingService/SetDepositRule"
+	BookingService_GetDepositRule_FullMethodName          = "/booking_service.BookingService/GetDepositRule"
+	BookingService_DeleteDepositRule_FullMethodName       = "/booking_service.BookingService/DeleteDepositRule"
+	BookingService_CreateTaxRule_FullMethodName           = "/booking_service.BookingService/CreateTaxRule"
+	BookingService_ListTaxRules_FullMethodName            = "/booking_service.BookingService/ListTaxRules"
+	BookingService_DeleteTaxRule_FullMethodName           = "/booking_service.BookingService/DeleteTaxRule"
+	BookingService_PayBooking_FullMethodName              = "/booking_service.BookingService/PayBooking"
+	BookingService_GetFolio_FullMethodName                = "/booking_service.BookingService/GetFolio"
+	BookingService_PostFolioCharge_FullMethodName         = "/booking_service.BookingService/PostFolioCharge"
+	BookingService_VoidFolioCharge_FullMethodName         = "/booking_service.BookingService/VoidFolioCharge"
+	BookingService_SettleFolio_FullMethodName             = "/booking_service.BookingService/SettleFolio"
+	BookingService_CloseFolio_FullMethodName              = "/booking_service.BookingService/CloseFolio"
+	BookingService_IssueInvoice_FullMethodName            = "/booking_service.BookingService/IssueInvoice"
+	BookingService_GetInvoice_FullMethodName              = "/booking_service.BookingService/GetInvoice"
+	BookingService_DownloadInvoice_FullMethodName         = "/booking_service.BookingService/DownloadInvoice"
+	BookingService_CreatePromotion_FullMethodName         = "/booking_service.BookingService/CreatePromotion"
+	BookingService_ListPromotions_FullMethodName          = "/booking_service.BookingService/ListPromotions"
+	BookingService_UpdatePromotion_FullMethodName         = "/booking_service.BookingService/UpdatePromotion"
+	BookingService_DeletePromotion_FullMethodName         = "/booking_service.BookingService/DeletePromotion"
+	BookingService_QuoteStay_FullMethodName               = "/booking_service.BookingService/QuoteStay"
+	BookingService_SetExchangeRates_FullMethodName        = "/booking_service.BookingService/SetExchangeRates"
+	BookingService_ListExchangeRates_FullMethodName       = "/booking_service.BookingService/ListExchangeRates"
+	BookingService_GetReconciliationReport_FullMethodName = "/booking_service.BookingService/GetReconciliationReport"
+	BookingService_ListAuditEvents_FullMethodName         = "/booking_service.BookingService/ListAuditEvents"
 )
 
 // BookingServiceClient is the client API for BookingService service.
@@ -39,8 +87,88 @@ type BookingServiceClient interface {
 	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error)
 	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
 	ModifyBooking(ctx context.Context, in *ModifyBookingRequest, opts ...grpc.CallOption) (*ModifyBookingResponse, error)
+	// Бронирования гостя, предъявившего X-Booking-Token
+	ListMyBookings(ctx context.Context, in *ListMyBookingsRequest, opts ...grpc.CallOption) (*ListMyBookingsResponse, error)
 	CreateGuest(ctx context.Context, in *CreateGuestRequest, opts ...grpc.CallOption) (*CreateGuestResponse, error)
+	GetGuest(ctx context.Context, in *GetGuestRequest, opts ...grpc.CallOption) (*GetGuestResponse, error)
+	// Заменяет профиль гостя целиком: незаданные поля очищаются
+	UpdateGuest(ctx context.Context, in *UpdateGuestRequest, opts ...grpc.CallOption) (*UpdateGuestResponse, error)
+	// Поиск по подстроке имени, email, телефону или номеру документа среди
+	// гостей с бронированиями в отеле hotel_id
+	SearchGuests(ctx context.Context, in *SearchGuestsRequest, opts ...grpc.CallOption) (*SearchGuestsResponse, error)
+	// Объединяет дубликаты: бронирования и отзывы source переходят к target,
+	// пустые поля профиля target заполняются из source, source удаляется.
+	// Токены доступа, выданные на source, перестают открывать бронирования
+	MergeGuests(ctx context.Context, in *MergeGuestsRequest, opts ...grpc.CallOption) (*MergeGuestsResponse, error)
+	// Выгрузка персональных данных гостя по запросу субъекта данных: JSON с
+	// профилем, бронированиями и отзывами (GuestDataExport)
+	ExportGuestData(ctx context.Context, in *ExportGuestDataRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Обезличивает гостя по запросу на удаление: профиль и текст отзывов
+	// очищаются, персональные данные вычищаются из журнала аудита. Оценки в
+	// отзывах, бронирования, платежи и счета сохраняются. Необратимо
+	EraseGuest(ctx context.Context, in *EraseGuestRequest, opts ...grpc.CallOption) (*EraseGuestResponse, error)
+	// Пары гостей, похожих на одного человека: совпадают email, телефон,
+	// документ или имя
+	ListDuplicateGuests(ctx context.Context, in *ListDuplicateGuestsRequest, opts ...grpc.CallOption) (*ListDuplicateGuestsResponse, error)
 	SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*SubmitReviewResponse, error)
+	UpdateRoomStatus(ctx context.Context, in *UpdateRoomStatusRequest, opts ...grpc.CallOption) (*UpdateRoomStatusResponse, error)
+	CreateEmployee(ctx context.Context, in *CreateEmployeeRequest, opts ...grpc.CallOption) (*CreateEmployeeResponse, error)
+	GetEmployee(ctx context.Context, in *GetEmployeeRequest, opts ...grpc.CallOption) (*GetEmployeeResponse, error)
+	ListEmployees(ctx context.Context, in *ListEmployeesRequest, opts ...grpc.CallOption) (*ListEmployeesResponse, error)
+	UpdateEmployee(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*UpdateEmployeeResponse, error)
+	DeleteEmployee(ctx context.Context, in *DeleteEmployeeRequest, opts ...grpc.CallOption) (*DeleteEmployeeResponse, error)
+	CreateRatePlan(ctx context.Context, in *CreateRatePlanRequest, opts ...grpc.CallOption) (*CreateRatePlanResponse, error)
+	ListRatePlans(ctx context.Context, in *ListRatePlansRequest, opts ...grpc.CallOption) (*ListRatePlansResponse, error)
+	UpdateRatePlan(ctx context.Context, in *UpdateRatePlanRequest, opts ...grpc.CallOption) (*UpdateRatePlanResponse, error)
+	DeleteRatePlan(ctx context.Context, in *DeleteRatePlanRequest, opts ...grpc.CallOption) (*DeleteRatePlanResponse, error)
+	// Переопределяет цены ночей по диапазонам дат и дням недели
+	SetRates(ctx context.Context, in *SetRatesRequest, opts ...grpc.CallOption) (*SetRatesResponse, error)
+	// Действующая цена каждой ночи с учётом тарифов и календаря
+	GetRateCalendar(ctx context.Context, in *GetRateCalendarRequest, opts ...grpc.CallOption) (*GetRateCalendarResponse, error)
+	// Правило динамического ценообразования для типа номера; заменяет существующее
+	SetPricingRule(ctx context.Context, in *SetPricingRuleRequest, opts ...grpc.CallOption) (*SetPricingRuleResponse, error)
+	ListPricingRules(ctx context.Context, in *ListPricingRulesRequest, opts ...grpc.CallOption) (*ListPricingRulesResponse, error)
+	DeletePricingRule(ctx context.Context, in *DeletePricingRuleRequest, opts ...grpc.CallOption) (*DeletePricingRuleResponse, error)
+	// Правило предоплаты отеля: депозит при бронировании, остаток до заезда; заменяет существующее
+	SetDepositRule(ctx context.Context, in *SetDepositRuleRequest, opts ...grpc.CallOption) (*SetDepositRuleResponse, error)
+	GetDepositRule(ctx context.Context, in *GetDepositRuleRequest, opts ...grpc.CallOption) (*GetDepositRuleResponse, error)
+	DeleteDepositRule(ctx context.Context, in *DeleteDepositRuleRequest, opts ...grpc.CallOption) (*DeleteDepositRuleResponse, error)
+	CreateTaxRule(ctx context.Context, in *CreateTaxRuleRequest, opts ...grpc.CallOption) (*CreateTaxRuleResponse, error)
+	ListTaxRules(ctx context.Context, in *ListTaxRulesRequest, opts ...grpc.CallOption) (*ListTaxRulesResponse, error)
+	DeleteTaxRule(ctx context.Context, in *DeleteTaxRuleRequest, opts ...grpc.CallOption) (*DeleteTaxRuleResponse, error)
+	// Списание полной стоимости бронирования с налогами и сборами
+	PayBooking(ctx context.Context, in *PayBookingRequest, opts ...grpc.CallOption) (*PayBookingResponse, error)
+	// Счет гостя за дополнительные услуги с начислениями, оплатами и остатком
+	GetFolio(ctx context.Context, in *GetFolioRequest, opts ...grpc.CallOption) (*GetFolioResponse, error)
+	PostFolioCharge(ctx context.Context, in *PostFolioChargeRequest, opts ...grpc.CallOption) (*PostFolioChargeResponse, error)
+	// Сторнирование начисления; оплаченное начисление сторнировать нельзя
+	VoidFolioCharge(ctx context.Context, in *VoidFolioChargeRequest, opts ...grpc.CallOption) (*VoidFolioChargeResponse, error)
+	// Оплата счета через платежный сервис; без суммы списывается весь остаток
+	SettleFolio(ctx context.Context, in *SettleFolioRequest, opts ...grpc.CallOption) (*SettleFolioResponse, error)
+	// Закрытие счета при выезде: счет и проживание должны быть оплачены
+	CloseFolio(ctx context.Context, in *CloseFolioRequest, opts ...grpc.CallOption) (*CloseFolioResponse, error)
+	// Выставление счета после выезда гостя: проживание, налоги и начисления
+	// счета гостя. Номер сквозной по отелю в пределах года
+	IssueInvoice(ctx context.Context, in *IssueInvoiceRequest, opts ...grpc.CallOption) (*IssueInvoiceResponse, error)
+	GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*GetInvoiceResponse, error)
+	// Сохраненный документ счета: PDF (по умолчанию) или JSON
+	DownloadInvoice(ctx context.Context, in *DownloadInvoiceRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*CreatePromotionResponse, error)
+	// Промокоды отеля вместе с промокодами всех отелей; без hotel_id — все
+	ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error)
+	UpdatePromotion(ctx context.Context, in *UpdatePromotionRequest, opts ...grpc.CallOption) (*UpdatePromotionResponse, error)
+	DeletePromotion(ctx context.Context, in *DeletePromotionRequest, opts ...grpc.CallOption) (*DeletePromotionResponse, error)
+	// Расчет стоимости проживания до бронирования; quote_token из ответа
+	// передается в CreateBooking, чтобы бронирование получило ровно эту цену
+	QuoteStay(ctx context.Context, in *QuoteStayRequest, opts ...grpc.CallOption) (*QuoteStayResponse, error)
+	// Загрузка курсов валют списком или CSV (строки base,quote,rate);
+	// используются только для показа цен в валюте гостя
+	SetExchangeRates(ctx context.Context, in *SetExchangeRatesRequest, opts ...grpc.CallOption) (*SetExchangeRatesResponse, error)
+	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error)
+	// Отчет сверки оплат с платежным сервисом; без hotel_id — все отели (только администратор)
+	GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*GetReconciliationReportResponse, error)
+	// Журнал аудита; менеджеру доступны только события своего отеля
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 }
 
 type bookingServiceClient struct {
@@ -111,6 +239,16 @@ func (c *bookingServiceClient) ModifyBooking(ctx context.Context, in *ModifyBook
 	return out, nil
 }
 
+func (c *bookingServiceClient) ListMyBookings(ctx context.Context, in *ListMyBookingsRequest, opts ...grpc.CallOption) (*ListMyBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyBookingsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListMyBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) CreateGuest(ctx context.Context, in *CreateGuestRequest, opts ...grpc.CallOption) (*CreateGuestResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateGuestResponse)
@@ -118,226 +256,1753 @@ func (c *bookingServiceClient) CreateGuest(ctx context.Context, in *CreateGuestR
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetGuest(ctx context.Context, in *GetGuestRequest, opts ...grpc.CallOption) (*GetGuestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGuestResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetGuest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateGuest(ctx context.Context, in *UpdateGuestRequest, opts ...grpc.CallOption) (*UpdateGuestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGuestResponse)
+	err := c.cc.Invoke(ctx, BookingService_UpdateGuest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SearchGuests(ctx context.Context, in *SearchGuestsRequest, opts ...grpc.CallOption) (*SearchGuestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchGuestsResponse)
+	err := c.cc.Invoke(ctx, BookingService_SearchGuests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) MergeGuests(ctx context.Context, in *MergeGuestsRequest, opts ...grpc.CallOption) (*MergeGuestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeGuestsResponse)
+	err := c.cc.Invoke(ctx, BookingService_MergeGuests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ExportGuestData(ctx context.Context, in *ExportGuestDataRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, BookingService_ExportGuestData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) EraseGuest(ctx context.Context, in *EraseGuestRequest, opts ...grpc.CallOption) (*EraseGuestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EraseGuestResponse)
+	err := c.cc.Invoke(ctx, BookingService_EraseGuest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListDuplicateGuests(ctx context.Context, in *ListDuplicateGuestsRequest, opts ...grpc.CallOption) (*ListDuplicateGuestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDuplicateGuestsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListDuplicateGuests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*SubmitReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitReviewResponse)
+	err := c.cc.Invoke(ctx, BookingService_SubmitReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateRoomStatus(ctx context.Context, in *UpdateRoomStatusRequest, opts ...grpc.CallOption) (*UpdateRoomStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRoomStatusResponse)
+	err := c.cc.Invoke(ctx, BookingService_UpdateRoomStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateEmployee(ctx context.Context, in *CreateEmployeeRequest, opts ...grpc.CallOption) (*CreateEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEmployeeResponse)
+	err := c.cc.Invoke(ctx, BookingService_CreateEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetEmployee(ctx context.Context, in *GetEmployeeRequest, opts ...grpc.CallOption) (*GetEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmployeeResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListEmployees(ctx context.Context, in *ListEmployeesRequest, opts ...grpc.CallOption) (*ListEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployeesResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateEmployee(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*UpdateEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEmployeeResponse)
+	err := c.cc.Invoke(ctx, BookingService_UpdateEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeleteEmployee(ctx context.Context, in *DeleteEmployeeRequest, opts ...grpc.CallOption) (*DeleteEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEmployeeResponse)
+	err := c.cc.Invoke(ctx, BookingService_DeleteEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateRatePlan(ctx context.Context, in *CreateRatePlanRequest, opts ...grpc.CallOption) (*CreateRatePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRatePlanResponse)
+	err := c.cc.Invoke(ctx, BookingService_CreateRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListRatePlans(ctx context.Context, in *ListRatePlansRequest, opts ...grpc.CallOption) (*ListRatePlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRatePlansResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListRatePlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateRatePlan(ctx context.Context, in *UpdateRatePlanRequest, opts ...grpc.CallOption) (*UpdateRatePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRatePlanResponse)
+	err := c.cc.Invoke(ctx, BookingService_UpdateRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeleteRatePlan(ctx context.Context, in *DeleteRatePlanRequest, opts ...grpc.CallOption) (*DeleteRatePlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRatePlanResponse)
+	err := c.cc.Invoke(ctx, BookingService_DeleteRatePlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SetRates(ctx context.Context, in *SetRatesRequest, opts ...grpc.CallOption) (*SetRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRatesResponse)
+	err := c.cc.Invoke(ctx, BookingService_SetRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetRateCalendar(ctx context.Context, in *GetRateCalendarRequest, opts ...grpc.CallOption) (*GetRateCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRateCalendarResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetRateCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SetPricingRule(ctx context.Context, in *SetPricingRuleRequest, opts ...grpc.CallOption) (*SetPricingRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPricingRuleResponse)
+	err := c.cc.Invoke(ctx, BookingService_SetPricingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListPricingRules(ctx context.Context, in *ListPricingRulesRequest, opts ...grpc.CallOption) (*ListPricingRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPricingRulesResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListPricingRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeletePricingRule(ctx context.Context, in *DeletePricingRuleRequest, opts ...grpc.CallOption) (*DeletePricingRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePricingRuleResponse)
+	err := c.cc.Invoke(ctx, BookingService_DeletePricingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SetDepositRule(ctx context.Context, in *SetDepositRuleRequest, opts ...grpc.CallOption) (*SetDepositRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDepositRuleResponse)
+	err := c.cc.Invoke(ctx, BookingService_SetDepositRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetDepositRule(ctx context.Context, in *GetDepositRuleRequest, opts ...grpc.CallOption) (*GetDepositRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDepositRuleResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetDepositRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeleteDepositRule(ctx context.Context, in *DeleteDepositRuleRequest, opts ...grpc.CallOption) (*DeleteDepositRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDepositRuleResponse)
+	err := c.cc.Invoke(ctx, BookingService_DeleteDepositRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateTaxRule(ctx context.Context, in *CreateTaxRuleRequest, opts ...grpc.CallOption) (*CreateTaxRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTaxRuleResponse)
+	err := c.cc.Invoke(ctx, BookingService_CreateTaxRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListTaxRules(ctx context.Context, in *ListTaxRulesRequest, opts ...grpc.CallOption) (*ListTaxRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaxRulesResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListTaxRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeleteTaxRule(ctx context.Context, in *DeleteTaxRuleRequest, opts ...grpc.CallOption) (*DeleteTaxRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTaxRuleResponse)
+	err := c.cc.Invoke(ctx, BookingService_DeleteTaxRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) PayBooking(ctx context.Context, in *PayBookingRequest, opts ...grpc.CallOption) (*PayBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_PayBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetFolio(ctx context.Context, in *GetFolioRequest, opts ...grpc.CallOption) (*GetFolioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFolioResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetFolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) PostFolioCharge(ctx context.Context, in *PostFolioChargeRequest, opts ...grpc.CallOption) (*PostFolioChargeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostFolioChargeResponse)
+	err := c.cc.Invoke(ctx, BookingService_PostFolioCharge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) VoidFolioCharge(ctx context.Context, in *VoidFolioChargeRequest, opts ...grpc.CallOption) (*VoidFolioChargeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidFolioChargeResponse)
+	err := c.cc.Invoke(ctx, BookingService_VoidFolioCharge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SettleFolio(ctx context.Context, in *SettleFolioRequest, opts ...grpc.CallOption) (*SettleFolioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleFolioResponse)
+	err := c.cc.Invoke(ctx, BookingService_SettleFolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CloseFolio(ctx context.Context, in *CloseFolioRequest, opts ...grpc.CallOption) (*CloseFolioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseFolioResponse)
+	err := c.cc.Invoke(ctx, BookingService_CloseFolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) IssueInvoice(ctx context.Context, in *IssueInvoiceRequest, opts ...grpc.CallOption) (*IssueInvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueInvoiceResponse)
+	err := c.cc.Invoke(ctx, BookingService_IssueInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetInvoice(ctx context.Context, in *GetInvoiceRequest, opts ...grpc.CallOption) (*GetInvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvoiceResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DownloadInvoice(ctx context.Context, in *DownloadInvoiceRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, BookingService_DownloadInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*CreatePromotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromotionResponse)
+	err := c.cc.Invoke(ctx, BookingService_CreatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromotionsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListPromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdatePromotion(ctx context.Context, in *UpdatePromotionRequest, opts ...grpc.CallOption) (*UpdatePromotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePromotionResponse)
+	err := c.cc.Invoke(ctx, BookingService_UpdatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeletePromotion(ctx context.Context, in *DeletePromotionRequest, opts ...grpc.CallOption) (*DeletePromotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePromotionResponse)
+	err := c.cc.Invoke(ctx, BookingService_DeletePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) QuoteStay(ctx context.Context, in *QuoteStayRequest, opts ...grpc.CallOption) (*QuoteStayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteStayResponse)
+	err := c.cc.Invoke(ctx, BookingService_QuoteStay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SetExchangeRates(ctx context.Context, in *SetExchangeRatesRequest, opts ...grpc.CallOption) (*SetExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, BookingService_SetExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*GetReconciliationReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReconciliationReportResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetReconciliationReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookingServiceServer is the server API for BookingService service.
+// All implementations must embed UnimplementedBookingServiceServer
+// for forward compatibility.
+type BookingServiceServer interface {
+	CreateHotel(context.Context, *CreateHotelRequest) (*CreateHotelResponse, error)
+	CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error)
+	UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error)
+	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error)
+	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
+	ModifyBooking(context.Context, *ModifyBookingRequest) (*ModifyBookingResponse, error)
+	// Бронирования гостя, предъявившего X-Booking-Token
+	ListMyBookings(context.Context, *ListMyBookingsRequest) (*ListMyBookingsResponse, error)
+	CreateGuest(context.Context, *CreateGuestRequest) (*CreateGuestResponse, error)
+	GetGuest(context.Context, *GetGuestRequest) (*GetGuestResponse, error)
+	// Заменяет профиль гостя целиком: незаданные поля очищаются
+	UpdateGuest(context.Context, *UpdateGuestRequest) (*UpdateGuestResponse, error)
+	// Поиск по подстроке имени, email, телефону или номеру документа среди
+	// гостей с бронированиями в отеле hotel_id
+	SearchGuests(context.Context, *SearchGuestsRequest) (*SearchGuestsResponse, error)
+	// Объединяет дубликаты: бронирования и отзывы source переходят к target,
+	// пустые поля профиля target заполняются из source, source удаляется.
+	// Токены доступа, выданные на source, перестают открывать бронирования
+	MergeGuests(context.Context, *MergeGuestsRequest) (*MergeGuestsResponse, error)
+	// Выгрузка персональных данных гостя по запросу субъекта данных: JSON с
+	// профилем, бронированиями и отзывами (GuestDataExport)
+	ExportGuestData(context.Context, *ExportGuestDataRequest) (*httpbody.HttpBody, error)
+	// Обезличивает гостя по запросу на удаление: профиль и текст отзывов
+	// очищаются, персональные данные вычищаются из журнала аудита. Оценки в
+	// отзывах, бронирования, платежи и счета сохраняются. Необратимо
+	EraseGuest(context.Context, *EraseGuestRequest) (*EraseGuestResponse, error)
+	// Пары гостей, похожих на одного человека: совпадают email, телефон,
+	// документ или имя
+	ListDuplicateGuests(context.Context, *ListDuplicateGuestsRequest) (*ListDuplicateGuestsResponse, error)
+	SubmitReview(context.Context, *SubmitReviewRequest) (*SubmitReviewResponse, error)
+	UpdateRoomStatus(context.Context, *UpdateRoomStatusRequest) (*UpdateRoomStatusResponse, error)
+	CreateEmployee(context.Context, *CreateEmployeeRequest) (*CreateEmployeeResponse, error)
+	GetEmployee(context.Context, *GetEmployeeRequest) (*GetEmployeeResponse, error)
+	ListEmployees(context.Context, *ListEmployeesRequest) (*ListEmployeesResponse, error)
+	UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*UpdateEmployeeResponse, error)
+	DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*DeleteEmployeeResponse, error)
+	CreateRatePlan(context.Context, *CreateRatePlanRequest) (*CreateRatePlanResponse, error)
+	ListRatePlans(context.Context, *ListRatePlansRequest) (*ListRatePlansResponse, error)
+	UpdateRatePlan(context.Context, *UpdateRatePlanRequest) (*UpdateRatePlanResponse, error)
+	DeleteRatePlan(context.Context, *DeleteRatePlanRequest) (*DeleteRatePlanResponse, error)
+	// Переопределяет цены ночей по диапазонам дат и дням недели
+	SetRates(context.Context, *SetRatesRequest) (*SetRatesResponse, error)
+	// Действующая цена каждой ночи с учётом тарифов и календаря
+	GetRateCalendar(context.Context, *GetRateCalendarRequest) (*GetRateCalendarResponse, error)
+	// Правило динамического ценообразования для типа номера; заменяет существующее
+	SetPricingRule(context.Context, *SetPricingRuleRequest) (*SetPricingRuleResponse, error)
+	ListPricingRules(context.Context, *ListPricingRulesRequest) (*ListPricingRulesResponse, error)
+	DeletePricingRule(context.Context, *DeletePricingRuleRequest) (*DeletePricingRuleResponse, error)
+	// Правило предоплаты отеля: депозит при бронировании, остаток до заезда; заменяет существующее
+	SetDepositRule(context.Context, *SetDepositRuleRequest) (*SetDepositRuleResponse, error)
+	GetDepositRule(context.Context, *GetDepositRuleRequest) (*GetDepositRuleResponse, error)
+	DeleteDepositRule(context.Context, *DeleteDepositRuleRequest) (*DeleteDepositRuleResponse, error)
+	CreateTaxRule(context.Context, *CreateTaxRuleRequest) (*CreateTaxRuleResponse, error)
+	ListTaxRules(context.Context, *ListTaxRulesRequest) (*ListTaxRulesResponse, error)
+	DeleteTaxRule(context.Context, *DeleteTaxRuleRequest) (*DeleteTaxRuleResponse, error)
+	// Списание полной стоимости бронирования с налогами и сборами
+	PayBooking(context.Context, *PayBookingRequest) (*PayBookingResponse, error)
+	// Счет гостя за дополнительные услуги с начислениями, оплатами и остатком
+	GetFolio(context.Context, *GetFolioRequest) (*GetFolioResponse, error)
+	PostFolioCharge(context.Context, *PostFolioChargeRequest) (*PostFolioChargeResponse, error)
+	// Сторнирование начисления; оплаченное начисление сторнировать нельзя
+	VoidFolioCharge(context.Context, *VoidFolioChargeRequest) (*VoidFolioChargeResponse, error)
+	// Оплата счета через платежный сервис; без суммы списывается весь остаток
+	SettleFolio(context.Context, *SettleFolioRequest) (*SettleFolioResponse, error)
+	// Закрытие счета при выезде: счет и проживание должны быть оплачены
+	CloseFolio(context.Context, *CloseFolioRequest) (*CloseFolioResponse, error)
+	// Выставление счета после выезда гостя: проживание, налоги и начисления
+	// счета гостя. Номер сквозной по отелю в пределах года
+	IssueInvoice(context.Context, *IssueInvoiceRequest) (*IssueInvoiceResponse, error)
+	GetInvoice(context.Context, *GetInvoiceRequest) (*GetInvoiceResponse, error)
+	// Сохраненный документ счета: PDF (по умолчанию) или JSON
+	DownloadInvoice(context.Context, *DownloadInvoiceRequest) (*httpbody.HttpBody, error)
+	CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error)
+	// Промокоды отеля вместе с промокодами всех отелей; без hotel_id — все
+	ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error)
+	UpdatePromotion(context.Context, *UpdatePromotionRequest) (*UpdatePromotionResponse, error)
+	DeletePromotion(context.Context, *DeletePromotionRequest) (*DeletePromotionResponse, error)
+	// Расчет стоимости проживания до бронирования; quote_token из ответа
+	// передается в CreateBooking, чтобы бронирование получило ровно эту цену
+	QuoteStay(context.Context, *QuoteStayRequest) (*QuoteStayResponse, error)
+	// Загрузка курсов валют списком или CSV (строки base,quote,rate);
+	// используются только для показа цен в валюте гостя
+	SetExchangeRates(context.Context, *SetExchangeRatesRequest) (*SetExchangeRatesResponse, error)
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
+	// Отчет сверки оплат с платежным сервисом; без hotel_id — все отели (только администратор)
+	GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*GetReconciliationReportResponse, error)
+	// Журнал аудита; менеджеру доступны только события своего отеля
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	mustEmbedUnimplementedBookingServiceServer()
+}
+
+// UnimplementedBookingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBookingServiceServer struct{}
+
+func (UnimplementedBookingServiceServer) CreateHotel(context.Context, *CreateHotelRequest) (*CreateHotelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHotel not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoom not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) ModifyBooking(context.Context, *ModifyBookingRequest) (*ModifyBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) ListMyBookings(context.Context, *ListMyBookingsRequest) (*ListMyBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateGuest(context.Context, *CreateGuestRequest) (*CreateGuestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuest not implemented")
+}
+func (UnimplementedBookingServiceServer) GetGuest(context.Context, *GetGuestRequest) (*GetGuestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuest not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateGuest(context.Context, *UpdateGuestRequest) (*UpdateGuestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuest not implemented")
+}
+func (UnimplementedBookingServiceServer) SearchGuests(context.Context, *SearchGuestsRequest) (*SearchGuestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchGuests not implemented")
+}
+func (UnimplementedBookingServiceServer) MergeGuests(context.Context, *MergeGuestsRequest) (*MergeGuestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeGuests not implemented")
+}
+func (UnimplementedBookingServiceServer) ExportGuestData(context.Context, *ExportGuestDataRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGuestData not implemented")
+}
+func (UnimplementedBookingServiceServer) EraseGuest(context.Context, *EraseGuestRequest) (*EraseGuestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseGuest not implemented")
+}
+func (UnimplementedBookingServiceServer) ListDuplicateGuests(context.Context, *ListDuplicateGuestsRequest) (*ListDuplicateGuestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDuplicateGuests not implemented")
+}
+func (UnimplementedBookingServiceServer) SubmitReview(context.Context, *SubmitReviewRequest) (*SubmitReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReview not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateRoomStatus(context.Context, *UpdateRoomStatusRequest) (*UpdateRoomStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoomStatus not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateEmployee(context.Context, *CreateEmployeeRequest) (*CreateEmployeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmployee not implemented")
+}
+func (UnimplementedBookingServiceServer) GetEmployee(context.Context, *GetEmployeeRequest) (*GetEmployeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmployee not implemented")
+}
+func (UnimplementedBookingServiceServer) ListEmployees(context.Context, *ListEmployeesRequest) (*ListEmployeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmployees not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*UpdateEmployeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEmployee not implemented")
+}
+func (UnimplementedBookingServiceServer) DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*DeleteEmployeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmployee not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateRatePlan(context.Context, *CreateRatePlanRequest) (*CreateRatePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRatePlan not implemented")
+}
+func (UnimplementedBookingServiceServer) ListRatePlans(context.Context, *ListRatePlansRequest) (*ListRatePlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRatePlans not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateRatePlan(context.Context, *UpdateRatePlanRequest) (*UpdateRatePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRatePlan not implemented")
+}
+func (UnimplementedBookingServiceServer) DeleteRatePlan(context.Context, *DeleteRatePlanRequest) (*DeleteRatePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRatePlan not implemented")
+}
+func (UnimplementedBookingServiceServer) SetRates(context.Context, *SetRatesRequest) (*SetRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRates not implemented")
+}
+func (UnimplementedBookingServiceServer) GetRateCalendar(context.Context, *GetRateCalendarRequest) (*GetRateCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateCalendar not implemented")
+}
+func (UnimplementedBookingServiceServer) SetPricingRule(context.Context, *SetPricingRuleRequest) (*SetPricingRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPricingRule not implemented")
+}
+func (UnimplementedBookingServiceServer) ListPricingRules(context.Context, *ListPricingRulesRequest) (*ListPricingRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPricingRules not implemented")
+}
+func (UnimplementedBookingServiceServer) DeletePricingRule(context.Context, *DeletePricingRuleRequest) (*DeletePricingRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePricingRule not implemented")
+}
+func (UnimplementedBookingServiceServer) SetDepositRule(context.Context, *SetDepositRuleRequest) (*SetDepositRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDepositRule not implemented")
+}
+func (UnimplementedBookingServiceServer) GetDepositRule(context.Context, *GetDepositRuleRequest) (*GetDepositRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositRule not implemented")
+}
+func (UnimplementedBookingServiceServer) DeleteDepositRule(context.Context, *DeleteDepositRuleRequest) (*DeleteDepositRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDepositRule not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateTaxRule(context.Context, *CreateTaxRuleRequest) (*CreateTaxRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTaxRule not implemented")
+}
+func (UnimplementedBookingServiceServer) ListTaxRules(context.Context, *ListTaxRulesRequest) (*ListTaxRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaxRules not implemented")
+}
+func (UnimplementedBookingServiceServer) DeleteTaxRule(context.Context, *DeleteTaxRuleRequest) (*DeleteTaxRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaxRule not implemented")
+}
+func (UnimplementedBookingServiceServer) PayBooking(context.Context, *PayBookingRequest) (*PayBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) GetFolio(context.Context, *GetFolioRequest) (*GetFolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFolio not implemented")
+}
+func (UnimplementedBookingServiceServer) PostFolioCharge(context.Context, *PostFolioChargeRequest) (*PostFolioChargeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostFolioCharge not implemented")
+}
+func (UnimplementedBookingServiceServer) VoidFolioCharge(context.Context, *VoidFolioChargeRequest) (*VoidFolioChargeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidFolioCharge not implemented")
+}
+func (UnimplementedBookingServiceServer) SettleFolio(context.Context, *SettleFolioRequest) (*SettleFolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleFolio not implemented")
+}
+func (UnimplementedBookingServiceServer) CloseFolio(context.Context, *CloseFolioRequest) (*CloseFolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseFolio not implemented")
+}
+func (UnimplementedBookingServiceServer) IssueInvoice(context.Context, *IssueInvoiceRequest) (*IssueInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueInvoice not implemented")
+}
+func (UnimplementedBookingServiceServer) GetInvoice(context.Context, *GetInvoiceRequest) (*GetInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoice not implemented")
+}
+func (UnimplementedBookingServiceServer) DownloadInvoice(context.Context, *DownloadInvoiceRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadInvoice not implemented")
+}
+func (UnimplementedBookingServiceServer) CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromotion not implemented")
+}
+func (UnimplementedBookingServiceServer) ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromotions not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdatePromotion(context.Context, *UpdatePromotionRequest) (*UpdatePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePromotion not implemented")
+}
+func (UnimplementedBookingServiceServer) DeletePromotion(context.Context, *DeletePromotionRequest) (*DeletePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePromotion not implemented")
+}
+func (UnimplementedBookingServiceServer) QuoteStay(context.Context, *QuoteStayRequest) (*QuoteStayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteStay not implemented")
+}
+func (UnimplementedBookingServiceServer) SetExchangeRates(context.Context, *SetExchangeRatesRequest) (*SetExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRates not implemented")
+}
+func (UnimplementedBookingServiceServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedBookingServiceServer) GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*GetReconciliationReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationReport not implemented")
+}
+func (UnimplementedBookingServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
+func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeBookingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookingServiceServer will
+// result in compilation errors.
+type UnsafeBookingServiceServer interface {
+	mustEmbedUnimplementedBookingServiceServer()
+}
+
+func RegisterBookingServiceServer(s grpc.ServiceRegistrar, srv BookingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBookingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BookingService_ServiceDesc, srv)
+}
+
+func _BookingService_CreateHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHotelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateHotel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateHotel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateHotel(ctx, req.(*CreateHotelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateRoom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateRoom(ctx, req.(*UpdateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateBooking(ctx, req.(*CreateBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ModifyBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ModifyBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ModifyBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ModifyBooking(ctx, req.(*ModifyBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListMyBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListMyBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListMyBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListMyBookings(ctx, req.(*ListMyBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateGuest(ctx, req.(*CreateGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetGuest(ctx, req.(*GetGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateGuest(ctx, req.(*UpdateGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SearchGuests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchGuestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SearchGuests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SearchGuests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SearchGuests(ctx, req.(*SearchGuestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_MergeGuests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeGuestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).MergeGuests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_MergeGuests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).MergeGuests(ctx, req.(*MergeGuestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ExportGuestData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGuestDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ExportGuestData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ExportGuestData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ExportGuestData(ctx, req.(*ExportGuestDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_EraseGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).EraseGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_EraseGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).EraseGuest(ctx, req.(*EraseGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListDuplicateGuests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDuplicateGuestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListDuplicateGuests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListDuplicateGuests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListDuplicateGuests(ctx, req.(*ListDuplicateGuestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SubmitReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SubmitReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SubmitReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SubmitReview(ctx, req.(*SubmitReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateRoomStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRoomStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateRoomStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateRoomStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateRoomStatus(ctx, req.(*UpdateRoomStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateEmployee(ctx, req.(*CreateEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetEmployee(ctx, req.(*GetEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListEmployees(ctx, req.(*ListEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateEmployee(ctx, req.(*UpdateEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeleteEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeleteEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_DeleteEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeleteEmployee(ctx, req.(*DeleteEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateRatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateRatePlan(ctx, req.(*CreateRatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListRatePlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRatePlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListRatePlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListRatePlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListRatePlans(ctx, req.(*ListRatePlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateRatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateRatePlan(ctx, req.(*UpdateRatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeleteRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRatePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeleteRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_DeleteRatePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeleteRatePlan(ctx, req.(*DeleteRatePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SetRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SetRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SetRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SetRates(ctx, req.(*SetRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetRateCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetRateCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetRateCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetRateCalendar(ctx, req.(*GetRateCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SetPricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPricingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SetPricingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SetPricingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SetPricingRule(ctx, req.(*SetPricingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListPricingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPricingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListPricingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListPricingRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListPricingRules(ctx, req.(*ListPricingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeletePricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePricingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeletePricingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_DeletePricingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeletePricingRule(ctx, req.(*DeletePricingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SetDepositRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDepositRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SetDepositRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SetDepositRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SetDepositRule(ctx, req.(*SetDepositRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetDepositRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepositRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetDepositRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetDepositRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetDepositRule(ctx, req.(*GetDepositRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeleteDepositRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDepositRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeleteDepositRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_DeleteDepositRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeleteDepositRule(ctx, req.(*DeleteDepositRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateTaxRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaxRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateTaxRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateTaxRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateTaxRule(ctx, req.(*CreateTaxRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListTaxRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaxRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListTaxRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListTaxRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListTaxRules(ctx, req.(*ListTaxRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeleteTaxRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaxRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeleteTaxRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_DeleteTaxRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeleteTaxRule(ctx, req.(*DeleteTaxRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_PayBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).PayBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_PayBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).PayBooking(ctx, req.(*PayBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetFolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetFolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetFolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetFolio(ctx, req.(*GetFolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_PostFolioCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostFolioChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).PostFolioCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_PostFolioCharge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).PostFolioCharge(ctx, req.(*PostFolioChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_VoidFolioCharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidFolioChargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).VoidFolioCharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_VoidFolioCharge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).VoidFolioCharge(ctx, req.(*VoidFolioChargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SettleFolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleFolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SettleFolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SettleFolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SettleFolio(ctx, req.(*SettleFolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *bookingServiceClient) SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*SubmitReviewResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SubmitReviewResponse)
-	err := c.cc.Invoke(ctx, BookingService_SubmitReview_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _BookingService_CloseFolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseFolioRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CloseFolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CloseFolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CloseFolio(ctx, req.(*CloseFolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// BookingServiceServer is the server API for BookingService service.
-// All implementations must embed UnimplementedBookingServiceServer
-// for forward compatibility.
-type BookingServiceServer interface {
-	CreateHotel(context.Context, *CreateHotelRequest) (*CreateHotelResponse, error)
-	CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error)
-	UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error)
-	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error)
-	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
-	ModifyBooking(context.Context, *ModifyBookingRequest) (*ModifyBookingResponse, error)
-	CreateGuest(context.Context, *CreateGuestRequest) (*CreateGuestResponse, error)
-	SubmitReview(context.Context, *SubmitReviewRequest) (*SubmitReviewResponse, error)
-	mustEmbedUnimplementedBookingServiceServer()
+func _BookingService_IssueInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).IssueInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_IssueInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).IssueInvoice(ctx, req.(*IssueInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedBookingServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedBookingServiceServer struct{}
-
-func (UnimplementedBookingServiceServer) CreateHotel(context.Context, *CreateHotelRequest) (*CreateHotelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateHotel not implemented")
-}
-func (UnimplementedBookingServiceServer) CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
-}
-func (UnimplementedBookingServiceServer) UpdateRoom(context.Context, *UpdateRoomRequest) (*UpdateRoomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoom not implemented")
-}
-func (UnimplementedBookingServiceServer) CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBooking not implemented")
-}
-func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
-}
-func (UnimplementedBookingServiceServer) ModifyBooking(context.Context, *ModifyBookingRequest) (*ModifyBookingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyBooking not implemented")
-}
-func (UnimplementedBookingServiceServer) CreateGuest(context.Context, *CreateGuestRequest) (*CreateGuestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGuest not implemented")
-}
-func (UnimplementedBookingServiceServer) SubmitReview(context.Context, *SubmitReviewRequest) (*SubmitReviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitReview not implemented")
+func _BookingService_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetInvoice(ctx, req.(*GetInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
-func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeBookingServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BookingServiceServer will
-// result in compilation errors.
-type UnsafeBookingServiceServer interface {
-	mustEmbedUnimplementedBookingServiceServer()
+func _BookingService_DownloadInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DownloadInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_DownloadInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DownloadInvoice(ctx, req.(*DownloadInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBookingServiceServer(s grpc.ServiceRegistrar, srv BookingServiceServer) {
-	// If the following call pancis, it indicates UnimplementedBookingServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _BookingService_CreatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&BookingService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreatePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreatePromotion(ctx, req.(*CreatePromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_CreateHotel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateHotelRequest)
+func _BookingService_ListPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromotionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).CreateHotel(ctx, in)
+		return srv.(BookingServiceServer).ListPromotions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_CreateHotel_FullMethodName,
+		FullMethod: BookingService_ListPromotions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).CreateHotel(ctx, req.(*CreateHotelRequest))
+		return srv.(BookingServiceServer).ListPromotions(ctx, req.(*ListPromotionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRoomRequest)
+func _BookingService_UpdatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePromotionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).CreateRoom(ctx, in)
+		return srv.(BookingServiceServer).UpdatePromotion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_CreateRoom_FullMethodName,
+		FullMethod: BookingService_UpdatePromotion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+		return srv.(BookingServiceServer).UpdatePromotion(ctx, req.(*UpdatePromotionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_UpdateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRoomRequest)
+func _BookingService_DeletePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePromotionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).UpdateRoom(ctx, in)
+		return srv.(BookingServiceServer).DeletePromotion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_UpdateRoom_FullMethodName,
+		FullMethod: BookingService_DeletePromotion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UpdateRoom(ctx, req.(*UpdateRoomRequest))
+		return srv.(BookingServiceServer).DeletePromotion(ctx, req.(*DeletePromotionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_CreateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBookingRequest)
+func _BookingService_QuoteStay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteStayRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).CreateBooking(ctx, in)
+		return srv.(BookingServiceServer).QuoteStay(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_CreateBooking_FullMethodName,
+		FullMethod: BookingService_QuoteStay_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).CreateBooking(ctx, req.(*CreateBookingRequest))
+		return srv.(BookingServiceServer).QuoteStay(ctx, req.(*QuoteStayRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelBookingRequest)
+func _BookingService_SetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeRatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).CancelBooking(ctx, in)
+		return srv.(BookingServiceServer).SetExchangeRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_CancelBooking_FullMethodName,
+		FullMethod: BookingService_SetExchangeRates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+		return srv.(BookingServiceServer).SetExchangeRates(ctx, req.(*SetExchangeRatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_ModifyBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyBookingRequest)
+func _BookingService_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExchangeRatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).ModifyBooking(ctx, in)
+		return srv.(BookingServiceServer).ListExchangeRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_ModifyBooking_FullMethodName,
+		FullMethod: BookingService_ListExchangeRates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).ModifyBooking(ctx, req.(*ModifyBookingRequest))
+		return srv.(BookingServiceServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_CreateGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateGuestRequest)
+func _BookingService_GetReconciliationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).CreateGuest(ctx, in)
+		return srv.(BookingServiceServer).GetReconciliationReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_CreateGuest_FullMethodName,
+		FullMethod: BookingService_GetReconciliationReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).CreateGuest(ctx, req.(*CreateGuestRequest))
+		return srv.(BookingServiceServer).GetReconciliationReport(ctx, req.(*GetReconciliationReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_SubmitReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitReviewRequest)
+func _BookingService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).SubmitReview(ctx, in)
+		return srv.(BookingServiceServer).ListAuditEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_SubmitReview_FullMethodName,
+		FullMethod: BookingService_ListAuditEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).SubmitReview(ctx, req.(*SubmitReviewRequest))
+		return srv.(BookingServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -373,14 +2038,202 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModifyBooking",
 			Handler:    _BookingService_ModifyBooking_Handler,
 		},
+		{
+			MethodName: "ListMyBookings",
+			Handler:    _BookingService_ListMyBookings_Handler,
+		},
 		{
 			MethodName: "CreateGuest",
 			Handler:    _BookingService_CreateGuest_Handler,
 		},
+		{
+			MethodName: "GetGuest",
+			Handler:    _BookingService_GetGuest_Handler,
+		},
+		{
+			MethodName: "UpdateGuest",
+			Handler:    _BookingService_UpdateGuest_Handler,
+		},
+		{
+			MethodName: "SearchGuests",
+			Handler:    _BookingService_SearchGuests_Handler,
+		},
+		{
+			MethodName: "MergeGuests",
+			Handler:    _BookingService_MergeGuests_Handler,
+		},
+		{
+			MethodName: "ExportGuestData",
+			Handler:    _BookingService_ExportGuestData_Handler,
+		},
+		{
+			MethodName: "EraseGuest",
+			Handler:    _BookingService_EraseGuest_Handler,
+		},
+		{
+			MethodName: "ListDuplicateGuests",
+			Handler:    _BookingService_ListDuplicateGuests_Handler,
+		},
 		{
 			MethodName: "SubmitReview",
 			Handler:    _BookingService_SubmitReview_Handler,
 		},
+		{
+			MethodName: "UpdateRoomStatus",
+			Handler:    _BookingService_UpdateRoomStatus_Handler,
+		},
+		{
+			MethodName: "CreateEmployee",
+			Handler:    _BookingService_CreateEmployee_Handler,
+		},
+		{
+			MethodName: "GetEmployee",
+			Handler:    _BookingService_GetEmployee_Handler,
+		},
+		{
+			MethodName: "ListEmployees",
+			Handler:    _BookingService_ListEmployees_Handler,
+		},
+		{
+			MethodName: "UpdateEmployee",
+			Handler:    _BookingService_UpdateEmployee_Handler,
+		},
+		{
+			MethodName: "DeleteEmployee",
+			Handler:    _BookingService_DeleteEmployee_Handler,
+		},
+		{
+			MethodName: "CreateRatePlan",
+			Handler:    _BookingService_CreateRatePlan_Handler,
+		},
+		{
+			MethodName: "ListRatePlans",
+			Handler:    _BookingService_ListRatePlans_Handler,
+		},
+		{
+			MethodName: "UpdateRatePlan",
+			Handler:    _BookingService_UpdateRatePlan_Handler,
+		},
+		{
+			MethodName: "DeleteRatePlan",
+			Handler:    _BookingService_DeleteRatePlan_Handler,
+		},
+		{
+			MethodName: "SetRates",
+			Handler:    _BookingService_SetRates_Handler,
+		},
+		{
+			MethodName: "GetRateCalendar",
+			Handler:    _BookingService_GetRateCalendar_Handler,
+		},
+		{
+			MethodName: "SetPricingRule",
+			Handler:    _BookingService_SetPricingRule_Handler,
+		},
+		{
+			MethodName: "ListPricingRules",
+			Handler:    _BookingService_ListPricingRules_Handler,
+		},
+		{
+			MethodName: "DeletePricingRule",
+			Handler:    _BookingService_DeletePricingRule_Handler,
+		},
+		{
+			MethodName: "SetDepositRule",
+			Handler:    _BookingService_SetDepositRule_Handler,
+		},
+		{
+			MethodName: "GetDepositRule",
+			Handler:    _BookingService_GetDepositRule_Handler,
+		},
+		{
+			MethodName: "DeleteDepositRule",
+			Handler:    _BookingService_DeleteDepositRule_Handler,
+		},
+		{
+			MethodName: "CreateTaxRule",
+			Handler:    _BookingService_CreateTaxRule_Handler,
+		},
+		{
+			MethodName: "ListTaxRules",
+			Handler:    _BookingService_ListTaxRules_Handler,
+		},
+		{
+			MethodName: "DeleteTaxRule",
+			Handler:    _BookingService_DeleteTaxRule_Handler,
+		},
+		{
+			MethodName: "PayBooking",
+			Handler:    _BookingService_PayBooking_Handler,
+		},
+		{
+			MethodName: "GetFolio",
+			Handler:    _BookingService_GetFolio_Handler,
+		},
+		{
+			MethodName: "PostFolioCharge",
+			Handler:    _BookingService_PostFolioCharge_Handler,
+		},
+		{
+			MethodName: "VoidFolioCharge",
+			Handler:    _BookingService_VoidFolioCharge_Handler,
+		},
+		{
+			MethodName: "SettleFolio",
+			Handler:    _BookingService_SettleFolio_Handler,
+		},
+		{
+			MethodName: "CloseFolio",
+			Handler:    _BookingService_CloseFolio_Handler,
+		},
+		{
+			MethodName: "IssueInvoice",
+			Handler:    _BookingService_IssueInvoice_Handler,
+		},
+		{
+			MethodName: "GetInvoice",
+			Handler:    _BookingService_GetInvoice_Handler,
+		},
+		{
+			MethodName: "DownloadInvoice",
+			Handler:    _BookingService_DownloadInvoice_Handler,
+		},
+		{
+			MethodName: "CreatePromotion",
+			Handler:    _BookingService_CreatePromotion_Handler,
+		},
+		{
+			MethodName: "ListPromotions",
+			Handler:    _BookingService_ListPromotions_Handler,
+		},
+		{
+			MethodName: "UpdatePromotion",
+			Handler:    _BookingService_UpdatePromotion_Handler,
+		},
+		{
+			MethodName: "DeletePromotion",
+			Handler:    _BookingService_DeletePromotion_Handler,
+		},
+		{
+			MethodName: "QuoteStay",
+			Handler:    _BookingService_QuoteStay_Handler,
+		},
+		{
+			MethodName: "SetExchangeRates",
+			Handler:    _BookingService_SetExchangeRates_Handler,
+		},
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _BookingService_ListExchangeRates_Handler,
+		},
+		{
+			MethodName: "GetReconciliationReport",
+			Handler:    _BookingService_GetReconciliationReport_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _BookingService_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "booking_service.proto",
@@ -76,9 +76,13 @@ func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type ProcessRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookingId     uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	Amount        float32                `protobuf:"fixed32,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BookingId uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// устаревшее: сумма в основных единицах без валюты, заполняется для совместимости
+	Amount float32 `protobuf:"fixed32,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// сумма в минимальных единицах валюты и код ISO 4217
+	AmountMinor   int64  `protobuf:"varint,3,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`
+	Currency      string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -127,6 +131,20 @@ func (x *ProcessRequest) GetAmount() float32 {
 	return 0
 }
 
+func (x *ProcessRequest) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *ProcessRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type ProcessResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -268,13 +286,16 @@ func (x *PaymentsResponse) GetPayments() []*Payment {
 }
 
 type Payment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BookingId     uint64                 `protobuf:"varint,4,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId uint64                 `protobuf:"varint,4,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// устаревшее: сумма в основных единицах без валюты
 	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	Status        PaymentStatus          `protobuf:"varint,9,opt,name=status,proto3,enum=payment.PaymentStatus" json:"status,omitempty"`
+	AmountMinor   int64                  `protobuf:"varint,10,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`
+	Currency      string                 `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -351,15 +372,31 @@ func (x *Payment) GetStatus() PaymentStatus {
 	return PaymentStatus_PAYMENT_STATUS_UNKNOWN
 }
 
+func (x *Payment) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *Payment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 var File_external_payments_service_proto protoreflect.FileDescriptor
 
 const file_external_payments_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1fexternal/payments_service.proto\x12\apayment\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"G\n" +
+	"\x1fexternal/payments_service.proto\x12\apayment\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\"\x86\x01\n" +
 	"\x0eProcessRequest\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\x04R\tbookingId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x02R\x06amount\"?\n" +
+	"\x06amount\x18\x02 \x01(\x02R\x06amount\x12!\n" +
+	"\famount_minor\x18\x03 \x01(\x03R\vamountMinor\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"?\n" +
 	"\x0fProcessResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\bR\x06status\x12\x14\n" +
 	"\x05Error\x18\x02 \x01(\tR\x05Error\",\n" +
@@ -367,7 +404,7 @@ const file_external_payments_service_proto_rawDesc = "" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\x04R\tbookingId\"@\n" +
 	"\x10PaymentsResponse\x12,\n" +
-	"\bpayments\x18\x01 \x03(\v2\x10.payment.PaymentR\bpayments\"\xf6\x01\n" +
+	"\bpayments\x18\x01 \x03(\v2\x10.payment.PaymentR\bpayments\"\xb5\x02\n" +
 	"\aPayment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
 	"\n" +
@@ -377,7 +414,10 @@ const file_external_payments_service_proto_rawDesc = "" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12.\n" +
-	"\x06status\x18\t \x01(\x0e2\x16.payment.PaymentStatusR\x06status*\x84\x01\n" +
+	"\x06status\x18\t \x01(\x0e2\x16.payment.PaymentStatusR\x06status\x12!\n" +
+	"\famount_minor\x18\n" +
+	" \x01(\x03R\vamountMinor\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency*\x84\x01\n" +
 	"\rPaymentStatus\x12\x1a\n" +
 	"\x16PAYMENT_STATUS_UNKNOWN\x10\x00\x12\x1d\n" +
 	"\x19PAYMENT_STATUS_AUTHORIZED\x10\x01\x12\x1a\n" +
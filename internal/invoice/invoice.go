@@ -0,0 +1,111 @@
+// Package invoice составляет счета за проживание и услуги и печатает их в PDF
+package invoice
+
+import (
+	"fmt"
+	"time"
+
+	"booking-service/internal/entities"
+)
+
+// Number форматирует номер счета: отель, финансовый год и порядковый номер
+func Number(hotelID uint64, fiscalYear int, seq int64) string {
+	return fmt.Sprintf("INV-%d-%d-%06d", hotelID, fiscalYear, seq)
+}
+
+// FiscalYear финансовый год счета, выставленного в момент t; совпадает с календарным
+func FiscalYear(t time.Time) int {
+	return t.UTC().Year()
+}
+
+// Build составляет счет из цены проживания, несторнированных начислений
+// счета гостя и успешных оплат бронирования. Ночи показываются по цене до
+// скидки промокода, скидка — отдельной строкой, чтобы итог совпал с ценой
+// бронирования
+func Build(
+	hotel entities.Hotel, booking entities.Booking, folio entities.Folio, payments []entities.Payment,
+	buyer entities.InvoiceParty,
+) entities.Invoice {
+	currency := hotel.Currency
+	inv := entities.Invoice{
+		HotelID:   hotel.ID,
+		BookingID: booking.ID,
+		Seller:    entities.InvoiceParty{Name: hotel.Name},
+		Buyer:     buyer,
+		StayStart: booking.StartDate,
+		StayEnd:   booking.EndDate,
+		Currency:  currency,
+		Lines:     make([]entities.InvoiceLine, 0),
+	}
+	if inv.Buyer.Name == "" && len(booking.Guests) > 0 {
+		inv.Buyer.Name = booking.Guests[0].Name
+	}
+
+	add := func(description string, quantity int, unit, net, tax int64) {
+		inv.Lines = append(inv.Lines, entities.InvoiceLine{
+			Description: description,
+			Quantity:    quantity,
+			UnitPrice:   entities.Money{Amount: unit, Currency: currency},
+			Net:         entities.Money{Amount: net, Currency: currency},
+			Tax:         entities.Money{Amount: tax, Currency: currency},
+			Total:       entities.Money{Amount: net + tax, Currency: currency},
+		})
+	}
+
+	for _, n := range booking.Price.Nights {
+		price := n.Price.Amount
+		for _, adj := range n.Adjustments {
+			if adj.Rule == "promo_code" {
+				price -= adj.Amount.Amount
+			}
+		}
+		add("Accommodation, night of "+n.Date.Format(time.DateOnly), 1, price, price, 0)
+	}
+	if d := booking.Price.Discount; d != nil && d.Amount.Amount > 0 {
+		add("Discount, promo code "+d.Code, 1, -d.Amount.Amount, -d.Amount.Amount, 0)
+	}
+	for _, item := range booking.Price.Items {
+		switch item.Kind {
+		case entities.TaxKindFee:
+			add(item.Name, item.Quantity, unitPrice(item), item.Amount.Amount, 0)
+		case entities.TaxKindCityTax:
+			add(item.Name, item.Quantity, unitPrice(item), 0, item.Amount.Amount)
+		default:
+			// НДС начислен на всю цену проживания, цена единицы не имеет смысла
+			add(item.Name, 1, 0, 0, item.Amount.Amount)
+		}
+	}
+	for _, ch := range folio.Charges {
+		if !ch.VoidedAt.IsZero() {
+			continue
+		}
+		description := string(ch.Category)
+		if ch.Description != "" {
+			description += ": " + ch.Description
+		}
+		add(description, ch.Quantity, ch.UnitPrice.Amount, ch.Net.Amount, ch.Tax.Amount)
+	}
+
+	inv.Net = entities.Money{Currency: currency}
+	inv.Tax = entities.Money{Currency: currency}
+	for _, l := range inv.Lines {
+		inv.Net.Amount += l.Net.Amount
+		inv.Tax.Amount += l.Tax.Amount
+	}
+	inv.Total = entities.Money{Amount: inv.Net.Amount + inv.Tax.Amount, Currency: currency}
+	inv.Paid = entities.Money{Currency: currency}
+	for _, p := range payments {
+		if p.Status == entities.PaymentStatusSuccess && p.Amount.Currency == currency {
+			inv.Paid.Amount += p.Amount.Amount
+		}
+	}
+	inv.Due = entities.Money{Amount: inv.Total.Amount - inv.Paid.Amount, Currency: currency}
+	return inv
+}
+
+func unitPrice(item entities.PriceItem) int64 {
+	if item.Quantity <= 0 {
+		return item.Amount.Amount
+	}
+	return item.Amount.Amount / int64(item.Quantity)
+}
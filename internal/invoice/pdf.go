@@ -0,0 +1,161 @@
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"booking-service/internal/entities"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+const (
+	// fontFamily имя, под которым регистрируется шрифт из конфига
+	fontFamily = "invoice"
+	// defaultFontFamily встроенный шрифт PDF: только латиница (cp1252)
+	defaultFontFamily = "Helvetica"
+)
+
+// ширина колонок таблицы строк, мм; сумма равна ширине области печати A4
+var columnWidths = []float64{70, 14, 24, 24, 24, 24}
+
+// Renderer печатает счета в PDF. Встроенный шрифт PDF не содержит
+// кириллицы, поэтому для счетов на русском нужен TTF-шрифт в конфиге
+type Renderer struct {
+	font []byte
+}
+
+// NewRenderer читает TTF-шрифт fontFile; пустой путь — встроенный шрифт
+func NewRenderer(fontFile string) (*Renderer, error) {
+	if fontFile == "" {
+		return &Renderer{}, nil
+	}
+	font, err := os.ReadFile(filepath.Clean(fontFile))
+	if err != nil {
+		return nil, fmt.Errorf("[invoice.NewRenderer]: %w", err)
+	}
+	return &Renderer{font: font}, nil
+}
+
+// PDF печатает счет. Даты документа берутся из счета, поэтому один и тот же
+// счет всегда печатается одинаково
+func (r *Renderer) PDF(inv entities.Invoice) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	family, tr := defaultFontFamily, pdf.UnicodeTranslatorFromDescriptor("")
+	if r.font != nil {
+		pdf.AddUTF8FontFromBytes(fontFamily, "", r.font)
+		pdf.AddUTF8FontFromBytes(fontFamily, "B", r.font)
+		family, tr = fontFamily, func(s string) string { return s }
+	}
+	pdf.SetCatalogSort(true)
+	pdf.SetCreationDate(inv.IssuedAt)
+	pdf.SetModificationDate(inv.IssuedAt)
+	pdf.SetTitle("Invoice "+inv.Number, true)
+	pdf.SetMargins(12, 15, 12)
+	pdf.AddPage()
+
+	pdf.SetFont(family, "B", 16)
+	pdf.CellFormat(0, 10, tr("Invoice "+inv.Number), "", 1, "L", false, 0, "")
+	pdf.SetFont(family, "", 10)
+	pdf.CellFormat(0, 6, "Issued: "+inv.IssuedAt.UTC().Format(time.DateOnly), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 6, fmt.Sprintf("Stay: %s - %s, booking %d",
+		inv.StayStart.Format(time.DateOnly), inv.StayEnd.Format(time.DateOnly), inv.BookingID), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	party := func(title string, p entities.InvoiceParty) {
+		pdf.SetFont(family, "B", 10)
+		pdf.CellFormat(0, 6, title, "", 1, "L", false, 0, "")
+		pdf.SetFont(family, "", 10)
+		for _, line := range []string{p.Name, taxID(p.TaxID), p.Address, p.Email} {
+			if line != "" {
+				pdf.MultiCell(0, 5, tr(line), "", "L", false)
+			}
+		}
+		pdf.Ln(2)
+	}
+	party("Seller", inv.Seller)
+	party("Buyer", inv.Buyer)
+
+	pdf.SetFont(family, "B", 9)
+	for i, h := range []string{"Description", "Qty", "Unit price", "Net", "Tax", "Total"} {
+		align := "R"
+		if i == 0 {
+			align = "L"
+		}
+		pdf.CellFormat(columnWidths[i], 7, h, "B", 0, align, false, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont(family, "", 9)
+	for _, l := range inv.Lines {
+		description := pdf.SplitText(tr(l.Description), columnWidths[0])
+		if len(description) == 0 {
+			description = []string{""}
+		}
+		cells := []string{
+			strconv.Itoa(l.Quantity),
+			FormatAmount(l.UnitPrice),
+			FormatAmount(l.Net),
+			FormatAmount(l.Tax),
+			FormatAmount(l.Total),
+		}
+		for i, text := range description {
+			pdf.CellFormat(columnWidths[0], 5, text, "", 0, "L", false, 0, "")
+			for j, cell := range cells {
+				if i > 0 {
+					cell = ""
+				}
+				pdf.CellFormat(columnWidths[j+1], 5, cell, "", 0, "R", false, 0, "")
+			}
+			pdf.Ln(-1)
+		}
+	}
+	pdf.CellFormat(0, 2, "", "T", 1, "L", false, 0, "")
+
+	total := func(label string, m entities.Money, style string) {
+		pdf.SetFont(family, style, 10)
+		pdf.CellFormat(columnWidths[0]+columnWidths[1]+columnWidths[2]+columnWidths[3], 6, "", "", 0, "L", false, 0, "")
+		pdf.CellFormat(columnWidths[4], 6, label, "", 0, "L", false, 0, "")
+		pdf.CellFormat(columnWidths[5], 6, FormatAmount(m)+" "+m.Currency, "", 1, "R", false, 0, "")
+	}
+	total("Net", inv.Net, "")
+	total("Tax", inv.Tax, "")
+	total("Total", inv.Total, "B")
+	total("Paid", inv.Paid, "")
+	total("Due", inv.Due, "B")
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("[invoice.PDF]: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// FormatAmount печатает сумму в основных единицах валюты: 1234.50
+func FormatAmount(m entities.Money) string {
+	exp := entities.MinorUnitExponent(m.Currency)
+	sign, amount := "", m.Amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	s := strconv.FormatInt(amount, 10)
+	if exp == 0 {
+		return sign + s
+	}
+	if len(s) <= exp {
+		s = strings.Repeat("0", exp-len(s)+1) + s
+	}
+	return sign + s[:len(s)-exp] + "." + s[len(s)-exp:]
+}
+
+func taxID(id string) string {
+	if id == "" {
+		return ""
+	}
+	return "Tax ID: " + id
+}
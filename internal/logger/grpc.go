@@ -0,0 +1,84 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor проставляет request id, кладет логгер запроса в контекст
+// и пишет access-лог gRPC-вызова
+func UnaryServerInterceptor(base *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx = startRPC(ctx, base, info.FullMethod)
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		logRPC(ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+func StreamServerInterceptor(base *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := startRPC(ss.Context(), base, info.FullMethod)
+		start := time.Now()
+
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+
+		logRPC(ctx, info.FullMethod, start, err)
+		return err
+	}
+}
+
+func startRPC(ctx context.Context, base *slog.Logger, method string) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDMetadataKey); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	id = normalizeRequestID(id)
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadataKey, id))
+
+	ctx = WithRequestID(ctx, id)
+	return WithContext(ctx, base.With(
+		slog.String("request_id", id),
+		slog.String("grpc.method", method),
+	))
+}
+
+func logRPC(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	if err != nil {
+		level = slog.LevelWarn
+	}
+	FromContext(ctx).LogAttrs(ctx, level, "grpc request",
+		slog.String("grpc.code", code.String()),
+		slog.Duration("duration", time.Since(start)),
+		errAttr(err),
+	)
+}
+
+func errAttr(err error) slog.Attr {
+	if err == nil {
+		return slog.Attr{}
+	}
+	return slog.String("error", err.Error())
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
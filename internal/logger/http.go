@@ -0,0 +1,56 @@
+package logger
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// HTTPMiddleware проставляет X-Request-Id (принимает от клиента или генерирует),
+// прокидывает его дальше в шлюз и пишет access-лог HTTP-запроса
+func HTTPMiddleware(base *slog.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		id := normalizeRequestID(r.Header.Get(RequestIDHeader))
+		r.Header.Set(RequestIDHeader, id)
+		w.Header().Set(RequestIDHeader, id)
+
+		l := base.With(slog.String("request_id", id))
+		ctx := WithContext(WithRequestID(r.Context(), id), l)
+
+		rw := &responseWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rw, r.WithContext(ctx))
+
+		l.LogAttrs(ctx, slog.LevelInfo, "http request",
+			slog.String("http.method", r.Method),
+			slog.String("http.path", r.URL.Path),
+			slog.Int("http.status", rw.status),
+			slog.Int("http.response_size", rw.size),
+			slog.String("http.remote_addr", r.RemoteAddr),
+			slog.Duration("duration", time.Since(start)),
+		)
+	})
+}
+
+type responseWriter struct {
+	http.ResponseWriter
+	status int
+	size   int
+}
+
+func (w *responseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.size += n
+	return n, err
+}
+
+func (w *responseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ctxLoggerKey struct{}
+
+// New создает JSON-логгер. Уровень задается через level и может меняться на лету.
+func New(w io.Writer, level *slog.LevelVar) *slog.Logger {
+	return slog.New(&contextHandler{
+		Handler: slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level}),
+	})
+}
+
+// ParseLevel разбирает уровень логирования из конфига (debug, info, warn, error)
+func ParseLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.TrimSpace(s))); err != nil {
+		return slog.LevelInfo, fmt.Errorf("unknown log level %q", s)
+	}
+	return level, nil
+}
+
+// WithContext кладет логгер запроса в контекст
+func WithContext(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, ctxLoggerKey{}, l)
+}
+
+// FromContext возвращает логгер запроса или логгер по умолчанию
+func FromContext(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(ctxLoggerKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return slog.Default()
+}
+
+// contextHandler дописывает в каждую запись trace_id/span_id из контекста,
+// чтобы логи HTTP, gRPC и БД можно было связать с трейсами
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(
+			slog.String("trace_id", sc.TraceID().String()),
+			slog.String("span_id", sc.SpanID().String()),
+		)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
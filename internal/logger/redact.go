@@ -0,0 +1,110 @@
+package logger
+
+import (
+	"encoding/json"
+	"log/slog"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const redacted = "[REDACTED]"
+
+// piiFields поля protobuf-сообщений с персональными данными гостей,
+// которые не должны попадать в логи
+var piiFields = map[protoreflect.FullName]struct{}{
+	"booking_service.CreateBookingRequest.guest.name":     {},
+	"booking_service.CreateBookingRequest.guest.email":    {},
+	"booking_service.CreateBookingRequest.guest.phone":    {},
+	"booking_service.CreateBookingRequest.guest.document": {},
+	"booking_service.CreateGuestRequest.name":             {},
+	"booking_service.CreateGuestRequest.email":            {},
+	"booking_service.CreateGuestRequest.phone":            {},
+	"booking_service.CreateGuestRequest.document":         {},
+	"booking_service.Guest.name":                          {},
+	"booking_service.Guest.email":                         {},
+	"booking_service.Guest.phone":                         {},
+	"booking_service.Guest.date_of_birth":                 {},
+	"booking_service.Guest.nationality":                   {},
+	"booking_service.Guest.address":                       {},
+	"booking_service.Guest.document":                      {},
+	"booking_service.UpdateGuestRequest.name":             {},
+	"booking_service.UpdateGuestRequest.email":            {},
+	"booking_service.UpdateGuestRequest.phone":            {},
+	"booking_service.UpdateGuestRequest.date_of_birth":    {},
+	"booking_service.UpdateGuestRequest.nationality":      {},
+	"booking_service.UpdateGuestRequest.address":          {},
+	"booking_service.UpdateGuestRequest.document":         {},
+	"booking_service.SearchGuestsRequest.name":            {},
+	"booking_service.SearchGuestsRequest.email":           {},
+	"booking_service.SearchGuestsRequest.phone":           {},
+	"booking_service.SearchGuestsRequest.document_number": {},
+	"booking_service.CreateEmployeeRequest.name":          {},
+	"booking_service.UpdateEmployeeRequest.name":          {},
+	"booking_service.Employee.name":                       {},
+}
+
+// Redact возвращает значение для лога, в котором персональные данные замаскированы
+func Redact(m proto.Message) slog.LogValuer {
+	return redactedMessage{m: m}
+}
+
+type redactedMessage struct {
+	m proto.Message
+}
+
+func (r redactedMessage) LogValue() slog.Value {
+	if r.m == nil {
+		return slog.AnyValue(nil)
+	}
+	clone := proto.Clone(r.m)
+	redactMessage(clone.ProtoReflect())
+
+	b, err := protojson.Marshal(clone)
+	if err != nil {
+		return slog.StringValue(redacted)
+	}
+	return slog.AnyValue(json.RawMessage(b))
+}
+
+func redactMessage(m protoreflect.Message) {
+	// изменять сообщение внутри Range нельзя, поэтому сначала собираем поля
+	var fields []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+
+	for _, fd := range fields {
+		if _, ok := piiFields[fd.FullName()]; ok {
+			if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() {
+				m.Set(fd, protoreflect.ValueOfString(redacted))
+			} else {
+				m.Clear(fd)
+			}
+			continue
+		}
+
+		if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+			continue
+		}
+		v := m.Get(fd)
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				redactMessage(list.Get(i).Message())
+			}
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redactMessage(mv.Message())
+					return true
+				})
+			}
+		default:
+			redactMessage(v.Message())
+		}
+	}
+}
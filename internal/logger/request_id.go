@@ -0,0 +1,40 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader заголовок, через который клиент или шлюз передает идентификатор запроса
+const RequestIDHeader = "X-Request-Id"
+
+// requestIDMetadataKey ключ gRPC-метаданных (всегда в нижнем регистре)
+const requestIDMetadataKey = "x-request-id"
+
+const maxRequestIDLength = 128
+
+type ctxRequestIDKey struct{}
+
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxRequestIDKey{}, id)
+}
+
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(ctxRequestIDKey{}).(string)
+	return id
+}
+
+// normalizeRequestID принимает идентификатор клиента, если он разумной длины
+// и из печатных ASCII-символов, иначе генерирует новый
+func normalizeRequestID(id string) string {
+	if id == "" || len(id) > maxRequestIDLength {
+		return uuid.NewString()
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return uuid.NewString()
+		}
+	}
+	return id
+}
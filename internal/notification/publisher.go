@@ -0,0 +1,119 @@
+// Package notification публикует уведомления для сервиса уведомлений в RabbitMQ
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"booking-service/internal/entities"
+
+	"github.com/streadway/amqp"
+)
+
+// Publisher публикует уведомления в topic exchange с ключом маршрутизации,
+// равным виду уведомления. Публикация подтверждается брокером (publisher
+// confirms); при ошибке соединение закрывается и открывается заново при
+// следующей публикации. Уведомление может прийти повторно, получатель
+// отбрасывает дубликаты по MessageId
+type Publisher struct {
+	url      string
+	exchange string
+
+	mu       sync.Mutex
+	conn     *amqp.Connection
+	ch       *amqp.Channel
+	confirms chan amqp.Confirmation
+}
+
+func NewPublisher(url, exchange string) *Publisher {
+	return &Publisher{url: url, exchange: exchange}
+}
+
+func (p *Publisher) Publish(ctx context.Context, n entities.Notification) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := p.connect(); err != nil {
+		return fmt.Errorf("[notification.Publish]: %w", err)
+	}
+	err := p.ch.Publish(p.exchange, string(n.Kind), false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    strconv.FormatUint(n.ID, 10),
+		Timestamp:    n.CreatedAt,
+		Type:         string(n.Kind),
+		Body:         n.Payload,
+	})
+	if err != nil {
+		p.reset()
+		return fmt.Errorf("[notification.Publish]: %w", err)
+	}
+
+	select {
+	case confirm, ok := <-p.confirms:
+		if !ok {
+			p.reset()
+			return errors.New("[notification.Publish]: channel closed before confirmation")
+		}
+		if !confirm.Ack {
+			return errors.New("[notification.Publish]: broker rejected the message")
+		}
+		return nil
+	case <-ctx.Done():
+		// подтверждение этой публикации может прийти позже и спутаться со следующей
+		p.reset()
+		return fmt.Errorf("[notification.Publish]: %w", ctx.Err())
+	}
+}
+
+// Close закрывает соединение с брокером
+func (p *Publisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conn == nil {
+		return nil
+	}
+	err := p.conn.Close()
+	p.conn, p.ch, p.confirms = nil, nil, nil
+	return err
+}
+
+func (p *Publisher) connect() error {
+	if p.ch != nil && !p.conn.IsClosed() {
+		return nil
+	}
+	p.reset()
+
+	conn, err := amqp.Dial(p.url)
+	if err != nil {
+		return err
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	if err = ch.ExchangeDeclare(p.exchange, amqp.ExchangeTopic, true, false, false, false, nil); err != nil {
+		_ = conn.Close()
+		return err
+	}
+	if err = ch.Confirm(false); err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	p.conn, p.ch = conn, ch
+	p.confirms = ch.NotifyPublish(make(chan amqp.Confirmation, 1))
+	return nil
+}
+
+func (p *Publisher) reset() {
+	if p.conn != nil {
+		_ = p.conn.Close()
+	}
+	p.conn, p.ch, p.confirms = nil, nil, nil
+}
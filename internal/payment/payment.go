@@ -0,0 +1,85 @@
+// Package payment обращается к платежному сервису
+package payment
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+)
+
+// Client обращается к платежному API. Сумма передается в минимальных единицах
+// с кодом валюты и дублируется в устаревшем поле amount в основных единицах
+type Client struct {
+	api generated.PaymentServiceClient
+}
+
+func New(api generated.PaymentServiceClient) *Client {
+	return &Client{api: api}
+}
+
+// Charge списывает сумму по бронированию
+func (c *Client) Charge(ctx context.Context, bookingID uint64, amount entities.Money) error {
+	res, err := c.api.ProcessPayment(ctx, &generated.ProcessRequest{
+		BookingId:   bookingID,
+		Amount:      float32(amount.Major()),
+		AmountMinor: amount.Amount,
+		Currency:    amount.Currency,
+	})
+	if err != nil {
+		return fmt.Errorf("[payment.Charge]: %w", err)
+	}
+	if !res.GetStatus() {
+		return fmt.Errorf("%w: %s", entities.ErrPaymentDeclined, res.GetError())
+	}
+	return nil
+}
+
+// Cancel отменяет платежи по бронированию
+func (c *Client) Cancel(ctx context.Context, bookingID uint64) error {
+	res, err := c.api.CancelPayment(ctx, &generated.BookingInfo{BookingId: bookingID})
+	if err != nil {
+		return fmt.Errorf("[payment.Cancel]: %w", err)
+	}
+	if !res.GetStatus() {
+		return fmt.Errorf("[payment.Cancel]: %s", res.GetError())
+	}
+	return nil
+}
+
+var paymentStatuses = map[generated.PaymentStatus]entities.PaymentStatus{
+	generated.PaymentStatus_PAYMENT_STATUS_AUTHORIZED: entities.PaymentStatusAuthorized,
+	generated.PaymentStatus_PAYMENT_STATUS_SUCCESS:    entities.PaymentStatusSuccess,
+	generated.PaymentStatus_PAYMENT_STATUS_CANCELLED:  entities.PaymentStatusCanceled,
+}
+
+// Payments возвращает платежи по бронированию из платежного сервиса; ID — идентификатор
+// платежа в сервисе. Платежи без суммы в минимальных единицах пересчитываются из
+// устаревшего поля amount в валюте бронирования currency
+func (c *Client) Payments(ctx context.Context, bookingID uint64, currency string) ([]entities.Payment, error) {
+	res, err := c.api.GetPaymentsInfo(ctx, &generated.BookingInfo{BookingId: bookingID})
+	if err != nil {
+		return nil, fmt.Errorf("[payment.Payments]: %w", err)
+	}
+
+	payments := make([]entities.Payment, 0, len(res.GetPayments()))
+	for _, p := range res.GetPayments() {
+		amount := entities.Money{Amount: p.GetAmountMinor(), Currency: p.GetCurrency()}
+		if amount.Currency == "" {
+			amount.Currency = currency
+			amount.Amount = int64(math.Round(p.GetAmount() * math.Pow10(entities.MinorUnitExponent(currency))))
+		}
+		payments = append(payments, entities.Payment{
+			ID:          p.GetId(),
+			CreatedAt:   p.GetCreatedAt().AsTime(),
+			UpdatedAt:   p.GetUpdatedAt().AsTime(),
+			BookingID:   p.GetBookingId(),
+			Amount:      amount,
+			PaymentDate: p.GetCreatedAt().AsTime(),
+			Status:      paymentStatuses[p.GetStatus()],
+		})
+	}
+	return payments, nil
+}
@@ -0,0 +1,236 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+	"booking-service/internal/tracing"
+
+	"github.com/sony/gobreaker"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResilienceConfig таймауты, повторы и размыкатель цепи для вызовов платежного сервиса
+type ResilienceConfig struct {
+	// таймауты одной попытки; 0 — без собственного таймаута
+	ProcessPaymentTimeout  time.Duration
+	CancelPaymentTimeout   time.Duration
+	GetPaymentsInfoTimeout time.Duration
+	// MaxAttempts попыток идемпотентного вызова, включая первую
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// FailureThreshold неудачных вызовов подряд, после которых цепь размыкается
+	FailureThreshold uint32
+	// OpenTimeout сколько цепь остается разомкнутой до пробных вызовов
+	OpenTimeout time.Duration
+	// HalfOpenRequests пробных вызовов в полуразомкнутом состоянии
+	HalfOpenRequests uint32
+}
+
+// Resilient обертка над клиентом платежного сервиса. У каждой попытки свой
+// таймаут; повторяются только идемпотентные вызовы и только при временных
+// ошибках. Если сервис раз за разом не отвечает, цепь размыкается и вызовы сразу
+// завершаются ошибкой entities.ErrPaymentUnavailable вместе с
+// entities.ErrPaymentNotAttempted: запрос в сервис не отправлялся. Списание не
+// повторяется: у ProcessPayment нет ключа идемпотентности, и повтор после
+// таймаута может списать деньги дважды
+type Resilient struct {
+	api     generated.PaymentServiceClient
+	cfg     ResilienceConfig
+	breaker *gobreaker.CircuitBreaker
+	logger  *slog.Logger
+
+	transitions metric.Int64Counter
+	retries     metric.Int64Counter
+	rejected    metric.Int64Counter
+}
+
+var _ generated.PaymentServiceClient = (*Resilient)(nil)
+
+func NewResilient(api generated.PaymentServiceClient, cfg ResilienceConfig, logger *slog.Logger) (*Resilient, error) {
+	r := &Resilient{api: api, cfg: cfg, logger: logger}
+
+	var err error
+	meter := tracing.Meter()
+	if r.transitions, err = meter.Int64Counter("payment_client.breaker.transitions",
+		metric.WithDescription("Circuit breaker state transitions of the payment client")); err != nil {
+		return nil, fmt.Errorf("[payment.NewResilient]: %w", err)
+	}
+	if r.retries, err = meter.Int64Counter("payment_client.retries",
+		metric.WithDescription("Retried payment service calls")); err != nil {
+		return nil, fmt.Errorf("[payment.NewResilient]: %w", err)
+	}
+	if r.rejected, err = meter.Int64Counter("payment_client.rejected",
+		metric.WithDescription("Payment service calls rejected by the open circuit breaker")); err != nil {
+		return nil, fmt.Errorf("[payment.NewResilient]: %w", err)
+	}
+
+	r.breaker = gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:        "payment_service",
+		MaxRequests: max(cfg.HalfOpenRequests, 1),
+		Timeout:     cfg.OpenTimeout,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= max(cfg.FailureThreshold, 1)
+		},
+		IsSuccessful:  isHealthy,
+		OnStateChange: r.onStateChange,
+	})
+	return r, nil
+}
+
+func (r *Resilient) ProcessPayment(
+	ctx context.Context, in *generated.ProcessRequest, opts ...grpc.CallOption,
+) (*generated.ProcessResponse, error) {
+	return invoke(ctx, r, "ProcessPayment", false, r.cfg.ProcessPaymentTimeout,
+		func(ctx context.Context) (*generated.ProcessResponse, error) {
+			return r.api.ProcessPayment(ctx, in, opts...)
+		})
+}
+
+// CancelPayment идемпотентен: повторная отмена отмененного платежа ничего не меняет
+func (r *Resilient) CancelPayment(
+	ctx context.Context, in *generated.BookingInfo, opts ...grpc.CallOption,
+) (*generated.ProcessResponse, error) {
+	return invoke(ctx, r, "CancelPayment", true, r.cfg.CancelPaymentTimeout,
+		func(ctx context.Context) (*generated.ProcessResponse, error) {
+			return r.api.CancelPayment(ctx, in, opts...)
+		})
+}
+
+func (r *Resilient) GetPaymentsInfo(
+	ctx context.Context, in *generated.BookingInfo, opts ...grpc.CallOption,
+) (*generated.PaymentsResponse, error) {
+	return invoke(ctx, r, "GetPaymentsInfo", true, r.cfg.GetPaymentsInfoTimeout,
+		func(ctx context.Context) (*generated.PaymentsResponse, error) {
+			return r.api.GetPaymentsInfo(ctx, in, opts...)
+		})
+}
+
+func invoke[T any](
+	ctx context.Context, r *Resilient, method string, idempotent bool, timeout time.Duration,
+	call func(ctx context.Context) (T, error),
+) (T, error) {
+	attempts := 1
+	if idempotent {
+		attempts = max(r.cfg.MaxAttempts, 1)
+	}
+	methodAttr := metric.WithAttributes(attribute.String("method", method))
+
+	for attempt := 1; ; attempt++ {
+		res, err := r.breaker.Execute(func() (any, error) {
+			callCtx := ctx
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				callCtx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			res, err := call(callCtx)
+			if err != nil && ctx.Err() != nil {
+				return res, callerDoneError{err}
+			}
+			return res, err
+		})
+		if done := (callerDoneError{}); errors.As(err, &done) {
+			err = done.err
+		}
+		if err == nil {
+			return res.(T), nil
+		}
+
+		var zero T
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			r.rejected.Add(ctx, 1, methodAttr)
+			return zero, fmt.Errorf("%w: %w: circuit breaker is %s",
+				entities.ErrPaymentUnavailable, entities.ErrPaymentNotAttempted, r.breaker.State())
+		}
+		if attempt >= attempts || !isRetryable(err) || ctx.Err() != nil {
+			if status.Code(err) == codes.Unavailable {
+				return zero, fmt.Errorf("%w: %w", entities.ErrPaymentUnavailable, err)
+			}
+			return zero, err
+		}
+
+		r.retries.Add(ctx, 1, methodAttr)
+		r.logger.DebugContext(ctx, "retrying payment service call",
+			"method", method, "attempt", attempt, "error", err)
+		if err = sleep(ctx, r.backoff(attempt)); err != nil {
+			return zero, err
+		}
+	}
+}
+
+// backoff экспоненциальная задержка перед попыткой attempt+1 со случайным
+// разбросом в пределах второй половины, чтобы реплики не повторяли синхронно
+func (r *Resilient) backoff(attempt int) time.Duration {
+	d := r.cfg.InitialBackoff << (attempt - 1)
+	if d <= 0 || (r.cfg.MaxBackoff > 0 && d > r.cfg.MaxBackoff) {
+		d = r.cfg.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+func (r *Resilient) onStateChange(name string, from, to gobreaker.State) {
+	r.transitions.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("breaker", name),
+		attribute.String("from", from.String()),
+		attribute.String("to", to.String()),
+	))
+	r.logger.Warn("payment circuit breaker state changed", "breaker", name, "from", from.String(), "to", to.String())
+}
+
+// isRetryable временные ошибки, после которых вызов может пройти
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+// callerDoneError ошибка вызова, который отменил вызывающий или у которого истек
+// срок вызывающего. Платежный сервис в ней не виноват, и цепь она не размыкает
+type callerDoneError struct{ err error }
+
+func (e callerDoneError) Error() string { return e.err.Error() }
+func (e callerDoneError) Unwrap() error { return e.err }
+
+// isHealthy ошибки, которые не говорят о неисправности платежного сервиса
+// (неверный запрос, отмена вызывающим), не размыкают цепь
+func isHealthy(err error) bool {
+	if errors.As(err, &callerDoneError{}) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return false
+	}
+	return true
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,259 @@
+package payment
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"booking-service/internal/entities"
+	"booking-service/internal/generated"
+
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// flakyPaymentServer отвечает кодами из расписания: i-й вызов метода получает
+// i-й код, после конца расписания вызовы успешны
+type flakyPaymentServer struct {
+	generated.UnimplementedPaymentServiceServer
+
+	mu       sync.Mutex
+	schedule map[string][]codes.Code
+	delay    map[string]time.Duration
+	calls    map[string]int
+}
+
+func newFlakyPaymentServer() *flakyPaymentServer {
+	return &flakyPaymentServer{
+		schedule: map[string][]codes.Code{},
+		delay:    map[string]time.Duration{},
+		calls:    map[string]int{},
+	}
+}
+
+func (s *flakyPaymentServer) fail(method string, codes ...codes.Code) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.schedule[method] = append(s.schedule[method], codes...)
+}
+
+func (s *flakyPaymentServer) slow(method string, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.delay[method] = d
+}
+
+func (s *flakyPaymentServer) callCount(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+func (s *flakyPaymentServer) next(ctx context.Context, method string) error {
+	s.mu.Lock()
+	n := s.calls[method]
+	s.calls[method]++
+	delay := s.delay[method]
+	code := codes.OK
+	if n < len(s.schedule[method]) {
+		code = s.schedule[method][n]
+	}
+	s.mu.Unlock()
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	if code != codes.OK {
+		return status.Error(code, "scheduled failure")
+	}
+	return nil
+}
+
+func (s *flakyPaymentServer) ProcessPayment(ctx context.Context, _ *generated.ProcessRequest) (*generated.ProcessResponse, error) {
+	if err := s.next(ctx, "ProcessPayment"); err != nil {
+		return nil, err
+	}
+	return &generated.ProcessResponse{Status: true}, nil
+}
+
+func (s *flakyPaymentServer) CancelPayment(ctx context.Context, _ *generated.BookingInfo) (*generated.ProcessResponse, error) {
+	if err := s.next(ctx, "CancelPayment"); err != nil {
+		return nil, err
+	}
+	return &generated.ProcessResponse{Status: true}, nil
+}
+
+func (s *flakyPaymentServer) GetPaymentsInfo(ctx context.Context, _ *generated.BookingInfo) (*generated.PaymentsResponse, error) {
+	if err := s.next(ctx, "GetPaymentsInfo"); err != nil {
+		return nil, err
+	}
+	return &generated.PaymentsResponse{}, nil
+}
+
+// testConfig без таймаутов и задержек между попытками; цепь размыкается не раньше
+// десятого отказа подряд
+func testConfig() ResilienceConfig {
+	return ResilienceConfig{
+		MaxAttempts:      3,
+		FailureThreshold: 10,
+		OpenTimeout:      time.Minute,
+		HalfOpenRequests: 1,
+	}
+}
+
+func newTestResilient(t *testing.T, cfg ResilienceConfig) (*Resilient, *flakyPaymentServer) {
+	t.Helper()
+
+	srv := newFlakyPaymentServer()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	generated.RegisterPaymentServiceServer(s, srv)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	r, err := NewResilient(generated.NewPaymentServiceClient(conn), cfg, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, err)
+	return r, srv
+}
+
+func TestResilientDoesNotRetryProcessPayment(t *testing.T) {
+	r, srv := newTestResilient(t, testConfig())
+	srv.fail("ProcessPayment", codes.Unavailable, codes.Unavailable)
+
+	_, err := r.ProcessPayment(context.Background(), &generated.ProcessRequest{BookingId: 1})
+	require.ErrorIs(t, err, entities.ErrPaymentUnavailable)
+	// запрос ушел в сервис: исход списания неизвестен
+	assert.NotErrorIs(t, err, entities.ErrPaymentNotAttempted)
+	assert.Equal(t, 1, srv.callCount("ProcessPayment"))
+}
+
+func TestResilientRetriesIdempotentCalls(t *testing.T) {
+	calls := map[string]func(r *Resilient) error{
+		"CancelPayment": func(r *Resilient) error {
+			_, err := r.CancelPayment(context.Background(), &generated.BookingInfo{BookingId: 1})
+			return err
+		},
+		"GetPaymentsInfo": func(r *Resilient) error {
+			_, err := r.GetPaymentsInfo(context.Background(), &generated.BookingInfo{BookingId: 1})
+			return err
+		},
+	}
+
+	for method, call := range calls {
+		t.Run(method+"/retryable", func(t *testing.T) {
+			r, srv := newTestResilient(t, testConfig())
+			srv.fail(method, codes.Unavailable, codes.ResourceExhausted)
+
+			require.NoError(t, call(r))
+			assert.Equal(t, 3, srv.callCount(method))
+		})
+		t.Run(method+"/not retryable", func(t *testing.T) {
+			r, srv := newTestResilient(t, testConfig())
+			srv.fail(method, codes.InvalidArgument)
+
+			err := call(r)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Equal(t, 1, srv.callCount(method))
+		})
+		t.Run(method+"/attempts exhausted", func(t *testing.T) {
+			r, srv := newTestResilient(t, testConfig())
+			srv.fail(method, codes.Unavailable, codes.Unavailable, codes.Unavailable, codes.Unavailable)
+
+			require.ErrorIs(t, call(r), entities.ErrPaymentUnavailable)
+			assert.Equal(t, 3, srv.callCount(method))
+		})
+	}
+}
+
+func TestResilientPerMethodTimeouts(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxAttempts = 1
+	cfg.CancelPaymentTimeout = time.Second
+	cfg.GetPaymentsInfoTimeout = 20 * time.Millisecond
+	r, srv := newTestResilient(t, cfg)
+	srv.slow("CancelPayment", 100*time.Millisecond)
+	srv.slow("GetPaymentsInfo", 100*time.Millisecond)
+
+	_, err := r.CancelPayment(context.Background(), &generated.BookingInfo{BookingId: 1})
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = r.GetPaymentsInfo(context.Background(), &generated.BookingInfo{BookingId: 1})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestResilientBreakerOpensAndHalfOpens(t *testing.T) {
+	cfg := testConfig()
+	cfg.MaxAttempts = 1
+	cfg.FailureThreshold = 2
+	cfg.OpenTimeout = 50 * time.Millisecond
+	r, srv := newTestResilient(t, cfg)
+	srv.fail("GetPaymentsInfo", codes.Unavailable, codes.Internal)
+	ctx := context.Background()
+
+	for range 2 {
+		_, err := r.GetPaymentsInfo(ctx, &generated.BookingInfo{BookingId: 1})
+		require.Error(t, err)
+	}
+	require.Equal(t, gobreaker.StateOpen, r.breaker.State())
+
+	// разомкнутая цепь отклоняет вызовы, не обращаясь к сервису
+	_, err := r.ProcessPayment(ctx, &generated.ProcessRequest{BookingId: 1})
+	require.ErrorIs(t, err, entities.ErrPaymentUnavailable)
+	require.ErrorIs(t, err, entities.ErrPaymentNotAttempted)
+	assert.Equal(t, 0, srv.callCount("ProcessPayment"))
+
+	time.Sleep(cfg.OpenTimeout + 10*time.Millisecond)
+	require.Equal(t, gobreaker.StateHalfOpen, r.breaker.State())
+
+	// успешный пробный вызов замыкает цепь
+	_, err = r.GetPaymentsInfo(ctx, &generated.BookingInfo{BookingId: 1})
+	require.NoError(t, err)
+	assert.Equal(t, gobreaker.StateClosed, r.breaker.State())
+	assert.Equal(t, 3, srv.callCount("GetPaymentsInfo"))
+}
+
+func TestResilientBreakerIgnoresCallerCancellation(t *testing.T) {
+	cfg := testConfig()
+	cfg.FailureThreshold = 1
+	r, srv := newTestResilient(t, cfg)
+	srv.slow("GetPaymentsInfo", 100*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := r.GetPaymentsInfo(ctx, &generated.BookingInfo{BookingId: 1})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = r.CancelPayment(ctx, &generated.BookingInfo{BookingId: 1})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+
+	assert.Equal(t, gobreaker.StateClosed, r.breaker.State())
+	// после отмены вызывающим попытки не повторяются
+	assert.Equal(t, 1, srv.callCount("GetPaymentsInfo"))
+}
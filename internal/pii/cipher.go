@@ -0,0 +1,85 @@
+// Package pii шифрует персональные данные гостей перед записью в БД
+package pii
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+// KeySize длина ключа AES-256
+const KeySize = 32
+
+// version первый байт шифротекста; позволит сменить алгоритм или ключ, не
+// перешифровывая старые записи разом
+const version byte = 1
+
+var errMalformed = errors.New("[pii.Decrypt]: malformed ciphertext")
+
+// Cipher шифрует значения AES-256-GCM со случайным nonce: одинаковые значения
+// дают разные шифротексты. Для поиска по точному совпадению используется
+// BlindIndex — HMAC значения на ключе, производном от ключа шифрования
+type Cipher struct {
+	aead     cipher.AEAD
+	indexKey []byte
+}
+
+func NewCipher(key []byte) (*Cipher, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("[pii.NewCipher]: key must be %d bytes, got %d", KeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("[pii.NewCipher]: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("[pii.NewCipher]: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("booking-service/pii/blind-index"))
+	return &Cipher{aead: aead, indexKey: mac.Sum(nil)}, nil
+}
+
+// Encrypt возвращает version || nonce || шифротекст; пустая строка остается пустой
+func (c *Cipher) Encrypt(plain string) ([]byte, error) {
+	if plain == "" {
+		return nil, nil
+	}
+	out := make([]byte, 1+c.aead.NonceSize(), 1+c.aead.NonceSize()+len(plain)+c.aead.Overhead())
+	out[0] = version
+	if _, err := rand.Read(out[1:]); err != nil {
+		return nil, fmt.Errorf("[pii.Encrypt]: %w", err)
+	}
+	return c.aead.Seal(out, out[1:], []byte(plain), []byte{version}), nil
+}
+
+func (c *Cipher) Decrypt(data []byte) (string, error) {
+	if len(data) == 0 {
+		return "", nil
+	}
+	if data[0] != version || len(data) < 1+c.aead.NonceSize() {
+		return "", errMalformed
+	}
+	nonce, sealed := data[1:1+c.aead.NonceSize()], data[1+c.aead.NonceSize():]
+	plain, err := c.aead.Open(nil, nonce, sealed, data[:1])
+	if err != nil {
+		return "", fmt.Errorf("[pii.Decrypt]: %w", err)
+	}
+	return string(plain), nil
+}
+
+// BlindIndex детерминированный отпечаток значения для поиска; пустая строка — nil
+func (c *Cipher) BlindIndex(value string) []byte {
+	if value == "" {
+		return nil
+	}
+	mac := hmac.New(sha256.New, c.indexKey)
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,48 @@
+package pricing
+
+import (
+	"time"
+
+	"booking-service/internal/entities"
+)
+
+// ValidateDepositRule проверяет правило предоплаты
+func ValidateDepositRule(rule entities.DepositRule) error {
+	switch rule.Kind {
+	case entities.DepositKindPercent:
+		if rule.PercentBasisPoints <= 0 || rule.PercentBasisPoints > 10000 {
+			return entities.ErrInvalidDepositPercent
+		}
+	case entities.DepositKindFirstNight:
+		if rule.PercentBasisPoints != 0 {
+			return entities.ErrInvalidDepositPercent
+		}
+	default:
+		return entities.ErrInvalidDepositKind
+	}
+	if rule.BalanceDaysBefore < 0 {
+		return entities.ErrInvalidBalanceDays
+	}
+	return nil
+}
+
+// Deposit считает депозит по правилу; он не больше полной стоимости
+func Deposit(rule entities.DepositRule, price entities.PriceSnapshot) entities.Money {
+	total := price.Total
+	deposit := entities.Money{Currency: total.Currency}
+	switch rule.Kind {
+	case entities.DepositKindPercent:
+		deposit.Amount = basisPointsOf(total.Amount, rule.PercentBasisPoints)
+	case entities.DepositKindFirstNight:
+		if len(price.Nights) > 0 {
+			deposit.Amount = price.Nights[0].Price.Amount
+		}
+	}
+	deposit.Amount = min(deposit.Amount, total.Amount)
+	return deposit
+}
+
+// BalanceDueAt срок списания остатка: начало дня за BalanceDaysBefore дней до заезда
+func BalanceDueAt(rule entities.DepositRule, start time.Time) time.Time {
+	return Date(start).AddDate(0, 0, -rule.BalanceDaysBefore)
+}
@@ -0,0 +1,157 @@
+package pricing
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"booking-service/internal/entities"
+)
+
+// точность курса совпадает с колонкой exchange_rates.rate
+const (
+	maxRateIntegerDigits  = 12
+	maxRateFractionDigits = 12
+)
+
+// ValidateExchangeRate проверяет курс до сохранения
+func ValidateExchangeRate(r entities.ExchangeRate) error {
+	if !entities.IsValidCurrency(r.Base) || !entities.IsValidCurrency(r.Quote) {
+		return entities.ErrInvalidCurrency
+	}
+	if r.Base == r.Quote {
+		return entities.ErrInvalidExchangeRate
+	}
+	if _, ok := parseRate(r.Rate); !ok {
+		return entities.ErrInvalidExchangeRate
+	}
+	return nil
+}
+
+// parseRate разбирает положительную десятичную дробь вида 12.345
+func parseRate(rate string) (*big.Rat, bool) {
+	integer, fraction, _ := strings.Cut(rate, ".")
+	if integer == "" || len(integer) > maxRateIntegerDigits || len(fraction) > maxRateFractionDigits {
+		return nil, false
+	}
+	for _, r := range integer + fraction {
+		if r < '0' || r > '9' {
+			return nil, false
+		}
+	}
+	v, ok := new(big.Rat).SetString(rate)
+	if !ok || v.Sign() <= 0 {
+		return nil, false
+	}
+	return v, true
+}
+
+// ParseExchangeRatesCSV читает курсы из CSV со строками base,quote,rate,
+// например "EUR,RUB,98.15". Первая строка может быть заголовком
+func ParseExchangeRatesCSV(data string) ([]entities.ExchangeRate, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	var res []entities.ExchangeRate
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", entities.ErrInvalidExchangeRate, err)
+		}
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "base") {
+			continue
+		}
+		rate := entities.ExchangeRate{
+			Base:  strings.ToUpper(strings.TrimSpace(record[0])),
+			Quote: strings.ToUpper(strings.TrimSpace(record[1])),
+			Rate:  strings.TrimSpace(record[2]),
+		}
+		if err = ValidateExchangeRate(rate); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		res = append(res, rate)
+	}
+	return res, nil
+}
+
+// ConvertPrice пересчитывает цену в валюту to. Курс ищется среди rates в прямом
+// направлении, иначе берется обратный. Части цены пересчитываются отдельно, а
+// итог — их сумма, чтобы пересчитанная цена сходилась
+func ConvertPrice(
+	price entities.PriceSnapshot, to string, rates []entities.ExchangeRate,
+) (entities.ConvertedPrice, error) {
+	rate, value, err := findRate(rates, price.Total.Currency, to)
+	if err != nil {
+		return entities.ConvertedPrice{}, err
+	}
+
+	res := entities.ConvertedPrice{
+		Subtotal: convert(price.Subtotal, to, value),
+		Taxes:    convert(price.Taxes, to, value),
+		Fees:     convert(price.Fees, to, value),
+		Rate:     rate,
+	}
+	res.Total = entities.Money{
+		Amount:   res.Subtotal.Amount + res.Taxes.Amount + res.Fees.Amount,
+		Currency: to,
+	}
+	return res, nil
+}
+
+func findRate(rates []entities.ExchangeRate, from, to string) (entities.ExchangeRate, *big.Rat, error) {
+	for _, r := range rates {
+		if r.Base == from && r.Quote == to {
+			if v, ok := parseRate(r.Rate); ok {
+				return r, v, nil
+			}
+		}
+	}
+	for _, r := range rates {
+		if r.Base == to && r.Quote == from {
+			if v, ok := parseRate(r.Rate); ok {
+				v.Inv(v)
+				return entities.ExchangeRate{
+					Base:      from,
+					Quote:     to,
+					Rate:      strings.TrimRight(strings.TrimRight(v.FloatString(maxRateFractionDigits), "0"), "."),
+					UpdatedAt: r.UpdatedAt,
+				}, v, nil
+			}
+		}
+	}
+	return entities.ExchangeRate{}, nil, fmt.Errorf("%w: %s/%s", entities.ErrNoExchangeRate, from, to)
+}
+
+// convert пересчитывает сумму с учетом числа знаков после запятой в валютах
+// и округляет до минимальной единицы валюты to (половина — от нуля)
+func convert(m entities.Money, to string, rate *big.Rat) entities.Money {
+	v := new(big.Rat).SetInt64(m.Amount)
+	v.Mul(v, rate)
+	scale := entities.MinorUnitExponent(to) - entities.MinorUnitExponent(m.Currency)
+	pow := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(scale))), nil))
+	if scale >= 0 {
+		v.Mul(v, pow)
+	} else {
+		v.Quo(v, pow)
+	}
+
+	q, r := new(big.Int).QuoRem(v.Num(), v.Denom(), new(big.Int))
+	if r.Sign() != 0 && new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2)).Cmp(v.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(v.Sign())))
+	}
+	return entities.Money{Amount: q.Int64(), Currency: to}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,56 @@
+package pricing
+
+import (
+	"unicode/utf8"
+
+	"booking-service/internal/entities"
+)
+
+// длина колонки folio_charges.description
+const maxChargeDescriptionLength = 255
+
+// PriceFolioCharge проверяет начисление и считает его суммы. Налог
+// округляется от суммы строки, а не от цены единицы
+func PriceFolioCharge(ch entities.FolioCharge) (entities.FolioCharge, error) {
+	switch {
+	case !ch.Category.IsValid():
+		return entities.FolioCharge{}, entities.ErrInvalidChargeCategory
+	case ch.Quantity <= 0:
+		return entities.FolioCharge{}, entities.ErrInvalidQuantity
+	case ch.UnitPrice.Amount <= 0:
+		return entities.FolioCharge{}, entities.ErrInvalidPrice
+	case !entities.IsValidCurrency(ch.UnitPrice.Currency):
+		return entities.FolioCharge{}, entities.ErrInvalidCurrency
+	case ch.TaxBasisPoints < 0 || ch.TaxBasisPoints > 10000:
+		return entities.FolioCharge{}, entities.ErrInvalidTaxPercent
+	case utf8.RuneCountInString(ch.Description) > maxChargeDescriptionLength:
+		return entities.FolioCharge{}, entities.ErrDescriptionTooLong
+	}
+
+	currency := ch.UnitPrice.Currency
+	net := ch.UnitPrice.Amount * int64(ch.Quantity)
+	tax := basisPointsOf(net, ch.TaxBasisPoints)
+	ch.Net = entities.Money{Amount: net, Currency: currency}
+	ch.Tax = entities.Money{Amount: tax, Currency: currency}
+	ch.Total = entities.Money{Amount: net + tax, Currency: currency}
+	return ch, nil
+}
+
+// FolioTotals считает итоги счета: сторнированные начисления и неуспешные
+// оплаты не учитываются
+func FolioTotals(f entities.Folio) entities.Folio {
+	f.Charged = entities.Money{Currency: f.Currency}
+	f.Paid = entities.Money{Currency: f.Currency}
+	for _, ch := range f.Charges {
+		if ch.VoidedAt.IsZero() {
+			f.Charged.Amount += ch.Total.Amount
+		}
+	}
+	for _, p := range f.Payments {
+		if p.Status == entities.PaymentStatusSuccess {
+			f.Paid.Amount += p.Amount.Amount
+		}
+	}
+	f.Balance = entities.Money{Amount: f.Charged.Amount - f.Paid.Amount, Currency: f.Currency}
+	return f
+}
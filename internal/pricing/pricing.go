@@ -0,0 +1,178 @@
+// Package pricing рассчитывает стоимость проживания по ночам
+package pricing
+
+import (
+	"fmt"
+	"time"
+
+	"booking-service/internal/entities"
+)
+
+const day = 24 * time.Hour
+
+// Nights возвращает даты ночей проживания: от даты заезда до дня перед выездом
+func Nights(start, end time.Time) []time.Time {
+	start, end = Date(start), Date(end)
+	nights := make([]time.Time, 0, int(end.Sub(start)/day))
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		nights = append(nights, d)
+	}
+	return nights
+}
+
+// Date отбрасывает время: ночь определяется календарной датой в UTC
+func Date(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Rates исходные данные для расчета цен типа номера
+type Rates struct {
+	Plans     []entities.RatePlan
+	Overrides []entities.RateOverride
+	// Rule правило ценообразования; nil — цена ночи равна базовой
+	Rule      *entities.PricingRule
+	Occupancy entities.Occupancy
+}
+
+// Calendar возвращает действующую цену каждой ночи [start, end). Базовая цена
+// берется из календаря, иначе из тарифа; если ночь покрывают несколько тарифов,
+// действует начавшийся позже — так сезонный тариф перекрывает базовый. Затем
+// базовую цену корректирует правило ценообразования
+func Calendar(rates Rates, start, end time.Time) ([]entities.CalendarNight, error) {
+	var strategy Strategy
+	if rates.Rule != nil {
+		var err error
+		if strategy, err = NewStrategy(*rates.Rule); err != nil {
+			return nil, err
+		}
+	}
+
+	byDate := make(map[time.Time]entities.RateOverride, len(rates.Overrides))
+	for _, o := range rates.Overrides {
+		byDate[Date(o.Date)] = o
+	}
+
+	nights := Nights(start, end)
+	res := make([]entities.CalendarNight, 0, len(nights))
+	for _, night := range nights {
+		n := entities.CalendarNight{Date: night, OccupancyPercent: occupancyPercent(rates.Occupancy, night)}
+		if o, ok := byDate[night]; ok {
+			n.BasePrice, n.Override = o.Price, true
+		} else if plan, ok := planForNight(rates.Plans, night); ok {
+			n.BasePrice, n.RatePlanID = plan.NightlyPrice, plan.ID
+		}
+		n.Price = n.BasePrice
+
+		if strategy != nil && n.BasePrice.Amount > 0 {
+			var adjustments []entities.PriceAdjustment
+			n.Price, adjustments = strategy.Adjust(NightContext{
+				Date:             night,
+				Base:             n.BasePrice,
+				OccupancyPercent: n.OccupancyPercent,
+			})
+			n.Adjustments = append(n.Adjustments, adjustments...)
+			n.Price, adjustments = guard(n.Price, *rates.Rule)
+			n.Adjustments = append(n.Adjustments, adjustments...)
+		}
+		res = append(res, n)
+	}
+	return res, nil
+}
+
+func occupancyPercent(o entities.Occupancy, night time.Time) int {
+	if o.Rooms == 0 {
+		return 0
+	}
+	return o.Booked[night] * 100 / o.Rooms
+}
+
+// PriceStay считает стоимость проживания по календарю цен
+func PriceStay(rates Rates, start, end time.Time) (entities.PriceSnapshot, error) {
+	nights, err := Calendar(rates, start, end)
+	if err != nil {
+		return entities.PriceSnapshot{}, err
+	}
+	if len(nights) == 0 {
+		return entities.PriceSnapshot{}, entities.ErrEmptyStay
+	}
+
+	res := entities.PriceSnapshot{
+		Nights: make([]entities.NightPrice, 0, len(nights)),
+	}
+	for _, night := range nights {
+		if night.Price.Amount == 0 {
+			return entities.PriceSnapshot{}, fmt.Errorf("%w: %s", entities.ErrNoRateForNight, night.Date.Format(time.DateOnly))
+		}
+		if res.Total.Currency == "" {
+			res.Total.Currency = night.Price.Currency
+		}
+		if night.Price.Currency != res.Total.Currency {
+			return entities.PriceSnapshot{}, entities.ErrCurrencyMismatch
+		}
+
+		res.Nights = append(res.Nights, entities.NightPrice{
+			Date:        night.Date,
+			Price:       night.Price,
+			RatePlanID:  night.RatePlanID,
+			Override:    night.Override,
+			BasePrice:   night.BasePrice,
+			Adjustments: night.Adjustments,
+		})
+		res.Subtotal.Amount += night.Price.Amount
+	}
+	res.Subtotal.Currency = res.Total.Currency
+	res.Taxes.Currency, res.Fees.Currency = res.Total.Currency, res.Total.Currency
+	res.Total.Amount = res.Subtotal.Amount
+
+	return res, nil
+}
+
+// Cancellation считает условия отмены по политике отеля: бесплатно до
+// FreeCancellationHours часов до заезда, позже — цена первых
+// CancellationPenaltyNights ночей
+func Cancellation(hotel entities.Hotel, price entities.PriceSnapshot, start time.Time) entities.CancellationTerms {
+	terms := entities.CancellationTerms{
+		FreeUntil: Date(start).Add(-time.Duration(hotel.FreeCancellationHours) * time.Hour),
+		Penalty:   entities.Money{Currency: price.Total.Currency},
+	}
+	for i, n := range price.Nights {
+		if i >= hotel.CancellationPenaltyNights {
+			break
+		}
+		terms.Penalty.Amount += n.Price.Amount
+	}
+	return terms
+}
+
+// ExpandRange возвращает даты диапазона с учётом дней недели
+func ExpandRange(r entities.RateRange) []time.Time {
+	weekdays := make(map[time.Weekday]struct{}, len(r.Weekdays))
+	for _, w := range r.Weekdays {
+		weekdays[w] = struct{}{}
+	}
+
+	var res []time.Time
+	for _, d := range Nights(r.StartDate, Date(r.EndDate).AddDate(0, 0, 1)) {
+		if _, ok := weekdays[d.Weekday()]; len(weekdays) == 0 || ok {
+			res = append(res, d)
+		}
+	}
+	return res
+}
+
+func planForNight(plans []entities.RatePlan, night time.Time) (entities.RatePlan, bool) {
+	var (
+		best  entities.RatePlan
+		found bool
+	)
+	for _, p := range plans {
+		if night.Before(Date(p.ValidFrom)) || !p.ValidTo.IsZero() && night.After(Date(p.ValidTo)) {
+			continue
+		}
+		if !found || p.ValidFrom.After(best.ValidFrom) || p.ValidFrom.Equal(best.ValidFrom) && p.ID > best.ID {
+			best, found = p, true
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,156 @@
+package pricing
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"booking-service/internal/entities"
+)
+
+// NormalizePromoCode приводит код к виду, в котором он хранится: промокоды
+// не зависят от регистра
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// ValidatePromotion проверяет промокод до сохранения
+func ValidatePromotion(p entities.Promotion) error {
+	if !isValidPromoCode(p.Code) {
+		return entities.ErrInvalidPromoCode
+	}
+	for _, t := range p.RoomTypes {
+		if t == "" {
+			return entities.ErrInvalidRoomType
+		}
+	}
+	switch p.DiscountType {
+	case entities.DiscountTypePercent:
+		if p.PercentOff <= 0 || p.PercentOff > 100 {
+			return entities.ErrInvalidDiscount
+		}
+	case entities.DiscountTypeFixed:
+		if p.AmountOff.Amount <= 0 {
+			return entities.ErrInvalidDiscount
+		}
+		if !entities.IsValidCurrency(p.AmountOff.Currency) {
+			return entities.ErrInvalidCurrency
+		}
+	default:
+		return entities.ErrInvalidDiscount
+	}
+	if !p.StayFrom.IsZero() && !p.StayTo.IsZero() && Date(p.StayFrom).After(Date(p.StayTo)) {
+		return entities.ErrInvalidValidityPeriod
+	}
+	if !p.BookFrom.IsZero() && !p.BookTo.IsZero() && !p.BookFrom.Before(p.BookTo) {
+		return entities.ErrInvalidValidityPeriod
+	}
+	if p.MinNights < 0 {
+		return entities.ErrInvalidMinNights
+	}
+	if p.MaxRedemptions < 0 || p.MaxRedemptions > 0 && p.MaxRedemptions < p.Redemptions {
+		return entities.ErrInvalidMaxRedemptions
+	}
+	return nil
+}
+
+func isValidPromoCode(code string) bool {
+	if len(code) < 3 || len(code) > 64 {
+		return false
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckPromotion проверяет, что промокод действует для проживания [start, end)
+// в номере типа roomType, забронированного в момент bookedAt. Лимит
+// использований здесь не проверяется: он засчитывается при бронировании
+func CheckPromotion(p entities.Promotion, hotelID uint64, roomType string, start, end, bookedAt time.Time) error {
+	notApplicable := func(reason string) error {
+		return fmt.Errorf("%w: %s", entities.ErrPromoCodeNotApplicable, reason)
+	}
+
+	start, end = Date(start), Date(end)
+	switch {
+	case p.HotelID != 0 && p.HotelID != hotelID:
+		return notApplicable("other hotel")
+	case len(p.RoomTypes) > 0 && !slices.Contains(p.RoomTypes, roomType):
+		return notApplicable("room type")
+	case !p.StayFrom.IsZero() && start.Before(Date(p.StayFrom)),
+		!p.StayTo.IsZero() && end.AddDate(0, 0, -1).After(Date(p.StayTo)):
+		return notApplicable("stay dates")
+	case int(end.Sub(start)/day) < p.MinNights:
+		return notApplicable(fmt.Sprintf("at least %d nights required", p.MinNights))
+	case !p.BookFrom.IsZero() && bookedAt.Before(p.BookFrom),
+		!p.BookTo.IsZero() && !bookedAt.Before(p.BookTo):
+		return notApplicable("booking window")
+	}
+	return nil
+}
+
+// ApplyPromotion уменьшает цены ночей на скидку промокода и записывает
+// скидку в цену. Вызывается до начисления налогов, чтобы НДС считался от
+// цены со скидкой. Фиксированная скидка распределяется по ночам
+// пропорционально их цене и не больше стоимости проживания
+func ApplyPromotion(price entities.PriceSnapshot, p entities.Promotion) (entities.PriceSnapshot, error) {
+	currency := price.Subtotal.Currency
+	offs := make([]int64, len(price.Nights))
+	switch p.DiscountType {
+	case entities.DiscountTypePercent:
+		for i, n := range price.Nights {
+			offs[i] = min(percentOf(n.Price.Amount, p.PercentOff), n.Price.Amount)
+		}
+	case entities.DiscountTypeFixed:
+		if p.AmountOff.Currency != currency {
+			return entities.PriceSnapshot{}, fmt.Errorf("%w: currency", entities.ErrPromoCodeNotApplicable)
+		}
+		amount := min(p.AmountOff.Amount, price.Subtotal.Amount)
+		if price.Subtotal.Amount <= 0 {
+			break
+		}
+		rest := amount
+		for i, n := range price.Nights {
+			offs[i] = amount * n.Price.Amount / price.Subtotal.Amount
+			rest -= offs[i]
+		}
+		// остаток от округления — на первые ночи, в которых есть запас
+		for i := 0; rest > 0 && i < len(offs); i++ {
+			if offs[i] < price.Nights[i].Price.Amount {
+				offs[i]++
+				rest--
+			}
+		}
+	default:
+		return entities.PriceSnapshot{}, entities.ErrInvalidDiscount
+	}
+
+	discount := &entities.Discount{
+		PromotionID: p.ID,
+		Code:        p.Code,
+		Amount:      entities.Money{Currency: currency},
+	}
+	nights := make([]entities.NightPrice, len(price.Nights))
+	for i, n := range price.Nights {
+		if offs[i] > 0 {
+			n.Price.Amount -= offs[i]
+			n.Adjustments = append(slices.Clip(n.Adjustments), entities.PriceAdjustment{
+				Rule:        "promo_code",
+				Description: "promo code " + p.Code,
+				Amount:      entities.Money{Amount: -offs[i], Currency: currency},
+			})
+			discount.Amount.Amount += offs[i]
+		}
+		nights[i] = n
+	}
+
+	price.Nights = nights
+	price.Subtotal.Amount -= discount.Amount.Amount
+	price.Total.Amount -= discount.Amount.Amount
+	price.Discount = discount
+	return price, nil
+}
@@ -0,0 +1,88 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"booking-service/internal/entities"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	quoteTokenIssuer   = "booking_service"
+	quoteTokenAudience = "booking_quote"
+)
+
+// QuoteTokens подписывает предложения QuoteStay (HS256). Токен содержит
+// предложение целиком, поэтому CreateBooking не пересчитывает цену и гость
+// платит ровно показанную сумму, даже если тарифы успели измениться.
+type QuoteTokens struct {
+	secret []byte
+	ttl    time.Duration
+	parser *jwt.Parser
+	now    func() time.Time
+}
+
+type quoteClaims struct {
+	jwt.RegisteredClaims
+	Quote entities.Quote `json:"quote"`
+}
+
+func NewQuoteTokens(secret string, ttl time.Duration) (*QuoteTokens, error) {
+	if len(secret) < 32 {
+		return nil, errors.New("[pricing.NewQuoteTokens]: secret must be at least 32 bytes")
+	}
+	t := &QuoteTokens{
+		secret: []byte(secret),
+		ttl:    ttl,
+		now:    time.Now,
+	}
+	t.parser = jwt.NewParser(
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuer(quoteTokenIssuer),
+		jwt.WithAudience(quoteTokenAudience),
+		jwt.WithTimeFunc(func() time.Time { return t.now() }),
+	)
+	return t, nil
+}
+
+// Issue подписывает предложение и проставляет ему срок действия
+func (t *QuoteTokens) Issue(quote entities.Quote) (string, entities.Quote, error) {
+	now := t.now()
+	quote.ExpiresAt = now.Add(t.ttl).Truncate(time.Second)
+	claims := quoteClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    quoteTokenIssuer,
+			Audience:  jwt.ClaimStrings{quoteTokenAudience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(quote.ExpiresAt),
+		},
+		Quote: quote,
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(t.secret)
+	if err != nil {
+		return "", entities.Quote{}, fmt.Errorf("[pricing.QuoteTokens]: sign: %w", err)
+	}
+	return token, quote, nil
+}
+
+// Parse проверяет подпись и срок действия токена
+func (t *QuoteTokens) Parse(token string) (entities.Quote, error) {
+	var claims quoteClaims
+	_, err := t.parser.ParseWithClaims(token, &claims, func(*jwt.Token) (any, error) {
+		return t.secret, nil
+	})
+	switch {
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return entities.Quote{}, entities.ErrQuoteExpired
+	case err != nil:
+		return entities.Quote{}, fmt.Errorf("%w: %w", entities.ErrInvalidQuote, err)
+	}
+
+	quote := claims.Quote
+	quote.ExpiresAt = claims.ExpiresAt.Time
+	return quote, nil
+}
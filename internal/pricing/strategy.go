@@ -0,0 +1,138 @@
+package pricing
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"booking-service/internal/entities"
+)
+
+// NightContext данные ночи, доступные стратегии ценообразования
+type NightContext struct {
+	Date time.Time
+	Base entities.Money
+	// OccupancyPercent доля занятых номеров типа в эту ночь, 0–100
+	OccupancyPercent int
+}
+
+// Strategy корректирует базовую цену ночи и объясняет корректировки
+type Strategy interface {
+	Adjust(night NightContext) (entities.Money, []entities.PriceAdjustment)
+}
+
+// strategies фабрики стратегий по имени; новая стратегия регистрируется здесь
+var strategies = map[entities.PricingStrategy]func(rule entities.PricingRule) Strategy{
+	entities.PricingStrategyFixed: func(entities.PricingRule) Strategy {
+		return fixed{}
+	},
+	entities.PricingStrategyOccupancy: func(rule entities.PricingRule) Strategy {
+		return newOccupancyTiers(rule.OccupancyTiers)
+	},
+}
+
+// NewStrategy возвращает стратегию правила
+func NewStrategy(rule entities.PricingRule) (Strategy, error) {
+	factory, ok := strategies[rule.Strategy]
+	if !ok {
+		return nil, entities.ErrInvalidStrategy
+	}
+	return factory(rule), nil
+}
+
+// ValidateRule проверяет правило до сохранения
+func ValidateRule(rule entities.PricingRule) error {
+	if rule.RoomType == "" {
+		return entities.ErrInvalidRoomType
+	}
+	if _, ok := strategies[rule.Strategy]; !ok {
+		return entities.ErrInvalidStrategy
+	}
+	for _, t := range rule.OccupancyTiers {
+		if t.MinOccupancyPercent < 0 || t.MinOccupancyPercent > 100 || t.AdjustmentPercent <= -100 {
+			return entities.ErrInvalidOccupancyTier
+		}
+	}
+	for _, m := range []entities.Money{rule.MinPrice, rule.MaxPrice} {
+		if m.Amount < 0 {
+			return entities.ErrInvalidPrice
+		}
+		if m.Amount > 0 && !entities.IsValidCurrency(m.Currency) {
+			return entities.ErrInvalidCurrency
+		}
+	}
+	if rule.MinPrice.Amount > 0 && rule.MaxPrice.Amount > 0 &&
+		(rule.MinPrice.Currency != rule.MaxPrice.Currency || rule.MinPrice.Amount > rule.MaxPrice.Amount) {
+		return entities.ErrInvalidPriceGuard
+	}
+	return nil
+}
+
+type fixed struct{}
+
+func (fixed) Adjust(night NightContext) (entities.Money, []entities.PriceAdjustment) {
+	return night.Base, nil
+}
+
+// occupancyTiers применяет уровень с наибольшим порогом, не превышающим загрузку
+type occupancyTiers struct {
+	tiers []entities.OccupancyTier
+}
+
+func newOccupancyTiers(tiers []entities.OccupancyTier) occupancyTiers {
+	sorted := append([]entities.OccupancyTier(nil), tiers...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].MinOccupancyPercent > sorted[j].MinOccupancyPercent
+	})
+	return occupancyTiers{tiers: sorted}
+}
+
+func (s occupancyTiers) Adjust(night NightContext) (entities.Money, []entities.PriceAdjustment) {
+	for _, t := range s.tiers {
+		if night.OccupancyPercent < t.MinOccupancyPercent {
+			continue
+		}
+		if t.AdjustmentPercent == 0 {
+			return night.Base, nil
+		}
+		delta := percentOf(night.Base.Amount, t.AdjustmentPercent)
+		price := entities.Money{Amount: night.Base.Amount + delta, Currency: night.Base.Currency}
+		return price, []entities.PriceAdjustment{{
+			Rule: string(entities.PricingStrategyOccupancy),
+			Description: fmt.Sprintf("%+d%% at %d%% occupancy (tier from %d%%)",
+				t.AdjustmentPercent, night.OccupancyPercent, t.MinOccupancyPercent),
+			Amount: entities.Money{Amount: delta, Currency: night.Base.Currency},
+		}}
+	}
+	return night.Base, nil
+}
+
+// guard ограничивает цену снизу и сверху; ограничение в другой валюте не применяется
+func guard(price entities.Money, rule entities.PricingRule) (entities.Money, []entities.PriceAdjustment) {
+	var adjustments []entities.PriceAdjustment
+	limit := func(to entities.Money, name, description string) {
+		adjustments = append(adjustments, entities.PriceAdjustment{
+			Rule:        name,
+			Description: description,
+			Amount:      entities.Money{Amount: to.Amount - price.Amount, Currency: price.Currency},
+		})
+		price = to
+	}
+
+	if m := rule.MinPrice; m.Amount > 0 && m.Currency == price.Currency && price.Amount < m.Amount {
+		limit(m, "min_price", "raised to the minimum price")
+	}
+	if m := rule.MaxPrice; m.Amount > 0 && m.Currency == price.Currency && price.Amount > m.Amount {
+		limit(m, "max_price", "lowered to the maximum price")
+	}
+	return price, adjustments
+}
+
+// percentOf считает процент от суммы с округлением до минимальной единицы валюты
+func percentOf(amount int64, percent int) int64 {
+	v := amount * int64(percent)
+	if v >= 0 {
+		return (v + 50) / 100
+	}
+	return (v - 50) / 100
+}
@@ -0,0 +1,147 @@
+package pricing
+
+import (
+	"time"
+
+	"booking-service/internal/entities"
+)
+
+// ApplyTaxes начисляет налоги и сборы отеля на цену ночей и пересчитывает
+// Total. Освобожденные гости не платят туристический налог; НДС не
+// начисляется, если от налогов освобождены все гости
+func ApplyTaxes(price entities.PriceSnapshot, rules []entities.TaxRule, guests, taxExempt int) (entities.PriceSnapshot, error) {
+	if taxExempt < 0 || taxExempt > guests {
+		return entities.PriceSnapshot{}, entities.ErrInvalidTaxExemptGuests
+	}
+	currency := price.Subtotal.Currency
+	price.Guests, price.TaxExemptGuests = guests, taxExempt
+	price.Items = nil
+	price.Taxes = entities.Money{Currency: currency}
+	price.Fees = entities.Money{Currency: currency}
+	if len(price.Nights) == 0 {
+		price.Total = price.Subtotal
+		return price, nil
+	}
+
+	items := make(map[uint64]*entities.PriceItem, len(rules))
+	add := func(rule entities.TaxRule, quantity int, amount int64) {
+		item, ok := items[rule.ID]
+		if !ok {
+			item = &entities.PriceItem{
+				TaxRuleID: rule.ID,
+				Kind:      rule.Kind,
+				Name:      rule.Name,
+				Amount:    entities.Money{Currency: currency},
+			}
+			items[rule.ID] = item
+		}
+		item.Quantity += quantity
+		item.Amount.Amount += amount
+	}
+
+	arrival := price.Nights[0].Date
+	for _, rule := range rules {
+		if rule.Kind == entities.TaxKindVAT {
+			continue
+		}
+		if rule.Amount.Currency != currency {
+			return entities.PriceSnapshot{}, entities.ErrTaxCurrencyMismatch
+		}
+		switch rule.Kind {
+		case entities.TaxKindFee:
+			if ruleCovers(rule, arrival) {
+				add(rule, 1, rule.Amount.Amount)
+				price.Fees.Amount += rule.Amount.Amount
+			}
+		case entities.TaxKindCityTax:
+			payable := guests - taxExempt
+			for _, n := range price.Nights {
+				if payable > 0 && ruleCovers(rule, n.Date) {
+					add(rule, payable, rule.Amount.Amount*int64(payable))
+					price.Taxes.Amount += rule.Amount.Amount * int64(payable)
+				}
+			}
+		}
+	}
+
+	if guests == 0 || taxExempt < guests {
+		for _, rule := range rules {
+			if rule.Kind != entities.TaxKindVAT {
+				continue
+			}
+			var base int64
+			quantity := 0
+			for _, n := range price.Nights {
+				if ruleCovers(rule, n.Date) {
+					base += n.Price.Amount
+					quantity++
+				}
+			}
+			// сборы облагаются по ставке, действующей в день заезда
+			if ruleCovers(rule, arrival) {
+				base += price.Fees.Amount
+			}
+			if quantity == 0 && base == 0 {
+				continue
+			}
+			vat := basisPointsOf(base, rule.PercentBasisPoints)
+			add(rule, quantity, vat)
+			price.Taxes.Amount += vat
+		}
+	}
+
+	// строки в порядке правил, чтобы расшифровка была стабильной
+	for _, rule := range rules {
+		if item, ok := items[rule.ID]; ok {
+			price.Items = append(price.Items, *item)
+			delete(items, rule.ID)
+		}
+	}
+	price.Total = entities.Money{
+		Amount:   price.Subtotal.Amount + price.Taxes.Amount + price.Fees.Amount,
+		Currency: currency,
+	}
+	return price, nil
+}
+
+// ValidateTaxRule проверяет правило до сохранения
+func ValidateTaxRule(rule entities.TaxRule) error {
+	if rule.Name == "" {
+		return entities.ErrNameIsRequired
+	}
+	if len([]rune(rule.Name)) > 255 {
+		return entities.ErrNameIsTooLong
+	}
+	switch rule.Kind {
+	case entities.TaxKindCityTax, entities.TaxKindFee:
+		if rule.Amount.Amount <= 0 {
+			return entities.ErrInvalidPrice
+		}
+		if !entities.IsValidCurrency(rule.Amount.Currency) {
+			return entities.ErrInvalidCurrency
+		}
+	case entities.TaxKindVAT:
+		if rule.PercentBasisPoints <= 0 || rule.PercentBasisPoints > 10000 {
+			return entities.ErrInvalidTaxPercent
+		}
+	default:
+		return entities.ErrInvalidTaxKind
+	}
+	if !rule.ValidTo.IsZero() && Date(rule.ValidFrom).After(Date(rule.ValidTo)) {
+		return entities.ErrInvalidValidityPeriod
+	}
+	return nil
+}
+
+func ruleCovers(rule entities.TaxRule, night time.Time) bool {
+	return !night.Before(Date(rule.ValidFrom)) && (rule.ValidTo.IsZero() || !night.After(Date(rule.ValidTo)))
+}
+
+// basisPointsOf считает долю в базисных пунктах с округлением до минимальной единицы валюты
+func basisPointsOf(amount int64, bp int) int64 {
+	v := amount * int64(bp)
+	if v >= 0 {
+		return (v + 5000) / 10000
+	}
+	return (v - 5000) / 10000
+}
@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"booking-service/internal/auth"
+	"booking-service/internal/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// RetryAfterMetadataKey заголовок ответа с числом секунд до следующей попытки;
+	// шлюз отдает его как HTTP Retry-After
+	RetryAfterMetadataKey = "retry-after"
+	forwardedForKey       = "x-forwarded-for"
+	healthServicePrefix   = "/grpc.health.v1.Health/"
+)
+
+// IPUnaryServerInterceptor ставится перед аутентификацией: ограничивает все
+// запросы с одного IP, в том числе с неверными учетными данными, чтобы
+// перебор ключей и токенов не доходил до их проверки
+func (l *Limiter) IPUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(ctx, req)
+		}
+
+		ip := l.clientIP(ctx)
+		if allowed, retryAfter := l.AllowIP(ctx, ip); !allowed {
+			return nil, reject(ctx, "ip:"+ip, info.FullMethod, retryAfter)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// UnaryServerInterceptor ставится после аутентификации, чтобы клиент
+// определялся по API-ключу или пользователю, а не только по IP
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if strings.HasPrefix(info.FullMethod, healthServicePrefix) {
+			return handler(ctx, req)
+		}
+
+		client := l.ClientKey(ctx)
+		if allowed, retryAfter := l.Allow(ctx, info.FullMethod, client); !allowed {
+			return nil, reject(ctx, client, info.FullMethod, retryAfter)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func reject(ctx context.Context, client, method string, retryAfter time.Duration) error {
+	seconds := strconv.Itoa(int(math.Ceil(max(retryAfter, time.Second).Seconds())))
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterMetadataKey, seconds))
+	logger.FromContext(ctx).InfoContext(ctx, "rate limit exceeded",
+		"client", client, "method", method, "retry_after", seconds)
+	return status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %s s", seconds)
+}
+
+// ClientKey идентификатор клиента: сервис, пользователь или гость из контекста
+// аутентификации, для анонимных запросов — IP
+func (l *Limiter) ClientKey(ctx context.Context) string {
+	if p := auth.PrincipalFromContext(ctx); p != nil {
+		return string(p.Kind) + ":" + p.Subject
+	}
+	return "ip:" + l.clientIP(ctx)
+}
+
+// clientIP берет адрес из X-Forwarded-For, только если запрос пришел из сети
+// из TrustedPeers, то есть от HTTP-шлюза. Шлюз дописывает адрес соединения в
+// конец цепочки, поэтому адрес клиента отсчитывается с конца с учетом
+// доверенных прокси: начало цепочки клиент может подделать
+func (l *Limiter) clientIP(ctx context.Context) string {
+	var ip netip.Addr
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if addrPort, err := netip.ParseAddrPort(p.Addr.String()); err == nil {
+			ip = addrPort.Addr().Unmap()
+		}
+	}
+	if !ip.IsValid() {
+		return "unknown"
+	}
+
+	if l.isTrustedPeer(ip) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		var chain []string
+		for _, v := range md.Get(forwardedForKey) {
+			chain = append(chain, strings.Split(v, ",")...)
+		}
+		if i := len(chain) - 1 - l.trustedProxies; i >= 0 && i < len(chain) {
+			if addr, err := netip.ParseAddr(strings.TrimSpace(chain[i])); err == nil {
+				return addr.Unmap().String()
+			}
+		}
+	}
+	return ip.String()
+}
+
+func (l *Limiter) isTrustedPeer(ip netip.Addr) bool {
+	for _, p := range l.trustedPeers {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func requestFrom(peerAddr, forwardedFor string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: net.TCPAddrFromAddrPort(netip.MustParseAddrPort(peerAddr))})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(forwardedForKey, forwardedFor))
+}
+
+func TestClientIPTrustsForwardedForOnlyFromTrustedPeers(t *testing.T) {
+	l := NewLimiter(NewMemoryStore(), Config{
+		TrustedPeers: []netip.Prefix{netip.MustParsePrefix("10.0.0.7/32")},
+	}, slog.Default())
+
+	assert.Equal(t, "203.0.113.5", l.clientIP(requestFrom("10.0.0.7:4000", "198.51.100.1, 203.0.113.5")))
+	// loopback сам по себе больше не доверенный: заголовок мог подставить любой локальный процесс
+	assert.Equal(t, "127.0.0.1", l.clientIP(requestFrom("127.0.0.1:4000", "203.0.113.5")))
+	assert.Equal(t, "10.0.0.8", l.clientIP(requestFrom("10.0.0.8:4000", "203.0.113.5")))
+	assert.Equal(t, "10.0.0.7", l.clientIP(requestFrom("10.0.0.7:4000", "not-an-ip")))
+
+	// без доверенных сетей заголовок не читается
+	l = NewLimiter(NewMemoryStore(), Config{}, slog.Default())
+	assert.Equal(t, "127.0.0.1", l.clientIP(requestFrom("127.0.0.1:4000", "203.0.113.5")))
+}
+
+func TestClientIPSkipsTrustedProxies(t *testing.T) {
+	l := NewLimiter(NewMemoryStore(), Config{
+		TrustedProxies: 1,
+		TrustedPeers:   []netip.Prefix{netip.MustParsePrefix("::1/128")},
+	}, slog.Default())
+
+	assert.Equal(t, "203.0.113.5", l.clientIP(requestFrom("[::1]:4000", "198.51.100.1, 203.0.113.5, 10.1.1.1")))
+}
@@ -0,0 +1,126 @@
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"net/netip"
+	"time"
+)
+
+// Budget отдельный лимит для группы методов, например дорогих запросов
+type Budget struct {
+	Name  string
+	Limit Limit
+	// Methods полные имена gRPC-методов
+	Methods []string
+}
+
+type Config struct {
+	// Default лимит для методов вне бюджетов
+	Default Limit
+	// PerIP общий лимит запросов с одного IP до аутентификации
+	PerIP   Limit
+	Budgets []Budget
+	// TrustedProxies число доверенных прокси перед HTTP-шлюзом (балансировщик,
+	// ingress): столько последних адресов X-Forwarded-For добавлены ими
+	TrustedProxies int
+	// TrustedPeers сети, из которых X-Forwarded-For принимается: адреса, с
+	// которых подключается HTTP-шлюз. Пустой список — заголовок не читается
+	TrustedPeers []netip.Prefix
+}
+
+// Limiter выбирает бюджет по методу и списывает токен из корзины клиента.
+// Корзины разных бюджетов независимы: дорогие вызовы не расходуют общий лимит
+type Limiter struct {
+	store          Store
+	def            Limit
+	perIP          Limit
+	budgets        map[string]Budget
+	trustedProxies int
+	trustedPeers   []netip.Prefix
+	failOpen       bool
+	logger         *slog.Logger
+}
+
+const (
+	defaultBudget = "default"
+	perIPBudget   = "ip"
+)
+
+func NewLimiter(store Store, cfg Config, logger *slog.Logger) *Limiter {
+	byMethod := make(map[string]Budget)
+	for _, b := range cfg.Budgets {
+		for _, m := range b.Methods {
+			byMethod[m] = b
+		}
+	}
+	return &Limiter{
+		store:          store,
+		def:            cfg.Default,
+		perIP:          cfg.PerIP,
+		budgets:        byMethod,
+		trustedProxies: cfg.TrustedProxies,
+		trustedPeers:   cfg.TrustedPeers,
+		// недоступность хранилища не должна останавливать сервис
+		failOpen: true,
+		logger:   logger,
+	}
+}
+
+// Allow проверяет лимит клиента client для метода method
+func (l *Limiter) Allow(ctx context.Context, method, client string) (bool, time.Duration) {
+	name, limit := defaultBudget, l.def
+	if b, ok := l.budgets[method]; ok {
+		name, limit = b.Name, b.Limit
+	}
+
+	return l.take(ctx, name, client, limit)
+}
+
+// AllowIP проверяет общий лимит запросов с адреса ip
+func (l *Limiter) AllowIP(ctx context.Context, ip string) (bool, time.Duration) {
+	return l.take(ctx, perIPBudget, ip, l.perIP)
+}
+
+func (l *Limiter) take(ctx context.Context, name, client string, limit Limit) (bool, time.Duration) {
+	allowed, retryAfter, err := l.store.Take(ctx, name+":"+client, limit)
+	if err != nil {
+		l.logger.WarnContext(ctx, "rate limit store failed", "error", err, "budget", name)
+		return l.failOpen, 0
+	}
+	return allowed, retryAfter
+}
+
+// Run периодически удаляет корзины неактивных клиентов
+func (l *Limiter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := l.store.Cleanup(ctx, l.idleTimeout()); err != nil {
+				l.logger.Warn("rate limit cleanup failed", "error", err)
+			}
+		}
+	}
+}
+
+// idleTimeout время, за которое наполняется самая медленная корзина:
+// удаленная после этого корзина равна новой
+func (l *Limiter) idleTimeout() time.Duration {
+	idle := max(fillTime(l.def), fillTime(l.perIP))
+	for _, b := range l.budgets {
+		idle = max(idle, fillTime(b.Limit))
+	}
+	return idle
+}
+
+func fillTime(limit Limit) time.Duration {
+	if limit.Rate <= 0 {
+		return 24 * time.Hour
+	}
+	return time.Duration(float64(limit.Burst) / limit.Rate * float64(time.Second))
+}
@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore корзины в памяти процесса: лимит действует на каждую реплику отдельно
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updated: now}
+		s.buckets[key] = b
+	}
+
+	var (
+		allowed    bool
+		retryAfter time.Duration
+	)
+	b.tokens, allowed, retryAfter = take(refill(b.tokens, now.Sub(b.updated), limit), limit)
+	b.updated = now
+	return allowed, retryAfter, nil
+}
+
+func (s *MemoryStore) Cleanup(_ context.Context, idle time.Duration) error {
+	deadline := s.now().Add(-idle)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, b := range s.buckets {
+		if b.updated.Before(deadline) {
+			delete(s.buckets, key)
+		}
+	}
+	return nil
+}
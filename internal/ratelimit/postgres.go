@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// PostgresStore корзины в таблице rate_limit_buckets, общие для всех реплик.
+// Пополнение и списание выполняются одним UPSERT под блокировкой строки.
+type PostgresStore struct {
+	db *sqlx.DB
+}
+
+// takeQuery: $2 — емкость, $3 — пополнение в секунду. В SET ссылки на b —
+// значения до обновления, поэтому refilled вычисляется одинаково во всех полях
+const takeQuery = `
+    INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at)
+    VALUES ($1, $2::FLOAT8 - 1, $2::FLOAT8 >= 1, statement_timestamp())
+    ON CONFLICT (key) DO UPDATE SET
+        tokens     = CASE
+                         WHEN LEAST($2::FLOAT8, b.tokens + $3::FLOAT8 * EXTRACT(EPOCH FROM statement_timestamp() - b.updated_at)::FLOAT8) >= 1
+                             THEN LEAST($2::FLOAT8, b.tokens + $3::FLOAT8 * EXTRACT(EPOCH FROM statement_timestamp() - b.updated_at)::FLOAT8) - 1
+                         ELSE LEAST($2::FLOAT8, b.tokens + $3::FLOAT8 * EXTRACT(EPOCH FROM statement_timestamp() - b.updated_at)::FLOAT8)
+                     END,
+        allowed    = LEAST($2::FLOAT8, b.tokens + $3::FLOAT8 * EXTRACT(EPOCH FROM statement_timestamp() - b.updated_at)::FLOAT8) >= 1,
+        updated_at = statement_timestamp()
+    RETURNING tokens, allowed
+`
+
+func NewPostgresStore(db *sqlx.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+func (s *PostgresStore) Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	var (
+		tokens  float64
+		allowed bool
+	)
+	err := s.db.QueryRowContext(ctx, takeQuery, key, float64(limit.Burst), limit.Rate).Scan(&tokens, &allowed)
+	if err != nil {
+		return false, 0, fmt.Errorf("[ratelimit.PostgresStore]: take: %w", err)
+	}
+	if allowed {
+		return true, 0, nil
+	}
+	_, _, retryAfter := take(tokens, limit)
+	return false, retryAfter, nil
+}
+
+func (s *PostgresStore) Cleanup(ctx context.Context, idle time.Duration) error {
+	_, err := s.db.ExecContext(ctx,
+		`DELETE FROM rate_limit_buckets WHERE updated_at < clock_timestamp() - make_interval(secs => $1)`,
+		idle.Seconds(),
+	)
+	if err != nil {
+		return fmt.Errorf("[ratelimit.PostgresStore]: cleanup: %w", err)
+	}
+	return nil
+}
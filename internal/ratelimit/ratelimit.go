@@ -0,0 +1,43 @@
+// Package ratelimit ограничивает частоту запросов клиентов алгоритмом token
+// bucket. Корзины хранятся в памяти инстанса или в Postgres, если лимиты
+// должны быть общими для всех реплик.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Limit скорость пополнения корзины (запросов в секунду) и ее емкость
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Store хранилище корзин. Take атомарно списывает один токен из корзины key;
+// если токенов нет, возвращает false и время до появления следующего
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit) (allowed bool, retryAfter time.Duration, err error)
+	// Cleanup удаляет корзины, к которым не обращались дольше idle
+	Cleanup(ctx context.Context, idle time.Duration) error
+}
+
+// refill возвращает количество токенов в корзине спустя elapsed
+func refill(tokens float64, elapsed time.Duration, limit Limit) float64 {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	return math.Min(float64(limit.Burst), tokens+elapsed.Seconds()*limit.Rate)
+}
+
+// take списывает токен из корзины с tokens токенами
+func take(tokens float64, limit Limit) (rest float64, allowed bool, retryAfter time.Duration) {
+	if tokens >= 1 {
+		return tokens - 1, true, 0
+	}
+	if limit.Rate <= 0 {
+		return tokens, false, time.Hour
+	}
+	return tokens, false, time.Duration((1 - tokens) / limit.Rate * float64(time.Second))
+}
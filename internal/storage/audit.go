@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"booking-service/internal/entities"
+
+	"github.com/lib/pq"
+)
+
+func (s *Storage) SaveAuditEvent(ctx context.Context, tx *sql.Tx, event entities.AuditEvent) error {
+	query := `
+        INSERT INTO audit_events
+            (actor_kind, actor, employee_id, rpc, entity_type, entity_id, hotel_id, action, diff, request_id)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    `
+	_, err := execContext(ctx, tx, "SaveAuditEvent", query,
+		event.ActorKind,
+		event.Actor,
+		nullableID(event.EmployeeID),
+		event.RPC,
+		event.EntityType,
+		event.EntityID,
+		nullableID(event.HotelID),
+		event.Action,
+		string(event.Diff),
+		event.RequestID,
+	)
+	if err != nil {
+		return fmt.Errorf("[AuditRepository]: save: %w", err)
+	}
+
+	return nil
+}
+
+// FindAuditEvents возвращает события по фильтру, от новых к старым.
+func (s *Storage) FindAuditEvents(ctx context.Context, tx *sql.Tx, filter entities.AuditFilter) ([]entities.AuditEvent, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	where := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.EntityType != "" {
+		where("entity_type = $%d", filter.EntityType)
+	}
+	if filter.EntityID != 0 {
+		where("entity_id = $%d", filter.EntityID)
+	}
+	if filter.HotelID != 0 {
+		where("hotel_id = $%d", filter.HotelID)
+	}
+	if !filter.From.IsZero() {
+		where("occurred_at >= $%d", filter.From)
+	}
+	if !filter.To.IsZero() {
+		where("occurred_at < $%d", filter.To)
+	}
+	if filter.BeforeID != 0 {
+		where("id < $%d", filter.BeforeID)
+	}
+
+	query := `
+        SELECT id, occurred_at, actor_kind, actor, COALESCE(employee_id, 0), rpc, entity_type, entity_id,
+               COALESCE(hotel_id, 0), action, diff, request_id
+        FROM audit_events`
+	if len(conds) > 0 {
+		query += "\n        WHERE " + strings.Join(conds, " AND ")
+	}
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf("\n        ORDER BY id DESC\n        LIMIT $%d", len(args))
+
+	rows, err := queryContext(ctx, tx, "FindAuditEvents", query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("[AuditRepository]: find: %w", err)
+	}
+	defer rows.Close()
+
+	res := make([]entities.AuditEvent, 0)
+	for rows.Next() {
+		var e entities.AuditEvent
+		var diff []byte
+		if err := rows.Scan(&e.ID, &e.OccurredAt, &e.ActorKind, &e.Actor, &e.EmployeeID, &e.RPC, &e.EntityType,
+			&e.EntityID, &e.HotelID, &e.Action, &diff, &e.RequestID); err != nil {
+			return nil, fmt.Errorf("[AuditRepository]: scan: %w", err)
+		}
+		e.Diff = diff
+		res = append(res, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RedactAuditEvents заменяет значения в diff событий сущностей на [REDACTED]:
+// остается видно, какие поля и когда менялись, но не сами данные. Журнал
+// только для добавления, и у приложения нет права его менять: diff переписывает
+// функция redact_audit_events от отдельной роли
+func (s *Storage) RedactAuditEvents(
+	ctx context.Context, tx *sql.Tx, entityType entities.AuditEntityType, entityIDs []uint64,
+) error {
+	query := `SELECT redact_audit_events($1, $2::BIGINT[])`
+	if _, err := execContext(ctx, tx, "RedactAuditEvents", query, entityType, pq.Array(toInt64s(entityIDs))); err != nil {
+		return fmt.Errorf("[AuditRepository]: redact: %w", err)
+	}
+	return nil
+}
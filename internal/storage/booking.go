@@ -3,39 +3,124 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"booking-service/internal/entities"
+
+	"github.com/lib/pq"
 )
 
-func (s *Storage) SaveBooking(ctx context.Context, tx *sql.Tx, booking entities.Booking) error {
-	queryBooking := `
-        INSERT INTO bookings (room_id, guest_id, start_date, end_date, comment, status, is_paid)
-        VALUES ($1, $2, $3, $4)
-        ON CONFLICT DO UPDATE SET
-            room_id = EXCLUDED.room_id,
-            guest_id = EXCLUDED.guest_id,
-            start_date = EXCLUDED.start_date,
-            end_date = EXCLUDED.end_date,
-            comment = EXCLUDED.comment,
-            status = EXCLUDED.status,
-            is_paid = EXCLUDED.is_paid
-    
-    `
+const bookingColumns = `id, room_id, start_date, end_date, COALESCE(comment, ''), created_at, updated_at, status, is_paid,
+    price_total, price_currency, price_nights, price_breakdown, cancellation_terms, payment_status, amount_paid,
+    balance_due_at`
+
+// priceBreakdown части цены, которые хранятся в price_breakdown
+type priceBreakdown struct {
+	Subtotal        entities.Money       `json:"subtotal"`
+	Taxes           entities.Money       `json:"taxes"`
+	Fees            entities.Money       `json:"fees"`
+	Items           []entities.PriceItem `json:"items,omitempty"`
+	Guests          int                  `json:"guests,omitempty"`
+	TaxExemptGuests int                  `json:"tax_exempt_guests,omitempty"`
+	Discount        *entities.Discount   `json:"discount,omitempty"`
+}
+
+// SaveBooking создает бронирование, если у него нет идентификатора, иначе обновляет его.
+func (s *Storage) SaveBooking(ctx context.Context, tx *sql.Tx, booking entities.Booking) (entities.Booking, error) {
+	nights, breakdown, cancellation, err := marshalBookingPrice(booking)
+	if err != nil {
+		return entities.Booking{}, fmt.Errorf("[BookingRepository]: marshal price: %w", err)
+	}
+
+	if booking.ID == 0 {
+		query := `
+            INSERT INTO bookings (room_id, start_date, end_date, comment, status, is_paid,
+                                  price_total, price_currency, price_nights, price_breakdown, cancellation_terms,
+                                  payment_status, amount_paid, balance_due_at)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+            RETURNING id, created_at, updated_at
+        `
+		err := queryRowContext(ctx, tx, "SaveBooking", query,
+			booking.RoomID,
+			booking.StartDate,
+			booking.EndDate,
+			booking.Comment,
+			booking.Status,
+			booking.IsPaid,
+			booking.Price.Total.Amount,
+			booking.Price.Total.Currency,
+			nights,
+			breakdown,
+			cancellation,
+			paymentStatus(booking),
+			booking.AmountPaid.Amount,
+			nullableTime(booking.BalanceDueAt),
+		).Scan(&booking.ID, &booking.CreatedAt, &booking.UpdatedAt)
+		if err != nil {
+			return entities.Booking{}, fmt.Errorf("[BookingRepository]: insert booking: %w", err)
+		}
+		return booking, nil
+	}
 
-	err := tx.QueryRowContext(ctx,
-		queryBooking,
+	query := `
+        UPDATE bookings
+        SET room_id    = $2,
+            start_date = $3,
+            end_date   = $4,
+            comment    = $5,
+            status     = $6,
+            is_paid    = $7,
+            price_total    = $8,
+            price_currency = $9,
+            price_nights   = $10,
+            price_breakdown    = $11,
+            cancellation_terms = $12,
+            payment_status     = $13,
+            amount_paid        = $14,
+            balance_due_at     = $15,
+            updated_at = NOW()
+        WHERE id = $1
+        RETURNING created_at, updated_at
+    `
+	err = queryRowContext(ctx, tx, "SaveBooking", query,
+		booking.ID,
 		booking.RoomID,
 		booking.StartDate,
 		booking.EndDate,
 		booking.Comment,
 		booking.Status,
 		booking.IsPaid,
-	).
-		Scan(&booking.ID, &booking.CreatedAt, &booking.UpdatedAt)
+		booking.Price.Total.Amount,
+		booking.Price.Total.Currency,
+		nights,
+		breakdown,
+		cancellation,
+		paymentStatus(booking),
+		booking.AmountPaid.Amount,
+		nullableTime(booking.BalanceDueAt),
+	).Scan(&booking.CreatedAt, &booking.UpdatedAt)
 	if err != nil {
-		return err
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Booking{}, entities.ErrNotFound
+		}
+		return entities.Booking{}, fmt.Errorf("[BookingRepository]: update booking: %w", err)
+	}
+
+	return booking, nil
+}
+
+// AttachGuestsToBooking привязывает гостей к бронированию; повторная привязка игнорируется.
+func (s *Storage) AttachGuestsToBooking(ctx context.Context, tx *sql.Tx, bookingID uint64, guestIDs []uint64) error {
+	query := `
+        INSERT INTO bookings_guests (booking_id, guest_id)
+        SELECT $1, unnest($2::BIGINT[])
+        ON CONFLICT DO NOTHING
+    `
+	if _, err := execContext(ctx, tx, "AttachGuestsToBooking", query, bookingID, pq.Array(toInt64s(guestIDs))); err != nil {
+		return fmt.Errorf("[BookingRepository]: attach guests: %w", err)
 	}
 
 	return nil
@@ -43,62 +128,138 @@ func (s *Storage) SaveBooking(ctx context.Context, tx *sql.Tx, booking entities.
 
 // FindBookingById возвращает бронирование по идентификатору, включая связанных гостей.
 func (s *Storage) FindBookingById(ctx context.Context, tx *sql.Tx, bookingID uint64) (entities.Booking, error) {
-	var booking entities.Booking
-	query := `
-        SELECT id, room_id, guest_id, start_date, end_date, comment, created_at, updated_at, status, is_paid
-        FROM bookings
-        WHERE id = $1
-    `
-	if err := tx.QueryRowContext(ctx, query, bookingID).Scan(&booking); err != nil {
+	query := `SELECT ` + bookingColumns + ` FROM bookings WHERE id = $1`
+
+	booking, err := scanBooking(queryRowContext(ctx, tx, "FindBookingById", query, bookingID))
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return entities.Booking{}, entities.ErrNotFound
 		}
 		return entities.Booking{}, err
 	}
 
+	guests, err := s.FindGuestsByBookingIDs(ctx, tx, []uint64{bookingID})
+	if err != nil {
+		return entities.Booking{}, err
+	}
+	booking.Guests = guests[bookingID]
+
 	return booking, nil
 }
 
 // FindBookingByDate возвращает список бронирований, активных на заданную дату.
-// Для каждого бронирования дополнительно загружаются связанные гости.
 func (s *Storage) FindBookingByDate(ctx context.Context, tx *sql.Tx, startDate, endDate time.Time) ([]entities.Booking, error) {
-	var bookings []entities.Booking
 	query := `
-        SELECT id, room_id, guest_id, start_date, end_date, comment, created_at, updated_at, status, is_paid
+        SELECT ` + bookingColumns + `
         FROM bookings
         WHERE start_date <= $1 AND end_date >= $2
         ORDER BY start_date
     `
-	rows, err := tx.QueryContext(ctx, query, endDate, startDate)
+	rows, err := queryContext(ctx, tx, "FindBookingByDate", query, endDate, startDate)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
 
-	res := make([]entities.Booking, 0)
-	for rows.Next() {
-		var booking entities.Booking
-		errScan := rows.Scan(&booking)
-		if errScan != nil {
-			return nil, errScan
-		}
-		res = append(res, booking)
+	return scanBookings(rows)
+}
+
+// FindBookingsByGuestIDs возвращает бронирования, к которым привязан хотя бы один из гостей.
+// Для каждого бронирования дополнительно загружаются связанные гости.
+func (s *Storage) FindBookingsByGuestIDs(ctx context.Context, tx *sql.Tx, guestIDs []uint64) ([]entities.Booking, error) {
+	query := `
+        SELECT ` + bookingColumns + `
+        FROM bookings
+        WHERE id IN (SELECT booking_id FROM bookings_guests WHERE guest_id = ANY($1::BIGINT[]))
+        ORDER BY start_date DESC, id DESC
+    `
+	rows, err := queryContext(ctx, tx, "FindBookingsByGuestIDs", query, pq.Array(toInt64s(guestIDs)))
+	if err != nil {
+		return nil, err
+	}
+
+	bookings, err := scanBookings(rows)
+	if err != nil {
+		return nil, err
 	}
 
-	if rows.Err() != nil {
-		return nil, rows.Err()
+	ids := make([]uint64, 0, len(bookings))
+	for _, b := range bookings {
+		ids = append(ids, b.ID)
+	}
+	guests, err := s.FindGuestsByBookingIDs(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range bookings {
+		bookings[i].Guests = guests[bookings[i].ID]
 	}
 
 	return bookings, nil
 }
 
+// HasActiveGuestBookings проверяет, есть ли у гостя неотмененные бронирования,
+// которые еще не закончились
+func (s *Storage) HasActiveGuestBookings(ctx context.Context, tx *sql.Tx, guestID uint64) (bool, error) {
+	query := `
+        SELECT EXISTS (
+            SELECT 1
+            FROM bookings_guests bg
+            JOIN bookings b ON b.id = bg.booking_id
+            WHERE bg.guest_id = $1 AND b.status <> $2 AND b.end_date >= CURRENT_DATE
+        )
+    `
+	var exists bool
+	err := queryRowContext(ctx, tx, "HasActiveGuestBookings", query, guestID, entities.BookingStatusCancelled).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("[BookingRepository]: has active guest bookings: %w", err)
+	}
+
+	return exists, nil
+}
+
+// IsGuestInBooking проверяет, привязан ли к бронированию хотя бы один из гостей.
+func (s *Storage) IsGuestInBooking(ctx context.Context, tx *sql.Tx, bookingID uint64, guestIDs []uint64) (bool, error) {
+	query := `
+        SELECT EXISTS (
+            SELECT 1 FROM bookings_guests WHERE booking_id = $1 AND guest_id = ANY($2::BIGINT[])
+        )
+    `
+	var exists bool
+	err := queryRowContext(ctx, tx, "IsGuestInBooking", query, bookingID, pq.Array(toInt64s(guestIDs))).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// IsGuestInHotel проверяет, есть ли у гостя бронирование в отеле, включая отмененные
+func (s *Storage) IsGuestInHotel(ctx context.Context, tx *sql.Tx, guestID, hotelID uint64) (bool, error) {
+	query := `
+        SELECT EXISTS (
+            SELECT 1
+            FROM bookings_guests bg
+            JOIN bookings b ON b.id = bg.booking_id
+            JOIN rooms r ON r.id = b.room_id
+            WHERE bg.guest_id = $1 AND r.hotel_id = $2
+        )
+    `
+	var exists bool
+	err := queryRowContext(ctx, tx, "IsGuestInHotel", query, guestID, hotelID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("[BookingRepository]: is guest in hotel: %w", err)
+	}
+
+	return exists, nil
+}
+
 // DeleteBooking удаляет бронирование по идентификатору, включая связанные записи в таблице bookings_guests.
 func (s *Storage) DeleteBooking(ctx context.Context, tx *sql.Tx, bookingID uint64) error {
 	deleteGuestsQuery := `
         DELETE FROM bookings
         WHERE id = $1
     `
-	if _, err := tx.ExecContext(ctx, deleteGuestsQuery, bookingID); err != nil {
+	if _, err := execContext(ctx, tx, "DeleteBooking", deleteGuestsQuery, bookingID); err != nil {
 		return err
 	}
 
@@ -106,41 +267,28 @@ func (s *Storage) DeleteBooking(ctx context.Context, tx *sql.Tx, bookingID uint6
 }
 
 // FindBookingByRoomIDAndDate возвращает список бронирований для заданной комнаты.
-// Для каждого бронирования дополнительно загружаются связанные гости.
 func (s *Storage) FindBookingByRoomIDAndDate(
 	ctx context.Context, tx *sql.Tx, roomID uint64, startDate, endDate time.Time,
 ) ([]entities.Booking, error) {
-	var bookings []entities.Booking
 	query := `
-        SELECT id, room_id, guest_id, start_date, end_date, comment, created_at, updated_at, status, is_paid
+        SELECT ` + bookingColumns + `
         FROM bookings
         WHERE room_id = $1 AND start_date <= $2 AND end_date >= $3
         ORDER BY start_date
     `
-	rows, err := tx.QueryContext(ctx, query, roomID, endDate, startDate)
+	rows, err := queryContext(ctx, tx, "FindBookingByRoomIDAndDate", query, roomID, endDate, startDate)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-
-	res := make([]entities.Booking, 0)
-	for rows.Next() {
-		var booking entities.Booking
-		errScan := rows.Scan(&booking)
-		if errScan != nil {
-			return nil, errScan
-		}
-		res = append(res, booking)
-	}
 
-	if rows.Err() != nil {
-		return nil, rows.Err()
-	}
-
-	return bookings, nil
+	return scanBookings(rows)
 }
 
-func (s *Storage) IsRoomAvailableForBooking(ctx context.Context, tx *sql.Tx, roomID uint64, startDate, endDate time.Time) (bool, error) {
+// IsRoomAvailableForBooking проверяет, свободен ли номер в заданные даты.
+// excludeBookingID не учитывается при проверке (при изменении дат бронирования), 0 — учитывать все.
+func (s *Storage) IsRoomAvailableForBooking(
+	ctx context.Context, tx *sql.Tx, roomID, excludeBookingID uint64, startDate, endDate time.Time,
+) (bool, error) {
 	query := `SELECT 
     NOT EXISTS (
         SELECT 1 
@@ -149,12 +297,138 @@ func (s *Storage) IsRoomAvailableForBooking(ctx context.Context, tx *sql.Tx, roo
           AND status IN (1, 3)  -- активные статусы бронирований (уточните по вашей системе)
           AND start_date < $2 -- конечная дата желаемого бронирования
           AND end_date > $3    -- начальная дата желаемого бронирования
+          AND id <> $4
     ) as is_available;`
 
 	var exist bool
-	if err := tx.QueryRowContext(ctx, query, roomID, startDate, endDate).Scan(&exist); err != nil {
+	if err := queryRowContext(ctx, tx, "IsRoomAvailableForBooking", query,
+		roomID, endDate, startDate, excludeBookingID).Scan(&exist); err != nil {
 		return false, err
 	}
 
 	return exist, nil
 }
+
+// FindHotelIDByBookingID возвращает отель, в номере которого сделано бронирование.
+func (s *Storage) FindHotelIDByBookingID(ctx context.Context, tx *sql.Tx, bookingID uint64) (uint64, error) {
+	query := `
+        SELECT r.hotel_id
+        FROM bookings b
+        JOIN rooms r ON r.id = b.room_id
+        WHERE b.id = $1
+    `
+	var hotelID uint64
+	if err := queryRowContext(ctx, tx, "FindHotelIDByBookingID", query, bookingID).Scan(&hotelID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, entities.ErrNotFound
+		}
+		return 0, err
+	}
+
+	return hotelID, nil
+}
+
+func scanBooking(row scanner) (entities.Booking, error) {
+	var (
+		b                               entities.Booking
+		nights, breakdown, cancellation []byte
+		price                           priceBreakdown
+		balanceDueAt                    sql.NullTime
+	)
+	err := row.Scan(&b.ID, &b.RoomID, &b.StartDate, &b.EndDate, &b.Comment,
+		&b.CreatedAt, &b.UpdatedAt, &b.Status, &b.IsPaid,
+		&b.Price.Total.Amount, &b.Price.Total.Currency, &nights, &breakdown, &cancellation,
+		&b.PaymentStatus, &b.AmountPaid.Amount, &balanceDueAt)
+	if err != nil {
+		return b, err
+	}
+	b.BalanceDueAt = balanceDueAt.Time
+	b.AmountPaid.Currency = b.Price.Total.Currency
+	if err = json.Unmarshal(nights, &b.Price.Nights); err != nil {
+		return b, fmt.Errorf("[BookingRepository]: unmarshal price: %w", err)
+	}
+	if err = json.Unmarshal(breakdown, &price); err != nil {
+		return b, fmt.Errorf("[BookingRepository]: unmarshal price: %w", err)
+	}
+	b.Price.Subtotal, b.Price.Taxes, b.Price.Fees = price.Subtotal, price.Taxes, price.Fees
+	b.Price.Items, b.Price.Guests, b.Price.TaxExemptGuests = price.Items, price.Guests, price.TaxExemptGuests
+	b.Price.Discount = price.Discount
+	if cancellation != nil {
+		if err = json.Unmarshal(cancellation, &b.Cancellation); err != nil {
+			return b, fmt.Errorf("[BookingRepository]: unmarshal cancellation terms: %w", err)
+		}
+	}
+	return b, nil
+}
+
+// paymentStatus состояние оплаты для записи; у нового бронирования оно не задано
+func paymentStatus(b entities.Booking) entities.BookingPaymentStatus {
+	if b.PaymentStatus == "" {
+		return entities.BookingPaymentStatusUnpaid
+	}
+	return b.PaymentStatus
+}
+
+// marshalBookingPrice готовит JSONB-колонки цены; условия отмены без срока хранятся как NULL
+func marshalBookingPrice(b entities.Booking) (nights, breakdown string, cancellation sql.NullString, err error) {
+	raw, err := json.Marshal(b.Price.Nights)
+	if err != nil {
+		return "", "", cancellation, err
+	}
+	nights = string(raw)
+
+	raw, err = json.Marshal(priceBreakdown{
+		Subtotal:        b.Price.Subtotal,
+		Taxes:           b.Price.Taxes,
+		Fees:            b.Price.Fees,
+		Items:           b.Price.Items,
+		Guests:          b.Price.Guests,
+		TaxExemptGuests: b.Price.TaxExemptGuests,
+		Discount:        b.Price.Discount,
+	})
+	if err != nil {
+		return "", "", cancellation, err
+	}
+	breakdown = string(raw)
+
+	if !b.Cancellation.FreeUntil.IsZero() {
+		raw, err = json.Marshal(b.Cancellation)
+		if err != nil {
+			return "", "", cancellation, err
+		}
+		cancellation = sql.NullString{String: string(raw), Valid: true}
+	}
+	return nights, breakdown, cancellation, nil
+}
+
+func scanBookings(rows *tracedRows) ([]entities.Booking, error) {
+	defer rows.Close()
+
+	res := make([]entities.Booking, 0)
+	for rows.Next() {
+		booking, err := scanBooking(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, booking)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// LockBooking блокирует строку бронирования до конца транзакции, чтобы
+// параллельные оплаты и изменения одного бронирования выполнялись по очереди
+func (s *Storage) LockBooking(ctx context.Context, tx *sql.Tx, bookingID uint64) error {
+	var id uint64
+	err := queryRowContext(ctx, tx, "LockBooking", `SELECT id FROM bookings WHERE id = $1 FOR UPDATE`, bookingID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.ErrNotFound
+		}
+		return fmt.Errorf("[BookingRepository]: lock booking: %w", err)
+	}
+	return nil
+}
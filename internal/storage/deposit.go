@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking-service/internal/entities"
+)
+
+const depositRuleColumns = `hotel_id, kind, percent_basis_points, balance_days_before, updated_at`
+
+// SaveDepositRule создает или заменяет правило предоплаты отеля
+func (s *Storage) SaveDepositRule(ctx context.Context, tx *sql.Tx, rule entities.DepositRule) (entities.DepositRule, error) {
+	query := `
+		INSERT INTO deposit_rules (hotel_id, kind, percent_basis_points, balance_days_before)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (hotel_id) DO UPDATE
+		    SET kind                 = EXCLUDED.kind,
+		        percent_basis_points = EXCLUDED.percent_basis_points,
+		        balance_days_before  = EXCLUDED.balance_days_before,
+		        updated_at           = NOW()
+		RETURNING ` + depositRuleColumns
+
+	res, err := scanDepositRule(queryRowContext(ctx, tx, "SaveDepositRule", query,
+		rule.HotelID, rule.Kind, rule.PercentBasisPoints, rule.BalanceDaysBefore,
+	))
+	if err != nil {
+		return entities.DepositRule{}, fmt.Errorf("[DepositRuleRepository]: Save: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindDepositRule(ctx context.Context, tx *sql.Tx, hotelID uint64) (entities.DepositRule, error) {
+	query := `SELECT ` + depositRuleColumns + ` FROM deposit_rules WHERE hotel_id = $1`
+
+	res, err := scanDepositRule(queryRowContext(ctx, tx, "FindDepositRule", query, hotelID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.DepositRule{}, entities.ErrNotFound
+		}
+		return entities.DepositRule{}, fmt.Errorf("[DepositRuleRepository]: Find: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) DeleteDepositRule(ctx context.Context, tx *sql.Tx, hotelID uint64) error {
+	res, err := execContext(ctx, tx, "DeleteDepositRule", `DELETE FROM deposit_rules WHERE hotel_id = $1`, hotelID)
+	if err != nil {
+		return fmt.Errorf("[DepositRuleRepository]: Delete: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.ErrNotFound
+	}
+	return nil
+}
+
+// LockNextDueBalance блокирует до конца транзакции следующее бронирование, у
+// которого наступил срок списания остатка. Строки, заблокированные другими
+// репликами, пропускаются. Возвращает ErrNotFound, если таких бронирований нет
+func (s *Storage) LockNextDueBalance(ctx context.Context, tx *sql.Tx) (uint64, int, error) {
+	query := `
+		SELECT id, balance_attempts
+		FROM bookings
+		WHERE balance_due_at <= NOW()
+		  AND status <> $1
+		  AND payment_status <> $2
+		  AND (balance_next_attempt_at IS NULL OR balance_next_attempt_at <= NOW())
+		ORDER BY balance_due_at, id
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED`
+
+	var (
+		id       uint64
+		attempts int
+	)
+	err := queryRowContext(ctx, tx, "LockNextDueBalance", query,
+		entities.BookingStatusCancelled, entities.BookingPaymentStatusPaid,
+	).Scan(&id, &attempts)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, 0, entities.ErrNotFound
+		}
+		return 0, 0, fmt.Errorf("[BookingRepository]: lock due balance: %w", err)
+	}
+	return id, attempts, nil
+}
+
+// ScheduleBalanceRetry откладывает следующую попытку списания остатка
+func (s *Storage) ScheduleBalanceRetry(
+	ctx context.Context, tx *sql.Tx, bookingID uint64, attempts int, next time.Time,
+) error {
+	query := `UPDATE bookings SET balance_attempts = $2, balance_next_attempt_at = $3 WHERE id = $1`
+	if _, err := execContext(ctx, tx, "ScheduleBalanceRetry", query, bookingID, attempts, next); err != nil {
+		return fmt.Errorf("[BookingRepository]: schedule balance retry: %w", err)
+	}
+	return nil
+}
+
+func scanDepositRule(row scanner) (entities.DepositRule, error) {
+	var r entities.DepositRule
+	err := row.Scan(&r.HotelID, &r.Kind, &r.PercentBasisPoints, &r.BalanceDaysBefore, &r.UpdatedAt)
+	return r, err
+}
@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"booking-service/internal/entities"
+)
+
+const employeeColumns = `id, name, role, hotel_id, subject, created_at, updated_at`
+
+func (s *Storage) SaveEmployee(ctx context.Context, tx *sql.Tx, employee entities.Employee) (entities.Employee, error) {
+	query := `
+		INSERT INTO employees (name, role, hotel_id, subject)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + employeeColumns
+
+	res, err := scanEmployee(queryRowContext(ctx, tx, "SaveEmployee", query,
+		employee.Name, employee.Role, nullableID(employee.HotelID), employee.Subject,
+	))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return entities.Employee{}, entities.ErrAlreadyExists
+		}
+		return entities.Employee{}, fmt.Errorf("[EmployeeRepository]: Save: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) UpdateEmployee(ctx context.Context, tx *sql.Tx, employee entities.Employee) (entities.Employee, error) {
+	query := `
+		UPDATE employees
+		SET name = $2, role = $3, hotel_id = $4, subject = $5, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + employeeColumns
+
+	res, err := scanEmployee(queryRowContext(ctx, tx, "UpdateEmployee", query,
+		employee.ID, employee.Name, employee.Role, nullableID(employee.HotelID), employee.Subject,
+	))
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return entities.Employee{}, entities.ErrNotFound
+		case isUniqueViolation(err):
+			return entities.Employee{}, entities.ErrAlreadyExists
+		}
+		return entities.Employee{}, fmt.Errorf("[EmployeeRepository]: Update: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindEmployeeByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.Employee, error) {
+	query := `SELECT ` + employeeColumns + ` FROM employees WHERE id = $1`
+
+	res, err := scanEmployee(queryRowContext(ctx, tx, "FindEmployeeByID", query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Employee{}, entities.ErrNotFound
+		}
+		return entities.Employee{}, fmt.Errorf("[EmployeeRepository]: FindByID: %w", err)
+	}
+	return res, nil
+}
+
+// FindEmployeeBySubject возвращает сотрудника, привязанного к subject из JWT
+func (s *Storage) FindEmployeeBySubject(ctx context.Context, tx *sql.Tx, subject string) (entities.Employee, error) {
+	query := `SELECT ` + employeeColumns + ` FROM employees WHERE subject = $1`
+
+	res, err := scanEmployee(queryRowContext(ctx, tx, "FindEmployeeBySubject", query, subject))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Employee{}, entities.ErrNotFound
+		}
+		return entities.Employee{}, fmt.Errorf("[EmployeeRepository]: FindBySubject: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindEmployeesByHotelID(ctx context.Context, tx *sql.Tx, hotelID uint64) ([]entities.Employee, error) {
+	query := `SELECT ` + employeeColumns + ` FROM employees WHERE hotel_id = $1 ORDER BY id`
+
+	rows, err := queryContext(ctx, tx, "FindEmployeesByHotelID", query, hotelID)
+	if err != nil {
+		return nil, fmt.Errorf("[EmployeeRepository]: FindByHotelID: %w", err)
+	}
+	defer rows.Close()
+
+	res := make([]entities.Employee, 0)
+	for rows.Next() {
+		employee, err := scanEmployee(rows)
+		if err != nil {
+			return nil, fmt.Errorf("[EmployeeRepository]: FindByHotelID: %w", err)
+		}
+		res = append(res, employee)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("[EmployeeRepository]: FindByHotelID: %w", err)
+	}
+
+	return res, nil
+}
+
+func (s *Storage) DeleteEmployee(ctx context.Context, tx *sql.Tx, id uint64) error {
+	query := `DELETE FROM employees WHERE id = $1`
+
+	res, err := execContext(ctx, tx, "DeleteEmployee", query, id)
+	if err != nil {
+		return fmt.Errorf("[EmployeeRepository]: Delete: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.ErrNotFound
+	}
+	return nil
+}
+
+func scanEmployee(row scanner) (entities.Employee, error) {
+	var (
+		employee entities.Employee
+		hotelID  sql.NullInt64
+	)
+	err := row.Scan(
+		&employee.ID,
+		&employee.Name,
+		&employee.Role,
+		&hotelID,
+		&employee.Subject,
+		&employee.CreatedAt,
+		&employee.UpdatedAt,
+	)
+	employee.HotelID = uint64(hotelID.Int64)
+	return employee, err
+}
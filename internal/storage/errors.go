@@ -0,0 +1,14 @@
+package storage
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+const uniqueViolationCode = "23505"
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode
+}
@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"booking-service/internal/entities"
+
+	"github.com/lib/pq"
+)
+
+const exchangeRateColumns = `base_currency, quote_currency, rate::text, updated_at`
+
+// SaveExchangeRates добавляет курсы или обновляет существующие
+func (s *Storage) SaveExchangeRates(ctx context.Context, tx *sql.Tx, rates []entities.ExchangeRate) error {
+	bases := make([]string, 0, len(rates))
+	quotes := make([]string, 0, len(rates))
+	values := make([]string, 0, len(rates))
+	for _, r := range rates {
+		bases = append(bases, r.Base)
+		quotes = append(quotes, r.Quote)
+		values = append(values, r.Rate)
+	}
+
+	query := `
+		INSERT INTO exchange_rates (base_currency, quote_currency, rate)
+		SELECT * FROM unnest($1::varchar[], $2::varchar[], $3::numeric[])
+		ON CONFLICT (base_currency, quote_currency)
+		DO UPDATE SET rate = EXCLUDED.rate, updated_at = NOW()`
+
+	_, err := execContext(ctx, tx, "SaveExchangeRates", query, pq.Array(bases), pq.Array(quotes), pq.Array(values))
+	if err != nil {
+		return fmt.Errorf("[ExchangeRateRepository]: Save: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) DeleteExchangeRates(ctx context.Context, tx *sql.Tx) error {
+	if _, err := execContext(ctx, tx, "DeleteExchangeRates", `DELETE FROM exchange_rates`); err != nil {
+		return fmt.Errorf("[ExchangeRateRepository]: Delete: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) FindExchangeRates(ctx context.Context, tx *sql.Tx) ([]entities.ExchangeRate, error) {
+	query := `SELECT ` + exchangeRateColumns + ` FROM exchange_rates ORDER BY base_currency, quote_currency`
+
+	rows, err := queryContext(ctx, tx, "FindExchangeRates", query)
+	if err != nil {
+		return nil, fmt.Errorf("[ExchangeRateRepository]: Find: %w", err)
+	}
+	return scanExchangeRates(rows)
+}
+
+// FindExchangeRatesForPair возвращает курсы пары в обоих направлениях
+func (s *Storage) FindExchangeRatesForPair(
+	ctx context.Context, tx *sql.Tx, a, b string,
+) ([]entities.ExchangeRate, error) {
+	query := `
+		SELECT ` + exchangeRateColumns + `
+		FROM exchange_rates
+		WHERE (base_currency = $1 AND quote_currency = $2)
+		   OR (base_currency = $2 AND quote_currency = $1)`
+
+	rows, err := queryContext(ctx, tx, "FindExchangeRatesForPair", query, a, b)
+	if err != nil {
+		return nil, fmt.Errorf("[ExchangeRateRepository]: FindForPair: %w", err)
+	}
+	return scanExchangeRates(rows)
+}
+
+func scanExchangeRates(rows *tracedRows) ([]entities.ExchangeRate, error) {
+	defer rows.Close()
+
+	res := make([]entities.ExchangeRate, 0)
+	for rows.Next() {
+		var r entities.ExchangeRate
+		if err := rows.Scan(&r.Base, &r.Quote, &r.Rate, &r.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("[ExchangeRateRepository]: scan: %w", err)
+		}
+		// NUMERIC возвращается с нулями до полной точности
+		r.Rate = strings.TrimRight(strings.TrimRight(r.Rate, "0"), ".")
+		res = append(res, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("[ExchangeRateRepository]: scan: %w", err)
+	}
+	return res, nil
+}
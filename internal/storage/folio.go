@@ -0,0 +1,163 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"booking-service/internal/entities"
+)
+
+const folioColumns = `id, booking_id, currency, status, closed_at, created_at, updated_at`
+
+const folioChargeColumns = `id, folio_id, category, description, quantity, unit_price, currency, tax_basis_points,
+    net, tax, total, posted_by, voided_at, void_reason, created_at, updated_at`
+
+// FindOrCreateFolio возвращает счет бронирования и создает его, если счета еще нет
+func (s *Storage) FindOrCreateFolio(
+	ctx context.Context, tx *sql.Tx, bookingID uint64, currency string,
+) (entities.Folio, error) {
+	query := `
+		INSERT INTO folios (booking_id, currency)
+		VALUES ($1, $2)
+		ON CONFLICT (booking_id) DO UPDATE SET booking_id = EXCLUDED.booking_id
+		RETURNING ` + folioColumns
+
+	res, err := scanFolio(queryRowContext(ctx, tx, "FindOrCreateFolio", query, bookingID, currency))
+	if err != nil {
+		return entities.Folio{}, fmt.Errorf("[FolioRepository]: FindOrCreate: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindFolioByBookingID(ctx context.Context, tx *sql.Tx, bookingID uint64) (entities.Folio, error) {
+	query := `SELECT ` + folioColumns + ` FROM folios WHERE booking_id = $1`
+
+	res, err := scanFolio(queryRowContext(ctx, tx, "FindFolioByBookingID", query, bookingID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Folio{}, entities.ErrNotFound
+		}
+		return entities.Folio{}, fmt.Errorf("[FolioRepository]: FindByBookingID: %w", err)
+	}
+	return res, nil
+}
+
+// UpdateFolio меняет статус счета
+func (s *Storage) UpdateFolio(ctx context.Context, tx *sql.Tx, folio entities.Folio) (entities.Folio, error) {
+	query := `
+		UPDATE folios
+		SET status = $2, closed_at = $3, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + folioColumns
+
+	res, err := scanFolio(queryRowContext(ctx, tx, "UpdateFolio", query,
+		folio.ID, folio.Status, nullableTime(folio.ClosedAt)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Folio{}, entities.ErrNotFound
+		}
+		return entities.Folio{}, fmt.Errorf("[FolioRepository]: Update: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) SaveFolioCharge(
+	ctx context.Context, tx *sql.Tx, ch entities.FolioCharge,
+) (entities.FolioCharge, error) {
+	query := `
+		INSERT INTO folio_charges (folio_id, category, description, quantity, unit_price, currency,
+		                           tax_basis_points, net, tax, total, posted_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING ` + folioChargeColumns
+
+	res, err := scanFolioCharge(queryRowContext(ctx, tx, "SaveFolioCharge", query,
+		ch.FolioID, ch.Category, ch.Description, ch.Quantity, ch.UnitPrice.Amount, ch.UnitPrice.Currency,
+		ch.TaxBasisPoints, ch.Net.Amount, ch.Tax.Amount, ch.Total.Amount, ch.PostedBy,
+	))
+	if err != nil {
+		return entities.FolioCharge{}, fmt.Errorf("[FolioRepository]: SaveCharge: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindFolioChargeByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.FolioCharge, error) {
+	query := `SELECT ` + folioChargeColumns + ` FROM folio_charges WHERE id = $1`
+
+	res, err := scanFolioCharge(queryRowContext(ctx, tx, "FindFolioChargeByID", query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.FolioCharge{}, entities.ErrNotFound
+		}
+		return entities.FolioCharge{}, fmt.Errorf("[FolioRepository]: FindChargeByID: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindFolioCharges(ctx context.Context, tx *sql.Tx, folioID uint64) ([]entities.FolioCharge, error) {
+	query := `SELECT ` + folioChargeColumns + ` FROM folio_charges WHERE folio_id = $1 ORDER BY id`
+
+	rows, err := queryContext(ctx, tx, "FindFolioCharges", query, folioID)
+	if err != nil {
+		return nil, fmt.Errorf("[FolioRepository]: FindCharges: %w", err)
+	}
+	defer rows.Close()
+
+	res := make([]entities.FolioCharge, 0)
+	for rows.Next() {
+		ch, err := scanFolioCharge(rows)
+		if err != nil {
+			return nil, fmt.Errorf("[FolioRepository]: FindCharges: %w", err)
+		}
+		res = append(res, ch)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("[FolioRepository]: FindCharges: %w", err)
+	}
+	return res, nil
+}
+
+// VoidFolioCharge сторнирует начисление; уже сторнированное не меняется
+func (s *Storage) VoidFolioCharge(
+	ctx context.Context, tx *sql.Tx, id uint64, reason string,
+) (entities.FolioCharge, error) {
+	query := `
+		UPDATE folio_charges
+		SET voided_at = NOW(), void_reason = $2, updated_at = NOW()
+		WHERE id = $1 AND voided_at IS NULL
+		RETURNING ` + folioChargeColumns
+
+	res, err := scanFolioCharge(queryRowContext(ctx, tx, "VoidFolioCharge", query, id, reason))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.FolioCharge{}, entities.ErrChargeAlreadyVoided
+		}
+		return entities.FolioCharge{}, fmt.Errorf("[FolioRepository]: VoidCharge: %w", err)
+	}
+	return res, nil
+}
+
+func scanFolio(row scanner) (entities.Folio, error) {
+	var (
+		f        entities.Folio
+		closedAt sql.NullTime
+	)
+	err := row.Scan(&f.ID, &f.BookingID, &f.Currency, &f.Status, &closedAt, &f.CreatedAt, &f.UpdatedAt)
+	f.ClosedAt = closedAt.Time
+	return f, err
+}
+
+func scanFolioCharge(row scanner) (entities.FolioCharge, error) {
+	var (
+		ch       entities.FolioCharge
+		currency string
+		voidedAt sql.NullTime
+	)
+	err := row.Scan(&ch.ID, &ch.FolioID, &ch.Category, &ch.Description, &ch.Quantity, &ch.UnitPrice.Amount,
+		&currency, &ch.TaxBasisPoints, &ch.Net.Amount, &ch.Tax.Amount, &ch.Total.Amount, &ch.PostedBy,
+		&voidedAt, &ch.VoidReason, &ch.CreatedAt, &ch.UpdatedAt)
+	ch.UnitPrice.Currency, ch.Net.Currency, ch.Tax.Currency, ch.Total.Currency = currency, currency, currency, currency
+	ch.VoidedAt = voidedAt.Time
+	return ch, err
+}
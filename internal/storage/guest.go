@@ -3,29 +3,515 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"booking-service/internal/entities"
+
+	"github.com/lib/pq"
 )
 
-func (s *Storage) SaveGuestAndReturnIt(ctx context.Context, tx *sql.Tx, input entities.Guest) (entities.Guest, error) {
-	var guest entities.Guest
-	query := `SELECT g.id, g.name, g.created_at, g.updated_at FROM guests g WHERE g.name = $1`
+const guestColumns = `id, name, created_at, updated_at, COALESCE(email, ''), COALESCE(phone, ''), date_of_birth,
+    COALESCE(nationality, ''), address, COALESCE(document_type, ''), document_number,
+    COALESCE(document_country, ''), document_expires_at, erased_at`
+
+// SaveGuest создает гостя с профилем; номер документа шифруется
+func (s *Storage) SaveGuest(ctx context.Context, tx *sql.Tx, guest entities.Guest) (entities.Guest, error) {
+	address, err := nullableAddress(guest.Address)
+	if err != nil {
+		return entities.Guest{}, fmt.Errorf("[GuestRepository]: Save: %w", err)
+	}
+	number, err := s.pii.Encrypt(guest.DocumentNumber)
+	if err != nil {
+		return entities.Guest{}, fmt.Errorf("[GuestRepository]: Save: %w", err)
+	}
 
-	err := tx.QueryRowContext(ctx, query, input.Name).Scan(&guest)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return entities.Guest{}, err
+	query := `
+        INSERT INTO guests (name, email, phone, date_of_birth, nationality, address, document_type,
+                            document_number, document_number_index, document_country, document_expires_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+        RETURNING ` + guestColumns
+	res, err := s.scanGuest(queryRowContext(ctx, tx, "SaveGuest", query,
+		guest.Name,
+		nullableString(guest.Email),
+		nullableString(guest.Phone),
+		nullableTime(guest.DateOfBirth),
+		nullableString(guest.Nationality),
+		address,
+		nullableString(string(guest.DocumentType)),
+		number,
+		s.pii.BlindIndex(guest.DocumentNumber),
+		nullableString(guest.DocumentCountry),
+		nullableTime(guest.DocumentExpiresAt),
+	))
+	if err != nil {
+		return entities.Guest{}, fmt.Errorf("[GuestRepository]: Save: %w", err)
 	}
+	return res, nil
+}
 
-	if err == nil {
-		return guest, nil
+func (s *Storage) FindGuestByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.Guest, error) {
+	query := `SELECT ` + guestColumns + ` FROM guests WHERE id = $1`
+
+	guest, err := s.scanGuest(queryRowContext(ctx, tx, "FindGuestByID", query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Guest{}, entities.ErrNotFound
+		}
+		return entities.Guest{}, fmt.Errorf("[GuestRepository]: FindByID: %w", err)
 	}
+	return guest, nil
+}
 
-	insertQuery := `INSERT INTO guests (name) VALUES ($1) RETURNING id, name, created_at, updated_at`
-	err = tx.QueryRowContext(ctx, insertQuery, input.Name).Scan(&guest.ID, &guest.Name, &guest.CreatedAt, &guest.UpdatedAt)
+// UpdateGuest сохраняет профиль гостя; номер документа шифруется
+func (s *Storage) UpdateGuest(ctx context.Context, tx *sql.Tx, guest entities.Guest) (entities.Guest, error) {
+	address, err := nullableAddress(guest.Address)
 	if err != nil {
-		return entities.Guest{}, err
+		return entities.Guest{}, fmt.Errorf("[GuestRepository]: Update: %w", err)
+	}
+	number, err := s.pii.Encrypt(guest.DocumentNumber)
+	if err != nil {
+		return entities.Guest{}, fmt.Errorf("[GuestRepository]: Update: %w", err)
 	}
 
+	query := `
+        UPDATE guests
+        SET name                  = $2,
+            email                 = $3,
+            phone                 = $4,
+            date_of_birth         = $5,
+            nationality           = $6,
+            address               = $7,
+            document_type         = $8,
+            document_number       = $9,
+            document_number_index = $10,
+            document_country      = $11,
+            document_expires_at   = $12,
+            updated_at            = NOW()
+        WHERE id = $1
+        RETURNING ` + guestColumns
+	res, err := s.scanGuest(queryRowContext(ctx, tx, "UpdateGuest", query,
+		guest.ID,
+		guest.Name,
+		nullableString(guest.Email),
+		nullableString(guest.Phone),
+		nullableTime(guest.DateOfBirth),
+		nullableString(guest.Nationality),
+		address,
+		nullableString(string(guest.DocumentType)),
+		number,
+		s.pii.BlindIndex(guest.DocumentNumber),
+		nullableString(guest.DocumentCountry),
+		nullableTime(guest.DocumentExpiresAt),
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Guest{}, entities.ErrNotFound
+		}
+		return entities.Guest{}, fmt.Errorf("[GuestRepository]: Update: %w", err)
+	}
+	return res, nil
+}
+
+// FindGuests ищет гостей по фильтру в порядке ID
+func (s *Storage) FindGuests(ctx context.Context, tx *sql.Tx, filter entities.GuestFilter) ([]entities.Guest, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.Name != "" {
+		add(`name ILIKE '%%' || $%d || '%%'`, escapeLike(filter.Name))
+	}
+	if filter.Email != "" {
+		add("email = $%d", filter.Email)
+	}
+	if filter.Phone != "" {
+		add("phone = $%d", filter.Phone)
+	}
+	if filter.DocumentNumber != "" {
+		add("document_number_index = $%d", s.pii.BlindIndex(filter.DocumentNumber))
+	}
+	if filter.HotelID != 0 {
+		add(`EXISTS (
+            SELECT 1
+            FROM bookings_guests bg
+            JOIN bookings b ON b.id = bg.booking_id
+            JOIN rooms r ON r.id = b.room_id
+            WHERE bg.guest_id = guests.id AND r.hotel_id = $%d
+        )`, filter.HotelID)
+	}
+	add("id > $%d", filter.AfterID)
+
+	args = append(args, filter.Limit)
+	query := `SELECT ` + guestColumns + `
+        FROM guests
+        WHERE ` + strings.Join(conds, " AND ") + fmt.Sprintf(`
+        ORDER BY id
+        LIMIT $%d`, len(args))
+
+	rows, err := queryContext(ctx, tx, "FindGuests", query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("[GuestRepository]: Find: %w", err)
+	}
+	defer rows.Close()
+
+	res := make([]entities.Guest, 0)
+	for rows.Next() {
+		guest, err := s.scanGuest(rows)
+		if err != nil {
+			return nil, fmt.Errorf("[GuestRepository]: Find: %w", err)
+		}
+		res = append(res, guest)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("[GuestRepository]: Find: %w", err)
+	}
+	return res, nil
+}
+
+// LockGuests блокирует строки гостей до конца транзакции в порядке ID, чтобы
+// встречные объединения не взаимоблокировались
+func (s *Storage) LockGuests(ctx context.Context, tx *sql.Tx, ids []uint64) error {
+	query := `SELECT id FROM guests WHERE id = ANY($1::BIGINT[]) ORDER BY id FOR UPDATE`
+	rows, err := queryContext(ctx, tx, "LockGuests", query, pq.Array(toInt64s(ids)))
+	if err != nil {
+		return fmt.Errorf("[GuestRepository]: Lock: %w", err)
+	}
+	defer rows.Close()
+
+	locked := 0
+	for rows.Next() {
+		locked++
+	}
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("[GuestRepository]: Lock: %w", err)
+	}
+	if locked != len(ids) {
+		return entities.ErrNotFound
+	}
+	return nil
+}
+
+// MoveGuestBookings переносит бронирования гостя fromID на toID. Бронирования,
+// где есть оба гостя, остаются с одним toID. Возвращает число бронирований fromID
+func (s *Storage) MoveGuestBookings(ctx context.Context, tx *sql.Tx, fromID, toID uint64) (int, error) {
+	query := `
+        INSERT INTO bookings_guests (booking_id, guest_id)
+        SELECT booking_id, $2 FROM bookings_guests WHERE guest_id = $1
+        ON CONFLICT DO NOTHING
+    `
+	if _, err := execContext(ctx, tx, "MoveGuestBookings", query, fromID, toID); err != nil {
+		return 0, fmt.Errorf("[GuestRepository]: MoveBookings: %w", err)
+	}
+
+	res, err := execContext(ctx, tx, "MoveGuestBookings", `DELETE FROM bookings_guests WHERE guest_id = $1`, fromID)
+	if err != nil {
+		return 0, fmt.Errorf("[GuestRepository]: MoveBookings: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("[GuestRepository]: MoveBookings: %w", err)
+	}
+	return int(n), nil
+}
+
+// MoveGuestReviews переносит отзывы гостя fromID на toID
+func (s *Storage) MoveGuestReviews(ctx context.Context, tx *sql.Tx, fromID, toID uint64) (int, error) {
+	query := `UPDATE reviews SET guest_id = $2, updated_at = NOW() WHERE guest_id = $1`
+	res, err := execContext(ctx, tx, "MoveGuestReviews", query, fromID, toID)
+	if err != nil {
+		return 0, fmt.Errorf("[GuestRepository]: MoveReviews: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("[GuestRepository]: MoveReviews: %w", err)
+	}
+	return int(n), nil
+}
+
+// SaveGuestMerge запоминает, что sourceID объединен с targetID. Гости, ранее
+// объединенные с sourceID, переходят к targetID
+func (s *Storage) SaveGuestMerge(ctx context.Context, tx *sql.Tx, sourceID, targetID uint64) error {
+	query := `UPDATE guest_merges SET target_id = $2 WHERE target_id = $1`
+	if _, err := execContext(ctx, tx, "SaveGuestMerge", query, sourceID, targetID); err != nil {
+		return fmt.Errorf("[GuestRepository]: SaveMerge: %w", err)
+	}
+	query = `INSERT INTO guest_merges (source_id, target_id) VALUES ($1, $2)`
+	if _, err := execContext(ctx, tx, "SaveGuestMerge", query, sourceID, targetID); err != nil {
+		return fmt.Errorf("[GuestRepository]: SaveMerge: %w", err)
+	}
+	return nil
+}
+
+// FindMergedGuestIDs возвращает гостей, объединенных с guestID
+func (s *Storage) FindMergedGuestIDs(ctx context.Context, tx *sql.Tx, guestID uint64) ([]uint64, error) {
+	query := `SELECT source_id FROM guest_merges WHERE target_id = $1 ORDER BY source_id`
+	rows, err := queryContext(ctx, tx, "FindMergedGuestIDs", query, guestID)
+	if err != nil {
+		return nil, fmt.Errorf("[GuestRepository]: FindMerged: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uint64
+	for rows.Next() {
+		var id uint64
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("[GuestRepository]: FindMerged: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("[GuestRepository]: FindMerged: %w", err)
+	}
+	return ids, nil
+}
+
+func (s *Storage) DeleteGuest(ctx context.Context, tx *sql.Tx, id uint64) error {
+	res, err := execContext(ctx, tx, "DeleteGuest", `DELETE FROM guests WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("[GuestRepository]: Delete: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("[GuestRepository]: Delete: %w", err)
+	}
+	if n == 0 {
+		return entities.ErrNotFound
+	}
+	return nil
+}
+
+// EraseGuest обезличивает гостя: имя заменяется на entities.ErasedGuestName,
+// остальные персональные данные очищаются
+func (s *Storage) EraseGuest(ctx context.Context, tx *sql.Tx, id uint64) (entities.Guest, error) {
+	query := `
+        UPDATE guests
+        SET name                  = $2,
+            email                 = NULL,
+            phone                 = NULL,
+            date_of_birth         = NULL,
+            nationality           = NULL,
+            address               = NULL,
+            document_type         = NULL,
+            document_number       = NULL,
+            document_number_index = NULL,
+            document_country      = NULL,
+            document_expires_at   = NULL,
+            erased_at             = NOW(),
+            updated_at            = NOW()
+        WHERE id = $1
+        RETURNING ` + guestColumns
+	guest, err := s.scanGuest(queryRowContext(ctx, tx, "EraseGuest", query, id, entities.ErasedGuestName))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Guest{}, entities.ErrNotFound
+		}
+		return entities.Guest{}, fmt.Errorf("[GuestRepository]: Erase: %w", err)
+	}
 	return guest, nil
 }
+
+// LockNextInactiveGuest блокирует следующего необезличенного гостя, который не
+// менял профиль и не проживал дольше inactiveAfter. Гости, заблокированные
+// другими репликами, пропускаются. Если таких гостей нет, возвращает entities.ErrNotFound
+func (s *Storage) LockNextInactiveGuest(ctx context.Context, tx *sql.Tx, inactiveAfter time.Duration) (uint64, error) {
+	query := `
+        SELECT g.id
+        FROM guests g
+        WHERE g.erased_at IS NULL
+          AND g.updated_at < NOW() - make_interval(secs => $1)
+          AND NOT EXISTS (
+              SELECT 1
+              FROM bookings_guests bg
+              JOIN bookings b ON b.id = bg.booking_id
+              WHERE bg.guest_id = g.id AND b.end_date >= NOW() - make_interval(secs => $1))
+        ORDER BY g.id
+        LIMIT 1
+        FOR UPDATE OF g SKIP LOCKED
+    `
+	var id uint64
+	if err := queryRowContext(ctx, tx, "LockNextInactiveGuest", query, inactiveAfter.Seconds()).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, entities.ErrNotFound
+		}
+		return 0, fmt.Errorf("[GuestRepository]: LockNextInactive: %w", err)
+	}
+	return id, nil
+}
+
+// FindDuplicateGuests возвращает пары гостей с совпадающими email, телефоном,
+// документом или именем без учета регистра. Первыми идут пары, совпавшие по
+// контактам или документу, и пары с большим числом совпавших полей
+func (s *Storage) FindDuplicateGuests(ctx context.Context, tx *sql.Tx, limit int) ([]entities.DuplicateGuests, error) {
+	query := `
+        WITH pairs AS (
+            SELECT a.id AS guest_id, b.id AS duplicate_id, 'email' AS match_key
+            FROM guests a JOIN guests b ON b.email = a.email AND b.id > a.id
+            UNION ALL
+            SELECT a.id, b.id, 'phone'
+            FROM guests a JOIN guests b ON b.phone = a.phone AND b.id > a.id
+            UNION ALL
+            SELECT a.id, b.id, 'document'
+            FROM guests a JOIN guests b ON b.document_number_index = a.document_number_index AND b.id > a.id
+            UNION ALL
+            SELECT a.id, b.id, 'name'
+            FROM guests a JOIN guests b ON LOWER(b.name) = LOWER(a.name) AND b.id > a.id
+            WHERE a.erased_at IS NULL AND b.erased_at IS NULL
+        )
+        SELECT guest_id, duplicate_id, ARRAY_AGG(DISTINCT match_key ORDER BY match_key)
+        FROM pairs
+        GROUP BY guest_id, duplicate_id
+        ORDER BY BOOL_OR(match_key <> 'name') DESC, COUNT(*) DESC, guest_id, duplicate_id
+        LIMIT $1
+    `
+	rows, err := queryContext(ctx, tx, "FindDuplicateGuests", query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("[GuestRepository]: FindDuplicates: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		res []entities.DuplicateGuests
+		ids []uint64
+	)
+	for rows.Next() {
+		var (
+			d    entities.DuplicateGuests
+			keys pq.StringArray
+		)
+		if err = rows.Scan(&d.Guest.ID, &d.Duplicate.ID, &keys); err != nil {
+			return nil, fmt.Errorf("[GuestRepository]: FindDuplicates: %w", err)
+		}
+		for _, k := range keys {
+			d.MatchedBy = append(d.MatchedBy, entities.GuestMatchKey(k))
+		}
+		res = append(res, d)
+		ids = append(ids, d.Guest.ID, d.Duplicate.ID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("[GuestRepository]: FindDuplicates: %w", err)
+	}
+	if len(res) == 0 {
+		return res, nil
+	}
+
+	guests, err := s.findGuestsByIDs(ctx, tx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("[GuestRepository]: FindDuplicates: %w", err)
+	}
+	for i := range res {
+		res[i].Guest, res[i].Duplicate = guests[res[i].Guest.ID], guests[res[i].Duplicate.ID]
+	}
+	return res, nil
+}
+
+func (s *Storage) findGuestsByIDs(ctx context.Context, tx *sql.Tx, ids []uint64) (map[uint64]entities.Guest, error) {
+	query := `SELECT ` + guestColumns + ` FROM guests WHERE id = ANY($1::BIGINT[])`
+	rows, err := queryContext(ctx, tx, "findGuestsByIDs", query, pq.Array(toInt64s(ids)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[uint64]entities.Guest, len(ids))
+	for rows.Next() {
+		guest, err := s.scanGuest(rows)
+		if err != nil {
+			return nil, err
+		}
+		res[guest.ID] = guest
+	}
+	return res, rows.Err()
+}
+
+// FindGuestsByBookingIDs возвращает гостей, сгруппированных по бронированиям.
+// Профиль не загружается: у гостей бронирования только имя
+func (s *Storage) FindGuestsByBookingIDs(ctx context.Context, tx *sql.Tx, bookingIDs []uint64) (map[uint64][]entities.Guest, error) {
+	res := make(map[uint64][]entities.Guest, len(bookingIDs))
+	if len(bookingIDs) == 0 {
+		return res, nil
+	}
+
+	query := `
+        SELECT bg.booking_id, g.id, g.name, g.created_at, g.updated_at
+        FROM bookings_guests bg
+        JOIN guests g ON g.id = bg.guest_id
+        WHERE bg.booking_id = ANY($1::BIGINT[])
+        ORDER BY bg.booking_id, g.id
+    `
+	rows, err := queryContext(ctx, tx, "FindGuestsByBookingIDs", query, pq.Array(toInt64s(bookingIDs)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			bookingID uint64
+			guest     entities.Guest
+		)
+		if err := rows.Scan(&bookingID, &guest.ID, &guest.Name, &guest.CreatedAt, &guest.UpdatedAt); err != nil {
+			return nil, err
+		}
+		res[bookingID] = append(res[bookingID], guest)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *Storage) scanGuest(row scanner) (entities.Guest, error) {
+	var (
+		g                              entities.Guest
+		dateOfBirth, documentExpiresAt sql.NullTime
+		erasedAt                       sql.NullTime
+		address, documentNumber        []byte
+	)
+	err := row.Scan(&g.ID, &g.Name, &g.CreatedAt, &g.UpdatedAt, &g.Email, &g.Phone, &dateOfBirth,
+		&g.Nationality, &address, &g.DocumentType, &documentNumber, &g.DocumentCountry, &documentExpiresAt, &erasedAt)
+	if err != nil {
+		return g, err
+	}
+	g.DateOfBirth = dateOfBirth.Time
+	g.DocumentExpiresAt = documentExpiresAt.Time
+	g.ErasedAt = erasedAt.Time
+	if len(address) > 0 {
+		if err = json.Unmarshal(address, &g.Address); err != nil {
+			return g, fmt.Errorf("[GuestRepository]: unmarshal address: %w", err)
+		}
+	}
+	if g.DocumentNumber, err = s.pii.Decrypt(documentNumber); err != nil {
+		return g, fmt.Errorf("[GuestRepository]: guest %d: %w", g.ID, err)
+	}
+	return g, nil
+}
+
+func nullableAddress(a entities.Address) (any, error) {
+	if a.IsZero() {
+		return nil, nil
+	}
+	b, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// nullableString сохраняет пустую строку как NULL
+func nullableString(v string) sql.NullString {
+	return sql.NullString{String: v, Valid: v != ""}
+}
+
+// escapeLike экранирует спецсимволы шаблона LIKE
+func escapeLike(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(v)
+}
@@ -3,22 +3,26 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"time"
 
 	"booking-service/internal/entities"
 )
 
 func (s *Storage) SaveHotel(ctx context.Context, tx *sql.Tx, hotel entities.Hotel) (entities.Hotel, error) {
-	query := `INSERT INTO hotels (name, created_at, updated_at)
-				VALUES ($1, $2, $3)
-				RETURNING id`
+	query := `INSERT INTO hotels (name, currency, free_cancellation_hours, cancellation_penalty_nights, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6)
+				RETURNING id, created_at, updated_at`
 
-	err := tx.QueryRowContext(ctx,
+	err := queryRowContext(ctx, tx, "SaveHotel",
 		query,
 		hotel.Name,
+		hotel.Currency,
+		hotel.FreeCancellationHours,
+		hotel.CancellationPenaltyNights,
 		time.Now().UTC(),
 		time.Now().UTC(),
-	).Scan(&hotel.ID)
+	).Scan(&hotel.ID, &hotel.CreatedAt, &hotel.UpdatedAt)
 	if err != nil {
 		return hotel, err
 	}
@@ -28,15 +32,22 @@ func (s *Storage) SaveHotel(ctx context.Context, tx *sql.Tx, hotel entities.Hote
 
 func (s *Storage) FindHotelByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.Hotel, error) {
 	var hotel entities.Hotel
-	query := `SELECT (id, name, created_at, updated_at) FROM hotels WHERE id = $1`
+	query := `SELECT id, name, currency, free_cancellation_hours, cancellation_penalty_nights, created_at, updated_at
+				FROM hotels WHERE id = $1`
 
-	err := tx.QueryRowContext(ctx, query, id).Scan(
+	err := queryRowContext(ctx, tx, "FindHotelByID", query, id).Scan(
 		&hotel.ID,
 		&hotel.Name,
+		&hotel.Currency,
+		&hotel.FreeCancellationHours,
+		&hotel.CancellationPenaltyNights,
 		&hotel.CreatedAt,
 		&hotel.UpdatedAt,
 	)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Hotel{}, entities.ErrNotFound
+		}
 		return entities.Hotel{}, err
 	}
 
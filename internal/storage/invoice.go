@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"booking-service/internal/entities"
+)
+
+// NextInvoiceNumber выдает следующий номер счета отеля за финансовый год.
+// Строка счетчика остается заблокированной до конца транзакции, так что
+// параллельные счета получают номера по очереди, а откат возвращает номер
+func (s *Storage) NextInvoiceNumber(ctx context.Context, tx *sql.Tx, hotelID uint64, fiscalYear int) (int64, error) {
+	query := `
+		INSERT INTO invoice_sequences (hotel_id, fiscal_year, last_number)
+		VALUES ($1, $2, 1)
+		ON CONFLICT (hotel_id, fiscal_year) DO UPDATE SET last_number = invoice_sequences.last_number + 1
+		RETURNING last_number`
+
+	var n int64
+	if err := queryRowContext(ctx, tx, "NextInvoiceNumber", query, hotelID, fiscalYear).Scan(&n); err != nil {
+		return 0, fmt.Errorf("[InvoiceRepository]: NextNumber: %w", err)
+	}
+	return n, nil
+}
+
+// SaveInvoice сохраняет выставленный счет вместе с печатной формой
+func (s *Storage) SaveInvoice(
+	ctx context.Context, tx *sql.Tx, inv entities.Invoice, pdf []byte,
+) (entities.Invoice, error) {
+	document, err := json.Marshal(inv)
+	if err != nil {
+		return entities.Invoice{}, fmt.Errorf("[InvoiceRepository]: marshal: %w", err)
+	}
+
+	query := `
+		INSERT INTO invoices (hotel_id, booking_id, fiscal_year, sequence_number, number, issued_at, document, pdf)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id`
+
+	err = queryRowContext(ctx, tx, "SaveInvoice", query,
+		inv.HotelID, inv.BookingID, inv.FiscalYear, inv.SequenceNumber, inv.Number, inv.IssuedAt, document, pdf,
+	).Scan(&inv.ID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return entities.Invoice{}, entities.ErrAlreadyExists
+		}
+		return entities.Invoice{}, fmt.Errorf("[InvoiceRepository]: Save: %w", err)
+	}
+	return inv, nil
+}
+
+func (s *Storage) FindInvoiceByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.Invoice, error) {
+	var (
+		inv      entities.Invoice
+		document []byte
+	)
+	err := queryRowContext(ctx, tx, "FindInvoiceByID", `SELECT id, document FROM invoices WHERE id = $1`, id).
+		Scan(&inv.ID, &document)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Invoice{}, entities.ErrNotFound
+		}
+		return entities.Invoice{}, fmt.Errorf("[InvoiceRepository]: FindByID: %w", err)
+	}
+	if err = json.Unmarshal(document, &inv); err != nil {
+		return entities.Invoice{}, fmt.Errorf("[InvoiceRepository]: unmarshal: %w", err)
+	}
+	return inv, nil
+}
+
+// FindInvoiceFile возвращает номер счета и сохраненный документ в формате format
+func (s *Storage) FindInvoiceFile(
+	ctx context.Context, tx *sql.Tx, id uint64, format entities.InvoiceFormat,
+) (string, []byte, error) {
+	column := "document"
+	if format == entities.InvoiceFormatPDF {
+		column = "pdf"
+	}
+
+	var (
+		number string
+		data   []byte
+	)
+	query := `SELECT number, ` + column + ` FROM invoices WHERE id = $1`
+	if err := queryRowContext(ctx, tx, "FindInvoiceFile", query, id).Scan(&number, &data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil, entities.ErrNotFound
+		}
+		return "", nil, fmt.Errorf("[InvoiceRepository]: FindFile: %w", err)
+	}
+	return number, data, nil
+}
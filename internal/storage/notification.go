@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"booking-service/internal/entities"
+)
+
+// SaveNotification ставит уведомление в outbox
+func (s *Storage) SaveNotification(ctx context.Context, tx *sql.Tx, n entities.Notification) (entities.Notification, error) {
+	query := `
+		INSERT INTO notifications (kind, booking_id, payload)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at`
+
+	err := queryRowContext(ctx, tx, "SaveNotification", query, n.Kind, nullableID(n.BookingID), string(n.Payload)).
+		Scan(&n.ID, &n.CreatedAt)
+	if err != nil {
+		return entities.Notification{}, fmt.Errorf("[NotificationRepository]: Save: %w", err)
+	}
+	return n, nil
+}
+
+// LockPendingNotifications блокирует до конца транзакции неотправленные
+// уведомления в порядке создания; заблокированные другими репликами пропускаются
+func (s *Storage) LockPendingNotifications(ctx context.Context, tx *sql.Tx, limit int) ([]entities.Notification, error) {
+	query := `
+		SELECT id, kind, COALESCE(booking_id, 0), payload, created_at
+		FROM notifications
+		WHERE sent_at IS NULL
+		ORDER BY id
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`
+
+	rows, err := queryContext(ctx, tx, "LockPendingNotifications", query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("[NotificationRepository]: LockPending: %w", err)
+	}
+	defer rows.Close()
+
+	res := make([]entities.Notification, 0)
+	for rows.Next() {
+		var n entities.Notification
+		if err = rows.Scan(&n.ID, &n.Kind, &n.BookingID, &n.Payload, &n.CreatedAt); err != nil {
+			return nil, fmt.Errorf("[NotificationRepository]: LockPending: %w", err)
+		}
+		res = append(res, n)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("[NotificationRepository]: LockPending: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) MarkNotificationSent(ctx context.Context, tx *sql.Tx, id uint64) error {
+	query := `UPDATE notifications SET sent_at = NOW(), attempts = attempts + 1, last_error = NULL WHERE id = $1`
+	if _, err := execContext(ctx, tx, "MarkNotificationSent", query, id); err != nil {
+		return fmt.Errorf("[NotificationRepository]: MarkSent: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) MarkNotificationFailed(ctx context.Context, tx *sql.Tx, id uint64, reason string) error {
+	query := `UPDATE notifications SET attempts = attempts + 1, last_error = $2 WHERE id = $1`
+	if _, err := execContext(ctx, tx, "MarkNotificationFailed", query, id, reason); err != nil {
+		return fmt.Errorf("[NotificationRepository]: MarkFailed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"booking-service/internal/entities"
+)
+
+const paymentColumns = `id, booking_id, folio_id, amount, currency, status, payment_date, created_at, updated_at`
+
+func (s *Storage) SavePayment(ctx context.Context, tx *sql.Tx, p entities.Payment) (entities.Payment, error) {
+	query := `
+		INSERT INTO payments (booking_id, folio_id, amount, currency, status, payment_date)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + paymentColumns
+
+	res, err := scanPayment(queryRowContext(ctx, tx, "SavePayment", query,
+		p.BookingID, nullableID(p.FolioID), p.Amount.Amount, p.Amount.Currency, p.Status, p.PaymentDate,
+	))
+	if err != nil {
+		return entities.Payment{}, fmt.Errorf("[PaymentRepository]: Save: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindPaymentsByBookingID(ctx context.Context, tx *sql.Tx, bookingID uint64) ([]entities.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE booking_id = $1 ORDER BY id`
+
+	rows, err := queryContext(ctx, tx, "FindPaymentsByBookingID", query, bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("[PaymentRepository]: FindByBookingID: %w", err)
+	}
+	defer rows.Close()
+
+	res := make([]entities.Payment, 0)
+	for rows.Next() {
+		p, err := scanPayment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("[PaymentRepository]: FindByBookingID: %w", err)
+		}
+		res = append(res, p)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("[PaymentRepository]: FindByBookingID: %w", err)
+	}
+	return res, nil
+}
+
+func scanPayment(row scanner) (entities.Payment, error) {
+	var (
+		p       entities.Payment
+		folioID sql.NullInt64
+	)
+	err := row.Scan(&p.ID, &p.BookingID, &folioID, &p.Amount.Amount, &p.Amount.Currency, &p.Status,
+		&p.PaymentDate, &p.CreatedAt, &p.UpdatedAt)
+	p.FolioID = uint64(folioID.Int64)
+	return p, err
+}
@@ -0,0 +1,174 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking-service/internal/entities"
+)
+
+const pricingRuleColumns = `hotel_id, room_type, strategy, occupancy_tiers, min_price, max_price, currency, updated_at`
+
+// SavePricingRule создает или заменяет правило типа номера
+func (s *Storage) SavePricingRule(ctx context.Context, tx *sql.Tx, rule entities.PricingRule) (entities.PricingRule, error) {
+	tiers, err := json.Marshal(rule.OccupancyTiers)
+	if err != nil {
+		return entities.PricingRule{}, fmt.Errorf("[PricingRuleRepository]: marshal tiers: %w", err)
+	}
+	// у ограничений одна валюта, она проверяется при валидации
+	currency := rule.MinPrice.Currency
+	if currency == "" {
+		currency = rule.MaxPrice.Currency
+	}
+
+	query := `
+		INSERT INTO pricing_rules (hotel_id, room_type, strategy, occupancy_tiers, min_price, max_price, currency)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (hotel_id, room_type) DO UPDATE
+		    SET strategy        = EXCLUDED.strategy,
+		        occupancy_tiers = EXCLUDED.occupancy_tiers,
+		        min_price       = EXCLUDED.min_price,
+		        max_price       = EXCLUDED.max_price,
+		        currency        = EXCLUDED.currency,
+		        updated_at      = NOW()
+		RETURNING ` + pricingRuleColumns
+
+	res, err := scanPricingRule(queryRowContext(ctx, tx, "SavePricingRule", query,
+		rule.HotelID, rule.RoomType, rule.Strategy, string(tiers),
+		nullableAmount(rule.MinPrice.Amount), nullableAmount(rule.MaxPrice.Amount), sql.NullString{
+			String: currency, Valid: currency != "",
+		},
+	))
+	if err != nil {
+		return entities.PricingRule{}, fmt.Errorf("[PricingRuleRepository]: Save: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindPricingRule(
+	ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string,
+) (entities.PricingRule, error) {
+	query := `SELECT ` + pricingRuleColumns + ` FROM pricing_rules WHERE hotel_id = $1 AND room_type = $2`
+
+	res, err := scanPricingRule(queryRowContext(ctx, tx, "FindPricingRule", query, hotelID, roomType))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.PricingRule{}, entities.ErrNotFound
+		}
+		return entities.PricingRule{}, fmt.Errorf("[PricingRuleRepository]: Find: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindPricingRulesByHotelID(ctx context.Context, tx *sql.Tx, hotelID uint64) ([]entities.PricingRule, error) {
+	query := `SELECT ` + pricingRuleColumns + ` FROM pricing_rules WHERE hotel_id = $1 ORDER BY room_type`
+
+	rows, err := queryContext(ctx, tx, "FindPricingRulesByHotelID", query, hotelID)
+	if err != nil {
+		return nil, fmt.Errorf("[PricingRuleRepository]: FindByHotelID: %w", err)
+	}
+	defer rows.Close()
+
+	res := make([]entities.PricingRule, 0)
+	for rows.Next() {
+		rule, err := scanPricingRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("[PricingRuleRepository]: FindByHotelID: %w", err)
+		}
+		res = append(res, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("[PricingRuleRepository]: FindByHotelID: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) DeletePricingRule(ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string) error {
+	res, err := execContext(ctx, tx, "DeletePricingRule",
+		`DELETE FROM pricing_rules WHERE hotel_id = $1 AND room_type = $2`, hotelID, roomType)
+	if err != nil {
+		return fmt.Errorf("[PricingRuleRepository]: Delete: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.ErrNotFound
+	}
+	return nil
+}
+
+// FindOccupancy считает номера типа (кроме выведенных из эксплуатации) и
+// число занятых из них в каждую ночь [start, end)
+func (s *Storage) FindOccupancy(
+	ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string, start, end time.Time,
+) (entities.Occupancy, error) {
+	res := entities.Occupancy{Booked: make(map[time.Time]int)}
+
+	query := `SELECT COUNT(*) FROM rooms WHERE hotel_id = $1 AND type = $2 AND status <> $3`
+	if err := queryRowContext(ctx, tx, "FindOccupancy", query,
+		hotelID, roomType, entities.RoomStatusOutOfService,
+	).Scan(&res.Rooms); err != nil {
+		return res, fmt.Errorf("[PricingRuleRepository]: FindOccupancy: %w", err)
+	}
+
+	query = `
+		SELECT b.start_date::date, b.end_date::date
+		FROM bookings b
+		JOIN rooms r ON r.id = b.room_id
+		WHERE r.hotel_id = $1
+		  AND r.type = $2
+		  AND b.status IN (1, 3)
+		  AND b.start_date < $4
+		  AND b.end_date > $3`
+	rows, err := queryContext(ctx, tx, "FindOccupancy", query, hotelID, roomType, start, end)
+	if err != nil {
+		return res, fmt.Errorf("[PricingRuleRepository]: FindOccupancy: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var from, to time.Time
+		if err := rows.Scan(&from, &to); err != nil {
+			return res, fmt.Errorf("[PricingRuleRepository]: FindOccupancy: %w", err)
+		}
+		for d := from.UTC(); d.Before(to); d = d.AddDate(0, 0, 1) {
+			if !d.Before(start) && d.Before(end) {
+				res.Booked[d]++
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return res, fmt.Errorf("[PricingRuleRepository]: FindOccupancy: %w", err)
+	}
+	return res, nil
+}
+
+func scanPricingRule(row scanner) (entities.PricingRule, error) {
+	var (
+		r                  entities.PricingRule
+		tiers              []byte
+		minPrice, maxPrice sql.NullInt64
+		currency           sql.NullString
+	)
+	if err := row.Scan(&r.HotelID, &r.RoomType, &r.Strategy, &tiers, &minPrice, &maxPrice, &currency,
+		&r.UpdatedAt); err != nil {
+		return r, err
+	}
+	if err := json.Unmarshal(tiers, &r.OccupancyTiers); err != nil {
+		return r, fmt.Errorf("unmarshal tiers: %w", err)
+	}
+	if minPrice.Valid {
+		r.MinPrice = entities.Money{Amount: minPrice.Int64, Currency: currency.String}
+	}
+	if maxPrice.Valid {
+		r.MaxPrice = entities.Money{Amount: maxPrice.Int64, Currency: currency.String}
+	}
+	return r, nil
+}
+
+// nullableAmount хранит нулевую сумму как NULL
+func nullableAmount(amount int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: amount, Valid: amount != 0}
+}
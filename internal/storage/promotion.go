@@ -0,0 +1,178 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"booking-service/internal/entities"
+
+	"github.com/lib/pq"
+)
+
+const promotionColumns = `id, code, hotel_id, room_types, discount_type, percent_off, amount_off, currency,
+    stay_from, stay_to, min_nights, book_from, book_to, max_redemptions, redemptions, created_at, updated_at`
+
+func (s *Storage) SavePromotion(ctx context.Context, tx *sql.Tx, p entities.Promotion) (entities.Promotion, error) {
+	query := `
+		INSERT INTO promotions (code, hotel_id, room_types, discount_type, percent_off, amount_off, currency,
+		                        stay_from, stay_to, min_nights, book_from, book_to, max_redemptions)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING ` + promotionColumns
+
+	res, err := scanPromotion(queryRowContext(ctx, tx, "SavePromotion", query,
+		p.Code, nullableID(p.HotelID), pq.Array(p.RoomTypes), p.DiscountType, p.PercentOff,
+		p.AmountOff.Amount, p.AmountOff.Currency, nullableTime(p.StayFrom), nullableTime(p.StayTo), p.MinNights,
+		nullableTime(p.BookFrom), nullableTime(p.BookTo), p.MaxRedemptions,
+	))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return entities.Promotion{}, entities.ErrAlreadyExists
+		}
+		return entities.Promotion{}, fmt.Errorf("[PromotionRepository]: Save: %w", err)
+	}
+	return res, nil
+}
+
+// UpdatePromotion меняет условия промокода; счетчик использований не меняется
+func (s *Storage) UpdatePromotion(ctx context.Context, tx *sql.Tx, p entities.Promotion) (entities.Promotion, error) {
+	query := `
+		UPDATE promotions
+		SET code = $2, hotel_id = $3, room_types = $4, discount_type = $5, percent_off = $6, amount_off = $7,
+		    currency = $8, stay_from = $9, stay_to = $10, min_nights = $11, book_from = $12, book_to = $13,
+		    max_redemptions = $14, updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + promotionColumns
+
+	res, err := scanPromotion(queryRowContext(ctx, tx, "UpdatePromotion", query,
+		p.ID, p.Code, nullableID(p.HotelID), pq.Array(p.RoomTypes), p.DiscountType, p.PercentOff,
+		p.AmountOff.Amount, p.AmountOff.Currency, nullableTime(p.StayFrom), nullableTime(p.StayTo), p.MinNights,
+		nullableTime(p.BookFrom), nullableTime(p.BookTo), p.MaxRedemptions,
+	))
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return entities.Promotion{}, entities.ErrNotFound
+		case isUniqueViolation(err):
+			return entities.Promotion{}, entities.ErrAlreadyExists
+		}
+		return entities.Promotion{}, fmt.Errorf("[PromotionRepository]: Update: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindPromotionByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.Promotion, error) {
+	query := `SELECT ` + promotionColumns + ` FROM promotions WHERE id = $1`
+
+	res, err := scanPromotion(queryRowContext(ctx, tx, "FindPromotionByID", query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Promotion{}, entities.ErrNotFound
+		}
+		return entities.Promotion{}, fmt.Errorf("[PromotionRepository]: FindByID: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindPromotionByCode(ctx context.Context, tx *sql.Tx, code string) (entities.Promotion, error) {
+	query := `SELECT ` + promotionColumns + ` FROM promotions WHERE code = $1`
+
+	res, err := scanPromotion(queryRowContext(ctx, tx, "FindPromotionByCode", query, code))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Promotion{}, entities.ErrNotFound
+		}
+		return entities.Promotion{}, fmt.Errorf("[PromotionRepository]: FindByCode: %w", err)
+	}
+	return res, nil
+}
+
+// FindPromotions возвращает промокоды отеля вместе с действующими во всех отелях;
+// при нулевом hotelID — все промокоды
+func (s *Storage) FindPromotions(ctx context.Context, tx *sql.Tx, hotelID uint64) ([]entities.Promotion, error) {
+	query := `
+		SELECT ` + promotionColumns + `
+		FROM promotions
+		WHERE $1 = 0 OR hotel_id = $1 OR hotel_id IS NULL
+		ORDER BY id`
+
+	rows, err := queryContext(ctx, tx, "FindPromotions", query, int64(hotelID))
+	if err != nil {
+		return nil, fmt.Errorf("[PromotionRepository]: Find: %w", err)
+	}
+	defer rows.Close()
+
+	res := make([]entities.Promotion, 0)
+	for rows.Next() {
+		p, err := scanPromotion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("[PromotionRepository]: Find: %w", err)
+		}
+		res = append(res, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("[PromotionRepository]: Find: %w", err)
+	}
+	return res, nil
+}
+
+// RedeemPromotion засчитывает использование промокода. Проверка лимита и
+// увеличение счетчика — один UPDATE, поэтому параллельные бронирования не
+// превысят max_redemptions
+func (s *Storage) RedeemPromotion(ctx context.Context, tx *sql.Tx, id uint64) error {
+	query := `
+		UPDATE promotions
+		SET redemptions = redemptions + 1
+		WHERE id = $1
+		  AND (max_redemptions = 0 OR redemptions < max_redemptions)
+		RETURNING redemptions`
+
+	var redemptions int
+	err := queryRowContext(ctx, tx, "RedeemPromotion", query, id).Scan(&redemptions)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("[PromotionRepository]: Redeem: %w", err)
+	}
+
+	// строка не обновилась: промокод удален или лимит исчерпан
+	var exists bool
+	err = queryRowContext(ctx, tx, "RedeemPromotion",
+		`SELECT EXISTS(SELECT 1 FROM promotions WHERE id = $1)`, id).Scan(&exists)
+	switch {
+	case err != nil:
+		return fmt.Errorf("[PromotionRepository]: Redeem: %w", err)
+	case !exists:
+		return entities.ErrPromoCodeNotFound
+	default:
+		return entities.ErrPromoCodeExhausted
+	}
+}
+
+func (s *Storage) DeletePromotion(ctx context.Context, tx *sql.Tx, id uint64) error {
+	res, err := execContext(ctx, tx, "DeletePromotion", `DELETE FROM promotions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("[PromotionRepository]: Delete: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.ErrNotFound
+	}
+	return nil
+}
+
+func scanPromotion(row scanner) (entities.Promotion, error) {
+	var (
+		p                                  entities.Promotion
+		hotelID                            sql.NullInt64
+		stayFrom, stayTo, bookFrom, bookTo sql.NullTime
+	)
+	err := row.Scan(&p.ID, &p.Code, &hotelID, pq.Array(&p.RoomTypes), &p.DiscountType, &p.PercentOff,
+		&p.AmountOff.Amount, &p.AmountOff.Currency, &stayFrom, &stayTo, &p.MinNights, &bookFrom, &bookTo,
+		&p.MaxRedemptions, &p.Redemptions, &p.CreatedAt, &p.UpdatedAt)
+	p.HotelID = uint64(hotelID.Int64)
+	p.StayFrom, p.StayTo = stayFrom.Time, stayTo.Time
+	p.BookFrom, p.BookTo = bookFrom.Time, bookTo.Time
+	return p, err
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"booking-service/internal/entities"
+
+	"github.com/lib/pq"
+)
+
+// SaveRateOverrides ставит одну цену на все даты; существующие цены на эти даты заменяются
+func (s *Storage) SaveRateOverrides(
+	ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string, dates []time.Time, price entities.Money,
+) error {
+	query := `
+		INSERT INTO rate_calendar (hotel_id, room_type, date, price, currency)
+		SELECT $1, $2, d, $4, $5 FROM unnest($3::date[]) AS d
+		ON CONFLICT (hotel_id, room_type, date)
+		    DO UPDATE SET price = EXCLUDED.price, currency = EXCLUDED.currency, updated_at = NOW()`
+
+	if _, err := execContext(ctx, tx, "SaveRateOverrides", query,
+		hotelID, roomType, pq.Array(toDates(dates)), price.Amount, price.Currency,
+	); err != nil {
+		return fmt.Errorf("[RateCalendarRepository]: Save: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) DeleteRateOverrides(
+	ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string, dates []time.Time,
+) error {
+	query := `DELETE FROM rate_calendar WHERE hotel_id = $1 AND room_type = $2 AND date = ANY($3::date[])`
+
+	if _, err := execContext(ctx, tx, "DeleteRateOverrides", query,
+		hotelID, roomType, pq.Array(toDates(dates)),
+	); err != nil {
+		return fmt.Errorf("[RateCalendarRepository]: Delete: %w", err)
+	}
+	return nil
+}
+
+// FindRateOverrides возвращает цены календаря на ночи [start, end)
+func (s *Storage) FindRateOverrides(
+	ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string, start, end time.Time,
+) ([]entities.RateOverride, error) {
+	query := `
+		SELECT hotel_id, room_type, date, price, currency, updated_at
+		FROM rate_calendar
+		WHERE hotel_id = $1 AND room_type = $2 AND date >= $3 AND date < $4
+		ORDER BY date`
+
+	rows, err := queryContext(ctx, tx, "FindRateOverrides", query, hotelID, roomType, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("[RateCalendarRepository]: Find: %w", err)
+	}
+	defer rows.Close()
+
+	res := make([]entities.RateOverride, 0)
+	for rows.Next() {
+		var o entities.RateOverride
+		if err := rows.Scan(&o.HotelID, &o.RoomType, &o.Date, &o.Price.Amount, &o.Price.Currency, &o.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("[RateCalendarRepository]: Find: %w", err)
+		}
+		res = append(res, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("[RateCalendarRepository]: Find: %w", err)
+	}
+	return res, nil
+}
+
+// toDates готовит даты для pq.Array: драйвер не умеет массивы time.Time
+func toDates(dates []time.Time) []string {
+	res := make([]string, 0, len(dates))
+	for _, d := range dates {
+		res = append(res, d.Format(time.DateOnly))
+	}
+	return res
+}
@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking-service/internal/entities"
+)
+
+const ratePlanColumns = `id, hotel_id, room_type, name, nightly_price, currency, valid_from, valid_to, created_at, updated_at`
+
+func (s *Storage) SaveRatePlan(ctx context.Context, tx *sql.Tx, plan entities.RatePlan) (entities.RatePlan, error) {
+	query := `
+		INSERT INTO rate_plans (hotel_id, room_type, name, nightly_price, currency, valid_from, valid_to)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + ratePlanColumns
+
+	res, err := scanRatePlan(queryRowContext(ctx, tx, "SaveRatePlan", query,
+		plan.HotelID, plan.RoomType, plan.Name, plan.NightlyPrice.Amount, plan.NightlyPrice.Currency,
+		plan.ValidFrom, nullableTime(plan.ValidTo),
+	))
+	if err != nil {
+		return entities.RatePlan{}, fmt.Errorf("[RatePlanRepository]: Save: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) UpdateRatePlan(ctx context.Context, tx *sql.Tx, plan entities.RatePlan) (entities.RatePlan, error) {
+	query := `
+		UPDATE rate_plans
+		SET room_type = $2, name = $3, nightly_price = $4, currency = $5, valid_from = $6, valid_to = $7,
+		    updated_at = NOW()
+		WHERE id = $1
+		RETURNING ` + ratePlanColumns
+
+	res, err := scanRatePlan(queryRowContext(ctx, tx, "UpdateRatePlan", query,
+		plan.ID, plan.RoomType, plan.Name, plan.NightlyPrice.Amount, plan.NightlyPrice.Currency,
+		plan.ValidFrom, nullableTime(plan.ValidTo),
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.RatePlan{}, entities.ErrNotFound
+		}
+		return entities.RatePlan{}, fmt.Errorf("[RatePlanRepository]: Update: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindRatePlanByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.RatePlan, error) {
+	query := `SELECT ` + ratePlanColumns + ` FROM rate_plans WHERE id = $1`
+
+	res, err := scanRatePlan(queryRowContext(ctx, tx, "FindRatePlanByID", query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.RatePlan{}, entities.ErrNotFound
+		}
+		return entities.RatePlan{}, fmt.Errorf("[RatePlanRepository]: FindByID: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindRatePlansByHotelID(ctx context.Context, tx *sql.Tx, hotelID uint64) ([]entities.RatePlan, error) {
+	query := `SELECT ` + ratePlanColumns + ` FROM rate_plans WHERE hotel_id = $1 ORDER BY room_type, valid_from, id`
+
+	rows, err := queryContext(ctx, tx, "FindRatePlansByHotelID", query, hotelID)
+	if err != nil {
+		return nil, fmt.Errorf("[RatePlanRepository]: FindByHotelID: %w", err)
+	}
+	return scanRatePlans(rows)
+}
+
+// FindRatePlansForStay возвращает тарифы типа номера, пересекающиеся с ночами [start, end).
+func (s *Storage) FindRatePlansForStay(
+	ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string, start, end time.Time,
+) ([]entities.RatePlan, error) {
+	query := `
+		SELECT ` + ratePlanColumns + `
+		FROM rate_plans
+		WHERE hotel_id = $1
+		  AND room_type = $2
+		  AND valid_from < $4
+		  AND (valid_to IS NULL OR valid_to >= $3)
+		ORDER BY valid_from, id`
+
+	rows, err := queryContext(ctx, tx, "FindRatePlansForStay", query, hotelID, roomType, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("[RatePlanRepository]: FindForStay: %w", err)
+	}
+	return scanRatePlans(rows)
+}
+
+func (s *Storage) DeleteRatePlan(ctx context.Context, tx *sql.Tx, id uint64) error {
+	res, err := execContext(ctx, tx, "DeleteRatePlan", `DELETE FROM rate_plans WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("[RatePlanRepository]: Delete: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.ErrNotFound
+	}
+	return nil
+}
+
+func scanRatePlan(row scanner) (entities.RatePlan, error) {
+	var (
+		p       entities.RatePlan
+		validTo sql.NullTime
+	)
+	err := row.Scan(&p.ID, &p.HotelID, &p.RoomType, &p.Name, &p.NightlyPrice.Amount, &p.NightlyPrice.Currency,
+		&p.ValidFrom, &validTo, &p.CreatedAt, &p.UpdatedAt)
+	p.ValidTo = validTo.Time
+	return p, err
+}
+
+func scanRatePlans(rows *tracedRows) ([]entities.RatePlan, error) {
+	defer rows.Close()
+
+	res := make([]entities.RatePlan, 0)
+	for rows.Next() {
+		p, err := scanRatePlan(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,169 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"booking-service/internal/entities"
+
+	"github.com/lib/pq"
+)
+
+// FindBookingsToReconcile возвращает бронирования, которые пора сверить: измененные
+// за opts.Lookback, ожидающие оплаты или остатка к сроку и с открытыми расхождениями. Сверенное
+// бронирование не выбирается повторно раньше opts.RecheckAfter
+func (s *Storage) FindBookingsToReconcile(
+	ctx context.Context, tx *sql.Tx, opts entities.ReconciliationOptions,
+) ([]uint64, error) {
+	query := `
+        SELECT id
+        FROM bookings
+        WHERE (reconciled_at IS NULL OR reconciled_at < NOW() - make_interval(secs => $1))
+          AND (updated_at >= NOW() - make_interval(secs => $2)
+               OR (status <> $3 AND payment_status <> $4
+                   AND (start_date <= NOW() + make_interval(secs => $5) OR balance_due_at <= NOW())
+                   AND end_date >= NOW() - make_interval(secs => $2))
+               OR id IN (SELECT booking_id FROM reconciliation_issues WHERE resolved_at IS NULL))
+        ORDER BY reconciled_at NULLS FIRST, id
+        LIMIT $6
+    `
+	rows, err := queryContext(ctx, tx, "FindBookingsToReconcile", query,
+		opts.RecheckAfter.Seconds(), opts.Lookback.Seconds(), entities.BookingStatusCancelled,
+		entities.BookingPaymentStatusPaid, opts.PaymentDueBefore.Seconds(), opts.BatchSize,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[ReconciliationRepository]: FindBookings: %w", err)
+	}
+	defer rows.Close()
+
+	res := make([]uint64, 0)
+	for rows.Next() {
+		var id uint64
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("[ReconciliationRepository]: FindBookings: %w", err)
+		}
+		res = append(res, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("[ReconciliationRepository]: FindBookings: %w", err)
+	}
+	return res, nil
+}
+
+// MarkBookingReconciled запоминает время сверки, не трогая updated_at
+func (s *Storage) MarkBookingReconciled(ctx context.Context, tx *sql.Tx, bookingID uint64) error {
+	query := `UPDATE bookings SET reconciled_at = NOW() WHERE id = $1`
+	if _, err := execContext(ctx, tx, "MarkBookingReconciled", query, bookingID); err != nil {
+		return fmt.Errorf("[ReconciliationRepository]: MarkReconciled: %w", err)
+	}
+	return nil
+}
+
+// SyncReconciliationIssues приводит открытые расхождения бронирования к найденным:
+// новые открываются, у уже открытых обновляется описание, пропавшие закрываются
+func (s *Storage) SyncReconciliationIssues(
+	ctx context.Context, tx *sql.Tx, bookingID, hotelID uint64, issues []entities.ReconciliationIssue,
+) error {
+	kinds := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		kinds = append(kinds, string(issue.Kind))
+	}
+
+	query := `
+        UPDATE reconciliation_issues
+        SET resolved_at = NOW()
+        WHERE booking_id = $1 AND resolved_at IS NULL AND kind <> ALL($2::VARCHAR[])
+    `
+	if _, err := execContext(ctx, tx, "SyncReconciliationIssues", query, bookingID, pq.Array(kinds)); err != nil {
+		return fmt.Errorf("[ReconciliationRepository]: resolve issues: %w", err)
+	}
+
+	query = `
+        INSERT INTO reconciliation_issues (booking_id, hotel_id, kind, details)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (booking_id, kind) WHERE resolved_at IS NULL DO UPDATE SET details = EXCLUDED.details
+    `
+	for _, issue := range issues {
+		if _, err := execContext(ctx, tx, "SyncReconciliationIssues", query,
+			bookingID, hotelID, issue.Kind, issue.Details,
+		); err != nil {
+			return fmt.Errorf("[ReconciliationRepository]: open issue: %w", err)
+		}
+	}
+	return nil
+}
+
+// FindReconciliationIssues возвращает расхождения, новые первыми
+func (s *Storage) FindReconciliationIssues(
+	ctx context.Context, tx *sql.Tx, filter entities.ReconciliationFilter,
+) ([]entities.ReconciliationIssue, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	if filter.HotelID != 0 {
+		args = append(args, filter.HotelID)
+		conds = append(conds, fmt.Sprintf("hotel_id = $%d", len(args)))
+	}
+	if !filter.IncludeResolved {
+		conds = append(conds, "resolved_at IS NULL")
+	}
+
+	query := `
+        SELECT id, booking_id, hotel_id, kind, details, detected_at, resolved_at
+        FROM reconciliation_issues`
+	if len(conds) > 0 {
+		query += "\n        WHERE " + strings.Join(conds, " AND ")
+	}
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf("\n        ORDER BY detected_at DESC, id DESC\n        LIMIT $%d", len(args))
+
+	rows, err := queryContext(ctx, tx, "FindReconciliationIssues", query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("[ReconciliationRepository]: FindIssues: %w", err)
+	}
+	defer rows.Close()
+
+	res := make([]entities.ReconciliationIssue, 0)
+	for rows.Next() {
+		var (
+			issue    entities.ReconciliationIssue
+			resolved sql.NullTime
+		)
+		if err = rows.Scan(&issue.ID, &issue.BookingID, &issue.HotelID, &issue.Kind, &issue.Details,
+			&issue.DetectedAt, &resolved); err != nil {
+			return nil, fmt.Errorf("[ReconciliationRepository]: FindIssues: %w", err)
+		}
+		issue.ResolvedAt = resolved.Time
+		res = append(res, issue)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("[ReconciliationRepository]: FindIssues: %w", err)
+	}
+	return res, nil
+}
+
+// AcquireJobLease берет или продлевает аренду задачи name на ttl. Возвращает
+// false, если аренду держит другая реплика и срок еще не истек
+func (s *Storage) AcquireJobLease(
+	ctx context.Context, tx *sql.Tx, name, holder string, ttl time.Duration,
+) (bool, error) {
+	query := `
+        INSERT INTO job_leases (name, holder, expires_at)
+        VALUES ($1, $2, NOW() + make_interval(secs => $3))
+        ON CONFLICT (name) DO UPDATE SET holder = EXCLUDED.holder, expires_at = EXCLUDED.expires_at
+        WHERE job_leases.expires_at < NOW() OR job_leases.holder = EXCLUDED.holder
+    `
+	res, err := execContext(ctx, tx, "AcquireJobLease", query, name, holder, ttl.Seconds())
+	if err != nil {
+		return false, fmt.Errorf("[JobLeaseRepository]: Acquire: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("[JobLeaseRepository]: Acquire: %w", err)
+	}
+	return n > 0, nil
+}
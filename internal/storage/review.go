@@ -3,17 +3,18 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"booking-service/internal/entities"
 )
 
 func (s *Storage) SaveReview(ctx context.Context, tx *sql.Tx, review entities.Review) (entities.Review, error) {
 	query := `
-        INSERT INTO reviews (booking_id, rating, comment)
-        VALUES ($1, $2, $3)
+        INSERT INTO reviews (booking_id, guest_id, rating, comment)
+        VALUES ($1, $2, $3, $4)
         RETURNING id, created_at, updated_at
     `
-	err := tx.QueryRowContext(ctx, query, review.BookingID, review.Rating, review.Comment).
+	err := queryRowContext(ctx, tx, "SaveReview", query, review.BookingID, nullableID(review.GuestID), review.Rating, review.Comment).
 		Scan(&review.ID, &review.CreatedAt, &review.UpdatedAt)
 	if err != nil {
 		return entities.Review{}, err
@@ -21,3 +22,41 @@ func (s *Storage) SaveReview(ctx context.Context, tx *sql.Tx, review entities.Re
 
 	return review, nil
 }
+
+// FindReviewsByGuestID возвращает отзывы гостя, новые первыми
+func (s *Storage) FindReviewsByGuestID(ctx context.Context, tx *sql.Tx, guestID uint64) ([]entities.Review, error) {
+	query := `
+        SELECT id, created_at, updated_at, booking_id, guest_id, rating, COALESCE(comment, '')
+        FROM reviews
+        WHERE guest_id = $1
+        ORDER BY created_at DESC, id DESC
+    `
+	rows, err := queryContext(ctx, tx, "FindReviewsByGuestID", query, guestID)
+	if err != nil {
+		return nil, fmt.Errorf("[ReviewRepository]: FindByGuestID: %w", err)
+	}
+	defer rows.Close()
+
+	res := make([]entities.Review, 0)
+	for rows.Next() {
+		var r entities.Review
+		if err = rows.Scan(&r.ID, &r.CreatedAt, &r.UpdatedAt, &r.BookingID, &r.GuestID, &r.Rating, &r.Comment); err != nil {
+			return nil, fmt.Errorf("[ReviewRepository]: FindByGuestID: %w", err)
+		}
+		res = append(res, r)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("[ReviewRepository]: FindByGuestID: %w", err)
+	}
+	return res, nil
+}
+
+// EraseGuestReviews удаляет текст отзывов гостя: в нем обычно есть имя гостя и
+// другие персональные данные. Оценки остаются в рейтинге отеля
+func (s *Storage) EraseGuestReviews(ctx context.Context, tx *sql.Tx, guestID uint64) error {
+	query := `UPDATE reviews SET comment = NULL, updated_at = NOW() WHERE guest_id = $1 AND comment IS NOT NULL`
+	if _, err := execContext(ctx, tx, "EraseGuestReviews", query, guestID); err != nil {
+		return fmt.Errorf("[ReviewRepository]: EraseByGuestID: %w", err)
+	}
+	return nil
+}
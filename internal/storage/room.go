@@ -3,7 +3,9 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	"booking-service/internal/entities"
 )
@@ -11,23 +13,47 @@ import (
 func (s *Storage) FindRoomById(ctx context.Context, tx *sql.Tx, roomId int64) (entities.Room, error) {
 	var room entities.Room
 	query := `
-		SELECT id, number, type, hotel_id, created_at, updated_at
+		SELECT id, number, type, hotel_id, status, created_at, updated_at
 		FROM rooms
 		WHERE id = $1
 	`
-	if err := tx.QueryRowContext(ctx, query, roomId).Scan(&room); err != nil {
+	if err := queryRowContext(ctx, tx, "FindRoomById", query, roomId).Scan(
+		&room.ID, &room.Number, &room.Type, &room.HotelID, &room.Status, &room.CreatedAt, &room.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Room{}, entities.ErrNotFound
+		}
 		return entities.Room{}, fmt.Errorf("[RoomRepository]: FindById: %w ", err)
 	}
 	return room, nil
 }
 
+func (s *Storage) UpdateRoomStatus(ctx context.Context, tx *sql.Tx, roomID uint64, status entities.RoomStatus) (entities.Room, error) {
+	var room entities.Room
+	query := `
+		UPDATE rooms
+		SET status = $2, updated_at = NOW()
+		WHERE id = $1
+		RETURNING id, number, type, hotel_id, status, created_at, updated_at
+	`
+	if err := queryRowContext(ctx, tx, "UpdateRoomStatus", query, roomID, status).Scan(
+		&room.ID, &room.Number, &room.Type, &room.HotelID, &room.Status, &room.CreatedAt, &room.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Room{}, entities.ErrNotFound
+		}
+		return entities.Room{}, fmt.Errorf("[RoomRepository]: UpdateStatus: %w ", err)
+	}
+	return room, nil
+}
+
 func (s *Storage) SaveRoom(ctx context.Context, tx *sql.Tx, room *entities.Room) error {
 	query := `
 		INSERT INTO rooms (number, type, hotel_id)
 		VALUES ($1, $2, $3)
 		RETURNING id, created_at, updated_at
 	`
-	if err := tx.QueryRowContext(ctx, query, room.Number, room.Type, room.HotelID).
+	if err := queryRowContext(ctx, tx, "SaveRoom", query, room.Number, room.Type, room.HotelID).
 		Scan(&room.ID, &room.CreatedAt, &room.UpdatedAt); err != nil {
 		return fmt.Errorf("[RoomRepository]: Save: %w ", err)
 	}
@@ -35,17 +61,33 @@ func (s *Storage) SaveRoom(ctx context.Context, tx *sql.Tx, room *entities.Room)
 	return nil
 }
 
-func (s *Storage) SaveAllRooms(ctx context.Context, tx *sql.Tx, rooms []entities.Room) error {
+// HasAvailableRoom проверяет, есть ли в отеле номер заданного типа, свободный
+// в даты [startDate, endDate); номера вне эксплуатации не учитываются
+func (s *Storage) HasAvailableRoom(
+	ctx context.Context, tx *sql.Tx, hotelID uint64, roomType string, startDate, endDate time.Time,
+) (bool, error) {
 	query := `
-		INSERT INTO rooms (number, type, hotel_id)
-		VALUES ($1, $2, $3)
-		ON CONFLICT DO NOTHING
-	`
-	// Итерация по всем комнатам для сохранения.
-	for i := range rooms {
-		if _, err := tx.ExecContext(ctx, query, rooms[i].Number, rooms[i].Type, rooms[i].HotelID); err != nil {
-			return err
-		}
+		SELECT EXISTS (
+			SELECT 1
+			FROM rooms r
+			WHERE r.hotel_id = $1
+			  AND r.type = $2
+			  AND r.status <> $5
+			  AND NOT EXISTS (
+			      SELECT 1
+			      FROM bookings b
+			      WHERE b.room_id = r.id
+			        AND b.status IN (1, 3)
+			        AND b.start_date < $4
+			        AND b.end_date > $3
+			  )
+		)`
+
+	var exists bool
+	if err := queryRowContext(ctx, tx, "HasAvailableRoom", query,
+		hotelID, roomType, startDate, endDate, entities.RoomStatusOutOfService,
+	).Scan(&exists); err != nil {
+		return false, fmt.Errorf("[RoomRepository]: HasAvailableRoom: %w", err)
 	}
-	return nil
+	return exists, nil
 }
@@ -1,16 +1,23 @@
 package storage
 
 import (
+	"database/sql"
 	"fmt"
+	"time"
+
+	"booking-service/internal/pii"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 )
 
-type Storage struct{}
+type Storage struct {
+	// pii шифрует номера документов гостей
+	pii *pii.Cipher
+}
 
-func New() *Storage {
-	return &Storage{}
+func New(cipher *pii.Cipher) *Storage {
+	return &Storage{pii: cipher}
 }
 
 func NewDB(host, username, password, dbname, port string) (*sqlx.DB, error) {
@@ -27,3 +34,27 @@ func NewDB(host, username, password, dbname, port string) (*sqlx.DB, error) {
 
 	return db, nil
 }
+
+// scanner общий интерфейс строк queryRowContext и queryContext
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// nullableID сохраняет нулевой идентификатор как NULL
+func nullableID(id uint64) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(id), Valid: id != 0}
+}
+
+// toInt64s готовит идентификаторы для pq.Array: драйвер не умеет uint64
+func toInt64s(ids []uint64) []int64 {
+	res := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, int64(id))
+	}
+	return res
+}
+
+// nullableTime сохраняет нулевое время как NULL
+func nullableTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"booking-service/internal/entities"
+)
+
+const taxRuleColumns = `id, hotel_id, kind, name, amount, currency, percent_basis_points, valid_from, valid_to,
+    created_at, updated_at`
+
+func (s *Storage) SaveTaxRule(ctx context.Context, tx *sql.Tx, rule entities.TaxRule) (entities.TaxRule, error) {
+	query := `
+		INSERT INTO tax_rules (hotel_id, kind, name, amount, currency, percent_basis_points, valid_from, valid_to)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING ` + taxRuleColumns
+
+	res, err := scanTaxRule(queryRowContext(ctx, tx, "SaveTaxRule", query,
+		rule.HotelID, rule.Kind, rule.Name, rule.Amount.Amount, rule.Amount.Currency, rule.PercentBasisPoints,
+		rule.ValidFrom, nullableTime(rule.ValidTo),
+	))
+	if err != nil {
+		return entities.TaxRule{}, fmt.Errorf("[TaxRuleRepository]: Save: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindTaxRuleByID(ctx context.Context, tx *sql.Tx, id uint64) (entities.TaxRule, error) {
+	query := `SELECT ` + taxRuleColumns + ` FROM tax_rules WHERE id = $1`
+
+	res, err := scanTaxRule(queryRowContext(ctx, tx, "FindTaxRuleByID", query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.TaxRule{}, entities.ErrNotFound
+		}
+		return entities.TaxRule{}, fmt.Errorf("[TaxRuleRepository]: FindByID: %w", err)
+	}
+	return res, nil
+}
+
+func (s *Storage) FindTaxRulesByHotelID(ctx context.Context, tx *sql.Tx, hotelID uint64) ([]entities.TaxRule, error) {
+	query := `SELECT ` + taxRuleColumns + ` FROM tax_rules WHERE hotel_id = $1 ORDER BY kind, valid_from, id`
+
+	rows, err := queryContext(ctx, tx, "FindTaxRulesByHotelID", query, hotelID)
+	if err != nil {
+		return nil, fmt.Errorf("[TaxRuleRepository]: FindByHotelID: %w", err)
+	}
+	return scanTaxRules(rows)
+}
+
+// FindTaxRulesForStay возвращает правила отеля, действующие хотя бы в одну из ночей [start, end)
+func (s *Storage) FindTaxRulesForStay(
+	ctx context.Context, tx *sql.Tx, hotelID uint64, start, end time.Time,
+) ([]entities.TaxRule, error) {
+	query := `
+		SELECT ` + taxRuleColumns + `
+		FROM tax_rules
+		WHERE hotel_id = $1
+		  AND valid_from < $3
+		  AND (valid_to IS NULL OR valid_to >= $2)
+		ORDER BY id`
+
+	rows, err := queryContext(ctx, tx, "FindTaxRulesForStay", query, hotelID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("[TaxRuleRepository]: FindForStay: %w", err)
+	}
+	return scanTaxRules(rows)
+}
+
+func (s *Storage) DeleteTaxRule(ctx context.Context, tx *sql.Tx, id uint64) error {
+	res, err := execContext(ctx, tx, "DeleteTaxRule", `DELETE FROM tax_rules WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("[TaxRuleRepository]: Delete: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.ErrNotFound
+	}
+	return nil
+}
+
+func scanTaxRule(row scanner) (entities.TaxRule, error) {
+	var (
+		r       entities.TaxRule
+		validTo sql.NullTime
+	)
+	err := row.Scan(&r.ID, &r.HotelID, &r.Kind, &r.Name, &r.Amount.Amount, &r.Amount.Currency,
+		&r.PercentBasisPoints, &r.ValidFrom, &validTo, &r.CreatedAt, &r.UpdatedAt)
+	r.ValidTo = validTo.Time
+	return r, err
+}
+
+func scanTaxRules(rows *tracedRows) ([]entities.TaxRule, error) {
+	defer rows.Close()
+
+	res := make([]entities.TaxRule, 0)
+	for rows.Next() {
+		r, err := scanTaxRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
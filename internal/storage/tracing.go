@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+
+	"booking-service/internal/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startStatementSpan открывает спан на один SQL-запрос
+func startStatementSpan(ctx context.Context, name, query string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "storage."+name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.statement", tracing.SanitizeSQL(query)),
+		),
+	)
+}
+
+func queryRowContext(ctx context.Context, tx *sql.Tx, name, query string, args ...any) *tracedRow {
+	ctx, span := startStatementSpan(ctx, name, query)
+	return &tracedRow{row: tx.QueryRowContext(ctx, query, args...), span: span}
+}
+
+func queryContext(ctx context.Context, tx *sql.Tx, name, query string, args ...any) (*tracedRows, error) {
+	ctx, span := startStatementSpan(ctx, name, query)
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		tracing.End(span, err)
+		return nil, err
+	}
+	return &tracedRows{Rows: rows, span: span}, nil
+}
+
+func execContext(ctx context.Context, tx *sql.Tx, name, query string, args ...any) (sql.Result, error) {
+	ctx, span := startStatementSpan(ctx, name, query)
+	res, err := tx.ExecContext(ctx, query, args...)
+	tracing.End(span, err)
+	return res, err
+}
+
+// tracedRow завершает спан запроса в Scan: строка читается только там, и
+// ошибки чтения попадают в спан. Отсутствие строки ошибкой спана не считается
+type tracedRow struct {
+	row  *sql.Row
+	span trace.Span
+}
+
+func (r *tracedRow) Scan(dest ...any) error {
+	err := r.row.Scan(dest...)
+	if errors.Is(err, sql.ErrNoRows) {
+		tracing.End(r.span, nil)
+	} else {
+		tracing.End(r.span, err)
+	}
+	return err
+}
+
+// tracedRows завершает спан запроса, когда строки прочитаны или закрыты, чтобы
+// в спан попали время чтения и ошибки Scan
+type tracedRows struct {
+	*sql.Rows
+	span    trace.Span
+	scanErr error
+	ended   bool
+}
+
+func (r *tracedRows) Next() bool {
+	if r.Rows.Next() {
+		return true
+	}
+	r.end(r.Rows.Err())
+	return false
+}
+
+func (r *tracedRows) Scan(dest ...any) error {
+	err := r.Rows.Scan(dest...)
+	if err != nil && r.scanErr == nil {
+		r.scanErr = err
+	}
+	return err
+}
+
+func (r *tracedRows) Close() error {
+	err := r.Rows.Close()
+	r.end(err)
+	return err
+}
+
+func (r *tracedRows) end(err error) {
+	if r.ended {
+		return
+	}
+	r.ended = true
+	tracing.End(r.span, cmp.Or(r.scanErr, err))
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
+
+	"booking-service/internal/logger"
+	"booking-service/internal/tracing"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -17,7 +19,10 @@ func WithWriteTransaction(ctx context.Context, db *sqlx.DB, fn TxFunc) error {
 	return withTx(ctx, db, fn, false)
 }
 
-func withTx(ctx context.Context, db *sqlx.DB, fn TxFunc, isReadOnly bool) error {
+func withTx(ctx context.Context, db *sqlx.DB, fn TxFunc, isReadOnly bool) (err error) {
+	ctx, span := tracing.Start(ctx, "storage.Transaction")
+	defer func() { tracing.End(span, err) }()
+
 	tx, err := db.BeginTx(ctx, &sql.TxOptions{
 		ReadOnly: isReadOnly,
 	})
@@ -30,7 +35,7 @@ func withTx(ctx context.Context, db *sqlx.DB, fn TxFunc, isReadOnly bool) error
 	defer func() {
 		if !committed {
 			if rollbackErr := tx.Rollback(); rollbackErr != nil {
-				log.Printf("failed to rollback transaction: %v", rollbackErr)
+				logger.FromContext(ctx).ErrorContext(ctx, "failed to rollback transaction", "error", rollbackErr)
 			}
 		}
 	}()
@@ -0,0 +1,47 @@
+package tracing
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	sqlLineComment  = regexp.MustCompile(`--[^\n]*`)
+	sqlBlockComment = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	sqlString       = regexp.MustCompile(`'(?:[^']|'')*'`)
+	sqlNumber       = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+	sqlSpaces       = regexp.MustCompile(`\s+`)
+)
+
+// SanitizeSQL приводит запрос к виду, пригодному для атрибута спана:
+// убирает комментарии и лишние пробелы, заменяет литералы на "?".
+// Плейсхолдеры вида $1 сохраняются.
+func SanitizeSQL(query string) string {
+	q := sqlBlockComment.ReplaceAllString(query, " ")
+	q = sqlLineComment.ReplaceAllString(q, " ")
+	q = sqlString.ReplaceAllString(q, "?")
+	q = replaceNumbers(q)
+	q = sqlSpaces.ReplaceAllString(q, " ")
+	return strings.TrimSpace(q)
+}
+
+func replaceNumbers(q string) string {
+	idx := sqlNumber.FindAllStringIndex(q, -1)
+	if len(idx) == 0 {
+		return q
+	}
+
+	var b strings.Builder
+	prev := 0
+	for _, m := range idx {
+		// $1, $2 ... — плейсхолдеры, а не литералы
+		if m[0] > 0 && q[m[0]-1] == '$' {
+			continue
+		}
+		b.WriteString(q[prev:m[0]])
+		b.WriteString("?")
+		prev = m[1]
+	}
+	b.WriteString(q[prev:])
+	return b.String()
+}
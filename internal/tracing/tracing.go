@@ -0,0 +1,140 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+const instrumentationName = "booking-service"
+
+type Config struct {
+	ServiceName string
+	Exporter    string
+	Endpoint    string
+	Insecure    bool
+	SampleRatio float64
+}
+
+// ShutdownFunc сбрасывает накопленные спаны и останавливает экспортер
+type ShutdownFunc func(ctx context.Context) error
+
+// Init настраивает глобальные TracerProvider и MeterProvider и W3C trace-context
+// пропагатор. Метрики отправляются тем же экспортером, что и спаны. При экспортере
+// none спаны и метрики не собираются, но контекст по-прежнему пробрасывается.
+func Init(ctx context.Context, cfg Config) (ShutdownFunc, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var (
+		exporter       sdktrace.SpanExporter
+		metricExporter sdkmetric.Exporter
+	)
+	switch cfg.Exporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("[tracing.Init]: stdout exporter: %w", err)
+		}
+		exporter = exp
+		metricExp, err := stdoutmetric.New(stdoutmetric.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("[tracing.Init]: stdout metric exporter: %w", err)
+		}
+		metricExporter = metricExp
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		metricOpts := []otlpmetricgrpc.Option{otlpmetricgrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+			metricOpts = append(metricOpts, otlpmetricgrpc.WithInsecure())
+		}
+		exp, err := otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("[tracing.Init]: otlp exporter: %w", err)
+		}
+		exporter = exp
+		metricExp, err := otlpmetricgrpc.New(ctx, metricOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("[tracing.Init]: otlp metric exporter: %w", err)
+		}
+		metricExporter = metricExp
+	default:
+		return nil, fmt.Errorf("[tracing.Init]: unknown exporter %q", cfg.Exporter)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("[tracing.Init]: resource: %w", err)
+	}
+
+	sampler := sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sampler),
+	)
+	otel.SetTracerProvider(tp)
+
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
+		sdkmetric.WithResource(res),
+	)
+	otel.SetMeterProvider(mp)
+
+	return func(ctx context.Context) error {
+		return errors.Join(tp.Shutdown(ctx), mp.Shutdown(ctx))
+	}, nil
+}
+
+// Tracer возвращает трейсер сервиса из глобального провайдера
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Meter возвращает измеритель сервиса из глобального провайдера
+func Meter() metric.Meter {
+	return otel.Meter(instrumentationName)
+}
+
+// Start открывает дочерний спан с именем name
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, opts...)
+}
+
+// End записывает ошибку в спан (если есть) и завершает его
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
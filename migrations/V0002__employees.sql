@@ -0,0 +1,20 @@
+-- Таблица Employee
+CREATE TABLE employees
+(
+    id         BIGSERIAL PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    role       VARCHAR(32)  NOT NULL,
+    -- NULL только у администраторов: их роль не ограничена отелем
+    hotel_id   BIGINT REFERENCES hotels (id),
+    -- subject из JWT, по которому сотрудник сопоставляется с токеном
+    subject    VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+    CHECK (role = 'admin' OR hotel_id IS NOT NULL)
+);
+
+CREATE INDEX employees_hotel_id_idx ON employees (hotel_id);
+
+-- Статус уборки номера, меняется в том числе горничными
+ALTER TABLE rooms
+    ADD COLUMN status INT8 NOT NULL DEFAULT 1;
@@ -0,0 +1,20 @@
+-- Гости бронирования: у бронирования может быть несколько гостей
+CREATE TABLE bookings_guests
+(
+    booking_id BIGINT NOT NULL REFERENCES bookings (id) ON DELETE CASCADE,
+    guest_id   BIGINT NOT NULL REFERENCES guests (id),
+    PRIMARY KEY (booking_id, guest_id)
+);
+
+CREATE INDEX bookings_guests_guest_id_idx ON bookings_guests (guest_id);
+
+INSERT INTO bookings_guests (booking_id, guest_id)
+SELECT id, guest_id
+FROM bookings;
+
+ALTER TABLE bookings
+    DROP COLUMN guest_id;
+
+-- Автор отзыва
+ALTER TABLE reviews
+    ADD COLUMN guest_id BIGINT REFERENCES guests (id);
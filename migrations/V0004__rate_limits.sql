@@ -0,0 +1,10 @@
+-- Корзины token bucket, общие для реплик (rate_limit.store = postgres)
+CREATE UNLOGGED TABLE rate_limit_buckets
+(
+    key        VARCHAR(255)     PRIMARY KEY,
+    tokens     DOUBLE PRECISION NOT NULL,
+    allowed    BOOLEAN          NOT NULL,
+    updated_at TIMESTAMPTZ      NOT NULL
+);
+
+CREATE INDEX rate_limit_buckets_updated_at_idx ON rate_limit_buckets (updated_at);
@@ -0,0 +1,40 @@
+-- Журнал аудита изменений; записывается в той же транзакции, что и изменение
+CREATE TABLE audit_events
+(
+    id          BIGSERIAL PRIMARY KEY,
+    occurred_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    actor_kind  VARCHAR(32)  NOT NULL,
+    actor       VARCHAR(255) NOT NULL,
+    employee_id BIGINT,
+    rpc         VARCHAR(255) NOT NULL DEFAULT '',
+    entity_type VARCHAR(32)  NOT NULL,
+    entity_id   BIGINT       NOT NULL,
+    -- NULL для гостей: они не принадлежат отелю
+    hotel_id    BIGINT,
+    action      VARCHAR(16)  NOT NULL,
+    diff        JSONB        NOT NULL,
+    request_id  VARCHAR(128) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX audit_events_entity_idx ON audit_events (entity_type, entity_id, occurred_at);
+CREATE INDEX audit_events_hotel_idx ON audit_events (hotel_id, occurred_at);
+
+-- Только добавление: изменение и удаление записей запрещены
+CREATE FUNCTION audit_events_immutable() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_no_update
+    BEFORE UPDATE OR DELETE
+    ON audit_events
+    FOR EACH ROW
+EXECUTE FUNCTION audit_events_immutable();
+
+CREATE TRIGGER audit_events_no_truncate
+    BEFORE TRUNCATE
+    ON audit_events
+    FOR EACH STATEMENT
+EXECUTE FUNCTION audit_events_immutable();
@@ -0,0 +1,35 @@
+-- Номер и тип номера хранятся строками, как в API (тип — имя значения RoomType)
+ALTER TABLE rooms
+    ALTER COLUMN number TYPE VARCHAR(32) USING number::VARCHAR,
+    ALTER COLUMN type TYPE VARCHAR(32) USING CASE type
+                                                 WHEN 1 THEN 'ROOM_TYPE_LOW_BUDGET'
+                                                 WHEN 2 THEN 'ROOM_TYPE_MID_BUDGET'
+                                                 WHEN 3 THEN 'ROOM_TYPE_HIGH_BUDGET'
+                                                 WHEN 4 THEN 'ROOM_TYPE_HIGH_PRESIDENT'
+                                                 ELSE 'ROOM_TYPE_UNKNOWN'
+        END;
+
+-- Тарифы: цена за ночь для типа номера в отеле; суммы в минимальных единицах валюты
+CREATE TABLE rate_plans
+(
+    id            BIGSERIAL PRIMARY KEY,
+    hotel_id      BIGINT       NOT NULL REFERENCES hotels (id),
+    room_type     VARCHAR(32)  NOT NULL,
+    name          VARCHAR(255) NOT NULL,
+    nightly_price BIGINT       NOT NULL CHECK (nightly_price > 0),
+    currency      CHAR(3)      NOT NULL,
+    valid_from    DATE         NOT NULL,
+    -- NULL — тариф бессрочный
+    valid_to      DATE,
+    created_at    TIMESTAMP DEFAULT NOW(),
+    updated_at    TIMESTAMP DEFAULT NOW(),
+    CHECK (valid_to IS NULL OR valid_to >= valid_from)
+);
+
+CREATE INDEX rate_plans_hotel_room_type_idx ON rate_plans (hotel_id, room_type, valid_from);
+
+-- Снимок цены на момент бронирования: изменение тарифов не меняет цену брони
+ALTER TABLE bookings
+    ADD COLUMN price_total    BIGINT     NOT NULL DEFAULT 0,
+    ADD COLUMN price_currency VARCHAR(3) NOT NULL DEFAULT '',
+    ADD COLUMN price_nights   JSONB      NOT NULL DEFAULT '[]';
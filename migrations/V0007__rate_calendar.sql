@@ -0,0 +1,11 @@
+-- Календарь цен: цена конкретной ночи, перекрывающая тарифы
+CREATE TABLE rate_calendar
+(
+    hotel_id   BIGINT      NOT NULL REFERENCES hotels (id),
+    room_type  VARCHAR(32) NOT NULL,
+    date       DATE        NOT NULL,
+    price      BIGINT      NOT NULL CHECK (price > 0),
+    currency   CHAR(3)     NOT NULL,
+    updated_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (hotel_id, room_type, date)
+);
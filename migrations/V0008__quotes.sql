@@ -0,0 +1,10 @@
+-- Политика отмены отеля: бесплатно за free_cancellation_hours часов до заезда,
+-- позже — штраф в размере cancellation_penalty_nights первых ночей
+ALTER TABLE hotels
+    ADD COLUMN free_cancellation_hours     INT NOT NULL DEFAULT 24 CHECK (free_cancellation_hours >= 0),
+    ADD COLUMN cancellation_penalty_nights INT NOT NULL DEFAULT 1 CHECK (cancellation_penalty_nights >= 0);
+
+-- Детализация цены (подытог, налоги, сборы) и условия отмены на момент бронирования
+ALTER TABLE bookings
+    ADD COLUMN price_breakdown    JSONB NOT NULL DEFAULT '{}',
+    ADD COLUMN cancellation_terms JSONB;
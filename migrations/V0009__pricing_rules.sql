@@ -0,0 +1,15 @@
+-- Правила динамического ценообразования: стратегия корректирует базовую цену ночи,
+-- затем цена ограничивается min_price/max_price (NULL — без ограничения)
+CREATE TABLE pricing_rules
+(
+    hotel_id        BIGINT      NOT NULL REFERENCES hotels (id),
+    room_type       VARCHAR(32) NOT NULL,
+    strategy        VARCHAR(32) NOT NULL,
+    occupancy_tiers JSONB       NOT NULL DEFAULT '[]',
+    min_price       BIGINT CHECK (min_price > 0),
+    max_price       BIGINT CHECK (max_price > 0),
+    currency        CHAR(3),
+    updated_at      TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (hotel_id, room_type),
+    CHECK (min_price IS NULL OR max_price IS NULL OR min_price <= max_price)
+);
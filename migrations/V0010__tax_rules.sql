@@ -0,0 +1,20 @@
+-- Налоги и сборы отеля с периодом действия: туристический налог за гостя за ночь,
+-- НДС в базисных пунктах (2000 — 20%) и фиксированный сбор за проживание
+CREATE TABLE tax_rules
+(
+    id                   BIGSERIAL PRIMARY KEY,
+    hotel_id             BIGINT       NOT NULL REFERENCES hotels (id),
+    kind                 VARCHAR(16)  NOT NULL,
+    name                 VARCHAR(255) NOT NULL,
+    amount               BIGINT       NOT NULL DEFAULT 0 CHECK (amount >= 0),
+    currency             VARCHAR(3)   NOT NULL DEFAULT '',
+    percent_basis_points INT          NOT NULL DEFAULT 0 CHECK (percent_basis_points BETWEEN 0 AND 10000),
+    valid_from           DATE         NOT NULL,
+    -- NULL — правило бессрочное
+    valid_to             DATE,
+    created_at           TIMESTAMP DEFAULT NOW(),
+    updated_at           TIMESTAMP DEFAULT NOW(),
+    CHECK (valid_to IS NULL OR valid_to >= valid_from)
+);
+
+CREATE INDEX tax_rules_hotel_idx ON tax_rules (hotel_id, valid_from);
@@ -0,0 +1,27 @@
+-- Промокоды маркетинга. NULL и нули в ограничениях — ограничения нет;
+-- redemptions увеличивается в транзакции создания бронирования
+CREATE TABLE promotions
+(
+    id              BIGSERIAL PRIMARY KEY,
+    -- хранится в верхнем регистре
+    code            VARCHAR(64) NOT NULL UNIQUE,
+    hotel_id        BIGINT REFERENCES hotels (id),
+    room_types      TEXT[]      NOT NULL DEFAULT '{}',
+    discount_type   VARCHAR(16) NOT NULL,
+    percent_off     INT         NOT NULL DEFAULT 0 CHECK (percent_off BETWEEN 0 AND 100),
+    amount_off      BIGINT      NOT NULL DEFAULT 0 CHECK (amount_off >= 0),
+    currency        VARCHAR(3)  NOT NULL DEFAULT '',
+    stay_from       DATE,
+    stay_to         DATE,
+    min_nights      INT         NOT NULL DEFAULT 0 CHECK (min_nights >= 0),
+    book_from       TIMESTAMP,
+    book_to         TIMESTAMP,
+    max_redemptions INT         NOT NULL DEFAULT 0 CHECK (max_redemptions >= 0),
+    redemptions     INT         NOT NULL DEFAULT 0 CHECK (redemptions >= 0),
+    created_at      TIMESTAMP DEFAULT NOW(),
+    updated_at      TIMESTAMP DEFAULT NOW(),
+    CHECK (stay_to IS NULL OR stay_from IS NULL OR stay_to >= stay_from),
+    CHECK (book_to IS NULL OR book_from IS NULL OR book_to > book_from)
+);
+
+CREATE INDEX promotions_hotel_idx ON promotions (hotel_id);
@@ -0,0 +1,23 @@
+-- Валюта отеля: у существующих отелей берется из первого тарифа, иначе RUB
+ALTER TABLE hotels
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'RUB';
+
+UPDATE hotels h
+SET currency = rp.currency
+FROM (SELECT DISTINCT ON (hotel_id) hotel_id, currency FROM rate_plans ORDER BY hotel_id, id) rp
+WHERE rp.hotel_id = h.id;
+
+ALTER TABLE hotels
+    ALTER COLUMN currency DROP DEFAULT;
+
+-- Курсы валют загружаются администратором; внешнего источника курсов нет.
+-- За единицу base_currency дают rate единиц quote_currency
+CREATE TABLE exchange_rates
+(
+    base_currency  VARCHAR(3)      NOT NULL,
+    quote_currency VARCHAR(3)      NOT NULL,
+    rate           NUMERIC(24, 12) NOT NULL CHECK (rate > 0),
+    updated_at     TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (base_currency, quote_currency),
+    CHECK (base_currency <> quote_currency)
+);
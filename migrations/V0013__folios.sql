@@ -0,0 +1,53 @@
+-- Счет гостя на бронирование: дополнительные услуги сверх проживания.
+-- Закрывается при выезде, после этого начисления и оплаты запрещены
+CREATE TABLE folios
+(
+    id         BIGSERIAL PRIMARY KEY,
+    booking_id BIGINT      NOT NULL UNIQUE REFERENCES bookings (id),
+    currency   VARCHAR(3)  NOT NULL,
+    status     VARCHAR(16) NOT NULL DEFAULT 'open',
+    closed_at  TIMESTAMP,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- Начисления не удаляются: ошибочное начисление сторнируется (voided_at)
+CREATE TABLE folio_charges
+(
+    id               BIGSERIAL PRIMARY KEY,
+    folio_id         BIGINT       NOT NULL REFERENCES folios (id),
+    category         VARCHAR(32)  NOT NULL,
+    description      VARCHAR(255) NOT NULL DEFAULT '',
+    quantity         INT          NOT NULL CHECK (quantity > 0),
+    unit_price       BIGINT       NOT NULL CHECK (unit_price > 0),
+    currency         VARCHAR(3)   NOT NULL,
+    tax_basis_points INT          NOT NULL DEFAULT 0 CHECK (tax_basis_points BETWEEN 0 AND 10000),
+    -- net = quantity * unit_price, total = net + tax
+    net              BIGINT       NOT NULL,
+    tax              BIGINT       NOT NULL,
+    total            BIGINT       NOT NULL,
+    posted_by        VARCHAR(255) NOT NULL DEFAULT '',
+    voided_at        TIMESTAMP,
+    void_reason      VARCHAR(255) NOT NULL DEFAULT '',
+    created_at       TIMESTAMP DEFAULT NOW(),
+    updated_at       TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX folio_charges_folio_idx ON folio_charges (folio_id);
+
+-- Списания через платежный сервис: оплата бронирования (folio_id IS NULL)
+-- и оплаты счета гостя
+CREATE TABLE payments
+(
+    id           BIGSERIAL PRIMARY KEY,
+    booking_id   BIGINT     NOT NULL REFERENCES bookings (id),
+    folio_id     BIGINT REFERENCES folios (id),
+    amount       BIGINT     NOT NULL CHECK (amount > 0),
+    currency     VARCHAR(3) NOT NULL,
+    status       SMALLINT   NOT NULL,
+    payment_date TIMESTAMP  NOT NULL DEFAULT NOW(),
+    created_at   TIMESTAMP DEFAULT NOW(),
+    updated_at   TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX payments_booking_idx ON payments (booking_id);
@@ -0,0 +1,41 @@
+-- Счетчик номеров счетов отеля за финансовый год. Строка счетчика
+-- блокируется до конца транзакции выставления счета, а при откате номер
+-- возвращается, поэтому номера идут без пропусков
+CREATE TABLE invoice_sequences
+(
+    hotel_id    BIGINT NOT NULL REFERENCES hotels (id),
+    fiscal_year INT    NOT NULL,
+    last_number BIGINT NOT NULL,
+    PRIMARY KEY (hotel_id, fiscal_year)
+);
+
+-- Выставленные счета: document — счет в JSON (хранится как выставлен,
+-- поэтому JSON, а не JSONB), pdf — печатная форма
+CREATE TABLE invoices
+(
+    id              BIGSERIAL PRIMARY KEY,
+    hotel_id        BIGINT      NOT NULL REFERENCES hotels (id),
+    booking_id      BIGINT      NOT NULL UNIQUE REFERENCES bookings (id),
+    fiscal_year     INT         NOT NULL,
+    sequence_number BIGINT      NOT NULL,
+    number          VARCHAR(64) NOT NULL UNIQUE,
+    issued_at       TIMESTAMP   NOT NULL,
+    document        JSON        NOT NULL,
+    pdf             BYTEA       NOT NULL,
+    created_at      TIMESTAMP DEFAULT NOW(),
+    UNIQUE (hotel_id, fiscal_year, sequence_number)
+);
+
+-- Счета неизменяемы: исправления оформляются новым документом
+CREATE FUNCTION invoices_immutable() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'invoices are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER invoices_immutable
+    BEFORE UPDATE OR DELETE
+    ON invoices
+    FOR EACH ROW
+EXECUTE FUNCTION invoices_immutable();
@@ -0,0 +1,40 @@
+-- Состояние оплаты проживания по данным платежного сервиса. is_paid
+-- остается для совместимости и равен payment_status = 'paid'.
+-- reconciled_at — время последней сверки бронирования
+ALTER TABLE bookings
+    ADD COLUMN payment_status VARCHAR(16) NOT NULL DEFAULT 'unpaid',
+    ADD COLUMN amount_paid    BIGINT      NOT NULL DEFAULT 0,
+    ADD COLUMN reconciled_at  TIMESTAMP;
+
+UPDATE bookings
+SET payment_status = 'paid',
+    amount_paid    = price_total
+WHERE is_paid;
+
+CREATE INDEX bookings_updated_at_idx ON bookings (updated_at);
+
+-- Расхождения, найденные сверкой. Открытое расхождение одного вида на
+-- бронирование одно; когда оно пропадает, сверка проставляет resolved_at
+CREATE TABLE reconciliation_issues
+(
+    id          BIGSERIAL PRIMARY KEY,
+    booking_id  BIGINT      NOT NULL REFERENCES bookings (id) ON DELETE CASCADE,
+    hotel_id    BIGINT      NOT NULL REFERENCES hotels (id),
+    kind        VARCHAR(32) NOT NULL,
+    details     TEXT        NOT NULL DEFAULT '',
+    detected_at TIMESTAMP   NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX reconciliation_issues_open_idx
+    ON reconciliation_issues (booking_id, kind) WHERE resolved_at IS NULL;
+CREATE INDEX reconciliation_issues_hotel_id_idx ON reconciliation_issues (hotel_id, detected_at);
+
+-- Аренда фоновых задач: задачу в каждый момент выполняет одна реплика,
+-- после expires_at аренду может забрать другая
+CREATE TABLE job_leases
+(
+    name       VARCHAR(64)  PRIMARY KEY,
+    holder     VARCHAR(255) NOT NULL,
+    expires_at TIMESTAMPTZ  NOT NULL
+);
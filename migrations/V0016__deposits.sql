@@ -0,0 +1,36 @@
+-- Правило предоплаты отеля: при бронировании списывается депозит (процент
+-- стоимости или первая ночь), остаток — за balance_days_before дней до заезда
+CREATE TABLE deposit_rules
+(
+    hotel_id             BIGINT PRIMARY KEY REFERENCES hotels (id),
+    kind                 VARCHAR(16) NOT NULL,
+    percent_basis_points INT         NOT NULL DEFAULT 0,
+    balance_days_before  INT         NOT NULL,
+    created_at           TIMESTAMP DEFAULT NOW(),
+    updated_at           TIMESTAMP DEFAULT NOW()
+);
+
+-- Срок списания остатка; пусто, если остаток не запланирован. Неудачные
+-- попытки считаются в balance_attempts, следующая — не раньше balance_next_attempt_at
+ALTER TABLE bookings
+    ADD COLUMN balance_due_at          TIMESTAMP,
+    ADD COLUMN balance_attempts        INT NOT NULL DEFAULT 0,
+    ADD COLUMN balance_next_attempt_at TIMESTAMP;
+
+CREATE INDEX bookings_balance_due_at_idx ON bookings (balance_due_at) WHERE balance_due_at IS NOT NULL;
+
+-- Исходящие уведомления: пишутся в транзакции изменения и отправляются
+-- в брокер отдельно, поэтому не теряются при сбое после фиксации
+CREATE TABLE notifications
+(
+    id         BIGSERIAL PRIMARY KEY,
+    kind       VARCHAR(64) NOT NULL,
+    booking_id BIGINT REFERENCES bookings (id) ON DELETE SET NULL,
+    payload    JSONB       NOT NULL,
+    attempts   INT         NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    sent_at    TIMESTAMP
+);
+
+CREATE INDEX notifications_pending_idx ON notifications (id) WHERE sent_at IS NULL;
@@ -0,0 +1,17 @@
+-- Профиль гостя. Номер документа хранится зашифрованным (AES-256-GCM);
+-- document_number_index — HMAC нормализованного номера для поиска
+ALTER TABLE guests
+    ADD COLUMN email                 VARCHAR(254),
+    ADD COLUMN phone                 VARCHAR(16),
+    ADD COLUMN date_of_birth         DATE,
+    ADD COLUMN nationality           CHAR(2),
+    ADD COLUMN address               JSONB,
+    ADD COLUMN document_type         VARCHAR(16),
+    ADD COLUMN document_number       BYTEA,
+    ADD COLUMN document_number_index BYTEA,
+    ADD COLUMN document_country      CHAR(2),
+    ADD COLUMN document_expires_at   DATE;
+
+CREATE INDEX guests_email_idx ON guests (email);
+CREATE INDEX guests_phone_idx ON guests (phone);
+CREATE INDEX guests_document_number_index_idx ON guests (document_number_index);
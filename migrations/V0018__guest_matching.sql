@@ -0,0 +1,4 @@
+-- Отчет о дубликатах сравнивает имена без учета регистра; объединение гостей
+-- переносит отзывы по guest_id
+CREATE INDEX guests_name_lower_idx ON guests (LOWER(name));
+CREATE INDEX reviews_guest_id_idx ON reviews (guest_id);
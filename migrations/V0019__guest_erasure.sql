@@ -0,0 +1,18 @@
+-- Время удаления персональных данных гостя по запросу или по сроку хранения
+ALTER TABLE guests
+    ADD COLUMN erased_at TIMESTAMP;
+
+-- Журнал по-прежнему только для добавления. Исключение — удаление персональных
+-- данных: при SET LOCAL booking.audit_redaction = 'on' можно переписать diff,
+-- остальные поля записи не меняются
+CREATE OR REPLACE FUNCTION audit_events_immutable() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND current_setting('booking.audit_redaction', true) = 'on'
+        AND to_jsonb(NEW) - 'diff' = to_jsonb(OLD) - 'diff' THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
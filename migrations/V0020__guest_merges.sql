@@ -0,0 +1,11 @@
+-- Гости, объединенные в другой профиль. При удалении персональных данных
+-- журнал аудита вычищается и по объединенным профилям. Цепочки объединений
+-- схлопываются: target_id всегда указывает на существующего гостя
+CREATE TABLE guest_merges
+(
+    source_id BIGINT PRIMARY KEY,
+    target_id BIGINT    NOT NULL REFERENCES guests (id),
+    merged_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX guest_merges_target_id_idx ON guest_merges (target_id);
@@ -0,0 +1,64 @@
+-- Переписать diff в журнале аудита может только функция redact_audit_events.
+-- Она выполняется от роли audit_redactor, которой разрешено менять только
+-- колонку diff; у остальных ролей права на изменение журнала отозваны.
+-- Гарантия действует, пока приложение подключается не владельцем схемы и не
+-- суперпользователем: они могут вернуть себе права или отключить триггер
+DO
+$$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_roles WHERE rolname = 'audit_redactor') THEN
+        CREATE ROLE audit_redactor NOLOGIN;
+    END IF;
+END;
+$$;
+
+GRANT USAGE ON SCHEMA public TO audit_redactor;
+GRANT SELECT, UPDATE (diff) ON audit_events TO audit_redactor;
+
+REVOKE UPDATE, DELETE, TRUNCATE ON audit_events FROM PUBLIC;
+REVOKE UPDATE, DELETE, TRUNCATE ON audit_events FROM CURRENT_USER;
+
+-- Изменение разрешено только роли audit_redactor и только в diff
+CREATE OR REPLACE FUNCTION audit_events_immutable() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND current_user = 'audit_redactor'
+        AND to_jsonb(NEW) - 'diff' = to_jsonb(OLD) - 'diff' THEN
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+-- Заменяет значения в diff событий сущностей на [REDACTED]: остается видно,
+-- какие поля и когда менялись, но не сами данные
+CREATE FUNCTION redact_audit_events(p_entity_type VARCHAR, p_entity_ids BIGINT[]) RETURNS VOID
+    SECURITY DEFINER
+    SET search_path = pg_catalog, public, pg_temp
+AS
+$$
+BEGIN
+    UPDATE audit_events
+    SET diff = (
+        SELECT COALESCE(JSONB_OBJECT_AGG(key, JSONB_BUILD_OBJECT(
+                   'before', CASE WHEN COALESCE(value -> 'before', 'null') = 'null' THEN 'null' ELSE '"[REDACTED]"' END::JSONB,
+                   'after', CASE WHEN COALESCE(value -> 'after', 'null') = 'null' THEN 'null' ELSE '"[REDACTED]"' END::JSONB
+               )), '{}')
+        FROM JSONB_EACH(diff)
+    )
+    WHERE entity_type = p_entity_type
+      AND entity_id = ANY (p_entity_ids);
+END;
+$$ LANGUAGE plpgsql;
+
+-- Вызывать функцию может только роль, применяющая миграции (она же роль
+-- приложения); другой роли приложения EXECUTE выдается отдельно
+REVOKE ALL ON FUNCTION redact_audit_events(VARCHAR, BIGINT[]) FROM PUBLIC;
+GRANT EXECUTE ON FUNCTION redact_audit_events(VARCHAR, BIGINT[]) TO CURRENT_USER;
+
+-- Передать функцию роли можно, только будучи ее членом; членство нужно лишь на
+-- время смены владельца
+GRANT audit_redactor TO CURRENT_USER;
+ALTER FUNCTION redact_audit_events(VARCHAR, BIGINT[]) OWNER TO audit_redactor;
+REVOKE audit_redactor FROM CURRENT_USER;